@@ -145,6 +145,12 @@ var (
 	LogsEventNotificationInstanceRegion string
 )
 
+// Cloud Monitoring
+var (
+	MonitoringInstanceID     string
+	MonitoringInstanceRegion string
+)
+
 // Secrets Manager
 var (
 	SecretsManagerInstanceID                                     string
@@ -243,6 +249,7 @@ var (
 	IsImageName             string
 	IsImage                 string
 	IsImage2                string
+	IsDeprecatedImage       string
 	IsImageEncryptedDataKey string
 	IsImageEncryptionKey    string
 	IsWinImage              string
@@ -384,6 +391,17 @@ var (
 	TargetAccountId string
 )
 
+// For Container Registry
+var (
+	CrImage string
+)
+
+// For Direct Link MACsec
+var (
+	DlMacsecPrimaryCak  string
+	DlMacsecFallbackCak string
+)
+
 func init() {
 	testlogger := os.Getenv("TF_LOG")
 	if testlogger != "" {
@@ -761,6 +779,11 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_IMAGE2 for testing ibm_is_instance, ibm_is_floating_ip else it is set to default value 'r134-f47cc24c-e020-4db5-ad96-1e5be8b5853b'")
 	}
 
+	IsDeprecatedImage = os.Getenv("IS_DEPRECATED_IMAGE")
+	if IsDeprecatedImage == "" {
+		fmt.Println("[INFO] Set the environment variable IS_DEPRECATED_IMAGE to the ID of a deprecated image for testing the deprecated_image_policy of ibm_is_instance else tests will fail if this is not set correctly")
+	}
+
 	IsWinImage = os.Getenv("IS_WIN_IMAGE")
 	if IsWinImage == "" {
 		// IsWinImage = "a7a0626c-f97e-4180-afbe-0331ec62f32a" // classic windows machine: ibm-windows-server-2012-full-standard-amd64-1
@@ -1722,6 +1745,14 @@ func init() {
 	if LogsEventNotificationInstanceRegion == "" {
 		fmt.Println("[INFO] Set the environment variable IBMCLOUD_LOGS_SERVICE_EVENT_NOTIFICATIONS_INSTANCE_REGION for testing cloud logs related operations")
 	}
+	MonitoringInstanceID = os.Getenv("IBMCLOUD_MONITORING_INSTANCE_ID")
+	if MonitoringInstanceID == "" {
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_MONITORING_INSTANCE_ID for testing cloud monitoring related operations")
+	}
+	MonitoringInstanceRegion = os.Getenv("IBMCLOUD_MONITORING_INSTANCE_REGION")
+	if MonitoringInstanceRegion == "" {
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_MONITORING_INSTANCE_REGION for testing cloud monitoring related operations")
+	}
 
 	PagCosInstanceName = os.Getenv("IBM_PAG_COS_INSTANCE_NAME")
 	if PagCosInstanceName == "" {
@@ -1781,6 +1812,21 @@ func init() {
 	if TargetAccountId == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_POLICY_ASSIGNMENT_TARGET_ACCOUNT_ID for testing ibm_iam_policy_assignment resource else tests will fail if this is not set correctly")
 	}
+
+	CrImage = os.Getenv("IBM_CR_IMAGE")
+	if CrImage == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CR_IMAGE to an image in Container Registry, such as us.icr.io/namespace/repository@sha256:digest, for testing ibm_cr_image_vulnerability_report data source else tests will fail if this is not set correctly")
+	}
+
+	DlMacsecPrimaryCak = os.Getenv("IBM_DL_MACSEC_PRIMARY_CAK")
+	if DlMacsecPrimaryCak == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DL_MACSEC_PRIMARY_CAK to the CRN of a Hyper Protect Crypto Services or Key Protect key for testing MACsec on ibm_dl_gateway resource else tests will fail if this is not set correctly")
+	}
+
+	DlMacsecFallbackCak = os.Getenv("IBM_DL_MACSEC_FALLBACK_CAK")
+	if DlMacsecFallbackCak == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DL_MACSEC_FALLBACK_CAK to the CRN of a Hyper Protect Crypto Services or Key Protect key for testing MACsec on ibm_dl_gateway resource else tests will fail if this is not set correctly")
+	}
 }
 
 var (
@@ -1893,6 +1939,16 @@ func TestAccPreCheckCloudLogs(t *testing.T) {
 	})
 }
 
+func TestAccPreCheckMonitoring(t *testing.T) {
+	TestAccPreCheck(t)
+	if MonitoringInstanceID == "" {
+		t.Fatal("IBMCLOUD_MONITORING_INSTANCE_ID must be set for acceptance tests")
+	}
+	if MonitoringInstanceRegion == "" {
+		t.Fatal("IBMCLOUD_MONITORING_INSTANCE_REGION must be set for acceptance tests")
+	}
+}
+
 func TestAccPreCheckCloudShell(t *testing.T) {
 	TestAccPreCheck(t)
 	if CloudShellAccountID == "" {
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Values of the api_log_format provider argument.
+const (
+	APILogFormatText = "text"
+	APILogFormatJSON = "json"
+)
+
+// apiLogMaxBodySize is the largest request or response body written to the API log. Larger bodies are
+// replaced by their size.
+const apiLogMaxBodySize = 64 << 10
+
+const apiLogRedacted = "[REDACTED]"
+
+// apiLogSensitiveName matches the names of the headers, query parameters and JSON or form fields whose value
+// is redacted, e.g. Authorization, apikey, refresh_token, user_data or the key material of a KMS key
+var apiLogSensitiveName = regexp.MustCompile(`(?i)authorization|cookie|payload|plaintext|user[-_]?data|` + sensitiveNames)
+
+// APILogEntry is one API call written to the provider log when api_log_format is json.
+type APILogEntry struct {
+	Time            time.Time         `json:"time"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     interface{}       `json:"request_body,omitempty"`
+	StatusCode      int               `json:"status_code,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    interface{}       `json:"response_body,omitempty"`
+	DurationMs      int64             `json:"duration_ms"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// apiLogTransport writes the API calls of the clients of a session to the provider log as JSON documents, with
+// the secrets of the headers, query strings and bodies redacted, when api_log_format is json.
+type apiLogTransport struct {
+	next   gohttp.RoundTripper
+	logger *log.Logger
+}
+
+func (t *apiLogTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	entry := APILogEntry{
+		Time:           time.Now().UTC(),
+		Method:         req.Method,
+		URL:            apiLogURL(req.URL),
+		RequestHeaders: apiLogHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		var body []byte
+		body, req.Body = apiLogReadBody(req.Body, req.ContentLength)
+		entry.RequestBody = apiLogBody(body, req.Header.Get("Content-Type"))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	entry.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.StatusCode = resp.StatusCode
+		entry.ResponseHeaders = apiLogHeaders(resp.Header)
+		if resp.Body != nil && resp.Body != gohttp.NoBody {
+			var body []byte
+			body, resp.Body = apiLogReadBody(resp.Body, resp.ContentLength)
+			entry.ResponseBody = apiLogBody(body, resp.Header.Get("Content-Type"))
+		}
+	}
+
+	line, jsonErr := json.Marshal(entry)
+	if jsonErr != nil {
+		t.logger.Printf("[DEBUG] Error encoding API log entry: %s", jsonErr)
+	} else {
+		t.logger.Printf("[DEBUG] API call: %s", line)
+	}
+	return resp, err
+}
+
+// apiLogReadBody reads up to apiLogMaxBodySize bytes of body and returns a body restored for the SDK. A nil
+// slice is returned for larger bodies, which are not logged.
+func apiLogReadBody(body io.ReadCloser, contentLength int64) ([]byte, io.ReadCloser) {
+	if contentLength > apiLogMaxBodySize {
+		return nil, body
+	}
+	read, err := io.ReadAll(io.LimitReader(body, apiLogMaxBodySize+1))
+	restored := struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(read), body), body}
+	if err != nil || len(read) > apiLogMaxBodySize {
+		return nil, restored
+	}
+	return read, restored
+}
+
+// apiLogBody returns the redacted JSON document or form of a body. Other bodies are only described by
+// their size, as they can not be redacted.
+func apiLogBody(body []byte, contentType string) interface{} {
+	if body == nil {
+		return "[body too large to log]"
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	switch {
+	case strings.Contains(contentType, "json"):
+		var document interface{}
+		if err := json.Unmarshal(body, &document); err == nil {
+			return apiLogRedactJSON(document)
+		}
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			return apiLogRedactValues(form).Encode()
+		}
+	}
+	return fmt.Sprintf("[%d bytes of %s]", len(body), contentType)
+}
+
+func apiLogRedactJSON(document interface{}) interface{} {
+	switch value := document.(type) {
+	case map[string]interface{}:
+		for k, v := range value {
+			if apiLogSensitiveName.MatchString(k) {
+				value[k] = apiLogRedacted
+			} else {
+				value[k] = apiLogRedactJSON(v)
+			}
+		}
+	case []interface{}:
+		for i, v := range value {
+			value[i] = apiLogRedactJSON(v)
+		}
+	}
+	return document
+}
+
+func apiLogRedactValues(values url.Values) url.Values {
+	for k := range values {
+		if apiLogSensitiveName.MatchString(k) {
+			values[k] = []string{apiLogRedacted}
+		}
+	}
+	return values
+}
+
+func apiLogHeaders(header gohttp.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(header))
+	for k, v := range header {
+		if apiLogSensitiveName.MatchString(k) {
+			headers[k] = apiLogRedacted
+		} else {
+			headers[k] = strings.Join(v, ", ")
+		}
+	}
+	return headers
+}
+
+func apiLogURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = apiLogRedactValues(u.Query()).Encode()
+	return redacted.String()
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAPILogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.Write([]byte(`{"id":"r006-1","user_data":"#cloud-config secret","keys":[{"name":"k1","payload":"secret-key"}]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	client := &http.Client{Transport: &apiLogTransport{next: http.DefaultTransport, logger: log.New(&output, "", 0)}}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/instances?version=2024-01-01&access_token=secret-query",
+		strings.NewReader(`{"name":"vsi","password":"secret-password"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret-bearer")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `"payload":"secret-key"`) {
+		t.Fatalf("response body was not restored: %s", body)
+	}
+
+	line := output.String()
+	if strings.Contains(line, "secret-") {
+		t.Fatalf("secrets were logged: %s", line)
+	}
+	entry := APILogEntry{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(strings.TrimSpace(line), "[DEBUG] API call: ")), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Method != http.MethodPost || entry.StatusCode != http.StatusOK {
+		t.Errorf("unexpected method %q or status code %d", entry.Method, entry.StatusCode)
+	}
+	if entry.RequestHeaders["Authorization"] != apiLogRedacted {
+		t.Errorf("Authorization header was not redacted: %q", entry.RequestHeaders["Authorization"])
+	}
+	if !strings.Contains(entry.URL, "version=2024-01-01") {
+		t.Errorf("query parameters were dropped from %q", entry.URL)
+	}
+	if entry.RequestBody.(map[string]interface{})["name"] != "vsi" {
+		t.Errorf("request body was not logged: %v", entry.RequestBody)
+	}
+}
+
+func TestAPILogBodyForm(t *testing.T) {
+	body := apiLogBody([]byte("grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey&apikey=secret"), "application/x-www-form-urlencoded")
+	if strings.Contains(body.(string), "secret") || !strings.Contains(body.(string), "grant_type") {
+		t.Errorf("unexpected form body %q", body)
+	}
+	if body := apiLogBody([]byte("binary"), "application/octet-stream"); body != "[6 bytes of application/octet-stream]" {
+		t.Errorf("unexpected binary body %q", body)
+	}
+}
+
+func TestInstrumentServiceAPILog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"r006-1","password":"secret-password"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.EnableRetries(1, 0)
+	session := &clientSession{jsonAPILog: true}
+	session.instrumentService(service)
+	// services sharing a client are instrumented once
+	session.instrumentService(service.Clone())
+
+	builder := core.NewRequestBuilder(core.GET)
+	if _, err = builder.ResolveRequestURL(service.GetServiceURL(), "/v1/instances/{id}", map[string]string{"id": "r006-1"}); err != nil {
+		t.Fatal(err)
+	}
+	builder.AddHeader("Accept", "application/json")
+	req, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if _, err = service.Request(req, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result["password"] != "secret-password" {
+		t.Fatalf("response body was not restored: %v", result)
+	}
+
+	logged := output.String()
+	if strings.Count(logged, "API call: ") != 1 {
+		t.Fatalf("expected 1 API log entry, got: %s", logged)
+	}
+	if strings.Contains(logged, "secret-") || !strings.Contains(logged, "/v1/instances/r006-1") {
+		t.Errorf("unexpected API log entry: %s", logged)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditLogMaxBodySize is the largest response body inspected for the CRN of the resource. Larger
+// responses are passed through untouched.
+const auditLogMaxBodySize = 1 << 20
+
+var (
+	// auditLogCRNPattern matches a URL encoded CRN, whose slashes are escaped, in the path of a request
+	auditLogCRNPattern = regexp.MustCompile(`(?i)crn(?::|%3A)v1(?::|%3A)[^/?&\s"]+`)
+
+	// auditLogCorrelationHeaders are the headers used by the IBM Cloud APIs to identify a request,
+	// in order of preference.
+	auditLogCorrelationHeaders = []string{"X-Correlation-Id", "X-Request-Id", "Transaction-Id", "X-Global-Transaction-Id"}
+)
+
+// AuditLogEntry is one line of the audit log.
+type AuditLogEntry struct {
+	Time          time.Time `json:"time"`
+	Service       string    `json:"service"`
+	Operation     string    `json:"operation"`
+	URL           string    `json:"url"`
+	StatusCode    int       `json:"status_code,omitempty"`
+	ResourceCRN   string    `json:"resource_crn,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	DurationMs    int64     `json:"duration_ms"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// auditLogWriter appends the entries to the audit log file, one JSON document per line.
+type auditLogWriter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (w *auditLogWriter) write(entry AuditLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[DEBUG] Error encoding audit log entry: %s", err)
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err = w.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[DEBUG] Error writing audit log entry to %s: %s", w.file.Name(), err)
+	}
+}
+
+// newAuditLogWriter opens the audit log file at path, in which the client session records every mutating
+// API call made by its clients.
+func newAuditLogWriter(path string) (*auditLogWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error opening the audit log file %s: %s", path, err)
+	}
+	return &auditLogWriter{file: file}, nil
+}
+
+type auditTransport struct {
+	next gohttp.RoundTripper
+	log  *auditLogWriter
+}
+
+func (t *auditTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if !isAuditedRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	entry := AuditLogEntry{
+		Time:       start.UTC(),
+		Service:    req.URL.Hostname(),
+		Operation:  fmt.Sprintf("%s %s", req.Method, req.URL.Path),
+		URL:        auditLogURL(req.URL),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	entry.ResourceCRN = auditLogCRN(req.URL)
+	entry.CorrelationID = auditLogHeader(req.Header)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.StatusCode = resp.StatusCode
+		if id := auditLogHeader(resp.Header); id != "" {
+			entry.CorrelationID = id
+		}
+		if entry.ResourceCRN == "" {
+			entry.ResourceCRN = auditLogResponseCRN(resp)
+		}
+	}
+	t.log.write(entry)
+	return resp, err
+}
+
+// isAuditedRequest reports whether the request mutates a resource. The IAM token requests are
+// excluded, as they only authenticate the provider.
+func isAuditedRequest(req *gohttp.Request) bool {
+	switch req.Method {
+	case gohttp.MethodPost, gohttp.MethodPut, gohttp.MethodPatch, gohttp.MethodDelete:
+	default:
+		return false
+	}
+	return !strings.HasSuffix(req.URL.Path, "/identity/token")
+}
+
+// auditLogURL drops the query string, which can contain secrets such as tokens.
+func auditLogURL(u *url.URL) string {
+	return fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path)
+}
+
+func auditLogHeader(header gohttp.Header) string {
+	for _, name := range auditLogCorrelationHeaders {
+		if v := header.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func auditLogCRN(u *url.URL) string {
+	crn := auditLogCRNPattern.FindString(u.EscapedPath())
+	if unescaped, err := url.PathUnescape(crn); err == nil {
+		return unescaped
+	}
+	return crn
+}
+
+// auditLogResponseCRN reads the crn of the resource from a JSON response and restores the body so that
+// the SDK can still decode it.
+func auditLogResponseCRN(resp *gohttp.Response) string {
+	if resp.Body == nil || resp.ContentLength > auditLogMaxBodySize ||
+		!strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return ""
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, auditLogMaxBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil || len(body) > auditLogMaxBodySize {
+		return ""
+	}
+	var document struct {
+		CRN string `json:"crn"`
+	}
+	if err = json.Unmarshal(body, &document); err != nil {
+		return ""
+	}
+	return document.CRN
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAuditTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Correlation-Id", "correlation-"+r.Method)
+		w.Write([]byte(`{"id":"r006-1","crn":"crn:v1:bluemix:public:is:us-south-1:a/123::instance:r006-1"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	client := &http.Client{Transport: &auditTransport{next: http.DefaultTransport, log: &auditLogWriter{file: file}}}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodDelete} {
+		req, _ := http.NewRequest(method, server.URL+"/v1/instances?version=2024-01-01&token=secret", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"id":"r006-1"`) {
+			t.Fatalf("response body was not restored: %s", body)
+		}
+	}
+
+	audit, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer audit.Close()
+	entries := []AuditLogEntry{}
+	scanner := bufio.NewScanner(audit)
+	for scanner.Scan() {
+		entry := AuditLogEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 audit log entries, got %d", len(entries))
+	}
+	for i, method := range []string{http.MethodPost, http.MethodDelete} {
+		entry := entries[i]
+		if entry.Operation != method+" /v1/instances" {
+			t.Errorf("unexpected operation %q", entry.Operation)
+		}
+		if entry.CorrelationID != "correlation-"+method {
+			t.Errorf("unexpected correlation ID %q", entry.CorrelationID)
+		}
+		if entry.ResourceCRN != "crn:v1:bluemix:public:is:us-south-1:a/123::instance:r006-1" {
+			t.Errorf("unexpected resource CRN %q", entry.ResourceCRN)
+		}
+		if entry.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code %d", entry.StatusCode)
+		}
+		if strings.Contains(entry.URL, "secret") {
+			t.Errorf("query string was recorded in %q", entry.URL)
+		}
+	}
+}
+
+func TestAuditLogCRN(t *testing.T) {
+	u, err := url.Parse("https://api.us-south.databases.cloud.ibm.com/v5/ibm/deployments/crn%3Av1%3Abluemix%3Apublic%3Adatabases-for-postgresql%3Aus-south%3Aa%2F123%3A456%3A%3A/users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	crn := auditLogCRN(u)
+	if crn != "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/123:456::" {
+		t.Errorf("unexpected CRN %q", crn)
+	}
+}
+
+func TestInstrumentService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"r006-1","crn":"crn:v1:bluemix:public:is:us-south-1:a/123::instance:r006-1"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	writer, err := newAuditLogWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.file.Close()
+	session := &clientSession{auditLog: writer}
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.EnableRetries(1, 0)
+	session.instrumentService(service)
+	// services sharing a client are instrumented once
+	session.instrumentService(service.Clone())
+
+	builder := core.NewRequestBuilder(core.POST)
+	if _, err = builder.ResolveRequestURL(service.GetServiceURL(), "/v1/instances", nil); err != nil {
+		t.Fatal(err)
+	}
+	builder.AddHeader("Accept", "application/json")
+	if _, err = builder.SetBodyContentJSON(map[string]interface{}{"name": "vsi"}); err != nil {
+		t.Fatal(err)
+	}
+	req, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if _, err = service.Request(req, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result["id"] != "r006-1" {
+		t.Fatalf("response body was not restored: %v", result)
+	}
+
+	audit, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(audit)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 audit log entry, got %d: %s", len(lines), audit)
+	}
+	entry := AuditLogEntry{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Operation != "POST /v1/instances" || entry.ResourceCRN != "crn:v1:bluemix:public:is:us-south-1:a/123::instance:r006-1" {
+		t.Errorf("unexpected audit log entry %+v", entry)
+	}
+}
+
+func TestAuditLogWriterRetry(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs", "audit.jsonl")
+
+	// the audit log file of a session is opened when the session is created, so a failed open is retried by
+	// the next session and sessions of provider aliases write to their own file
+	if _, err := newAuditLogWriter(path); err == nil {
+		t.Fatal("expected an error opening the audit log file in a missing directory")
+	}
+	if err := os.Mkdir(filepath.Join(dir, "logs"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	writer, err := newAuditLogWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.file.Close()
+
+	other, err := newAuditLogWriter(filepath.Join(dir, "alias.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.file.Close()
+	if other.file.Name() == writer.file.Name() {
+		t.Errorf("sessions share the audit log file %s", other.file.Name())
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"net"
 	gohttp "net/http"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
 	kp "github.com/IBM/keyprotect-go-client"
@@ -196,6 +198,24 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Data source cache settings
+	DataSourceCache DataSourceCacheConfig
+
+	// Naming convention of the resource_naming provider block
+	ResourceNaming ResourceNamingConfig
+
+	// How deprecated images are planned, DeprecatedImagePolicyWarn or DeprecatedImagePolicyError
+	DeprecatedImagePolicy string
+
+	// Path of the JSONL file recording the mutating API calls, the audit log is disabled when empty
+	AuditLogFile string
+
+	// Format of the API calls written to the provider logs, APILogFormatText or APILogFormatJSON
+	APILogFormat string
+
+	// Redact the secrets from the request dumps of the SDKs
+	RedactSensitiveLogs bool
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -217,6 +237,7 @@ type ClientSession interface {
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
+	VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error)
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
 	GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error)
@@ -305,11 +326,20 @@ type ClientSession interface {
 	MqcloudV1() (*mqcloudv1.MqcloudV1, error)
 	VmwareV1() (*vmwarev1.VmwareV1, error)
 	LogsV0() (*logsv0.LogsV0, error)
+	DataSourceCache() DataSourceCacheConfig
+	ResourceNaming() ResourceNamingConfig
+	DeprecatedImagePolicy() string
 }
 
 type clientSession struct {
 	session *Session
 
+	dataSourceCache       DataSourceCacheConfig
+	resourceNaming        ResourceNamingConfig
+	deprecatedImagePolicy string
+	auditLog              *auditLogWriter
+	jsonAPILog            bool
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -334,6 +364,9 @@ type clientSession struct {
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	vulnerabilityAdvisorClientErr error
+	vulnerabilityAdvisorClient    *vulnerabilityadvisorv4.VulnerabilityAdvisorV4
+
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
@@ -695,6 +728,11 @@ func (session clientSession) ContainerRegistryV1() (*containerregistryv1.Contain
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
+// VulnerabilityAdvisorV4 provides Vulnerability Advisor for Container Registry APIs ...
+func (session clientSession) VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error) {
+	return session.vulnerabilityAdvisorClient, session.vulnerabilityAdvisorClientErr
+}
+
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 	if sess.schematicsClientErr != nil {
@@ -839,7 +877,7 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.instrumentTransport(DefaultTransport()))
 		if err != nil {
 			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
@@ -1254,7 +1292,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:               sess,
+		dataSourceCache:       c.DataSourceCache,
+		resourceNaming:        c.ResourceNaming,
+		deprecatedImagePolicy: c.DeprecatedImagePolicy,
+		jsonAPILog:            c.APILogFormat == APILogFormatJSON,
+	}
+	if c.AuditLogFile != "" {
+		if session.auditLog, err = newAuditLogWriter(c.AuditLogFile); err != nil {
+			return nil, err
+		}
 	}
 
 	if sess.BluemixSession == nil {
@@ -1266,6 +1313,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.csConfigErr = errEmptyBluemixCredentials
 		session.csv2ConfigErr = errEmptyBluemixCredentials
 		session.containerRegistryClientErr = errEmptyBluemixCredentials
+		session.vulnerabilityAdvisorClientErr = errEmptyBluemixCredentials
 		session.kpErr = errEmptyBluemixCredentials
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
@@ -1472,7 +1520,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, session.instrumentTransport(DefaultTransport()))
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
@@ -1516,7 +1564,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, session.instrumentTransport(DefaultTransport()))
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
@@ -2014,6 +2062,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	// VULNERABILITY ADVISOR Service
+	// Vulnerability Advisor is served by the regional endpoints of Container Registry
+	vulnerabilityAdvisorClientOptions := &vulnerabilityadvisorv4.VulnerabilityAdvisorV4Options{
+		Authenticator: authenticator,
+		URL:           containerRegistryClientOptions.URL,
+		Account:       core.StringPtr(userConfig.UserAccount),
+	}
+	// Construct the service client.
+	session.vulnerabilityAdvisorClient, err = vulnerabilityadvisorv4.NewVulnerabilityAdvisorV4(vulnerabilityAdvisorClientOptions)
+	if err != nil {
+		session.vulnerabilityAdvisorClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Vulnerability Advisor API service: %q", err)
+	}
+	if session.vulnerabilityAdvisorClient != nil && session.vulnerabilityAdvisorClient.Service != nil {
+		// Enable retries for API calls
+		session.vulnerabilityAdvisorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.vulnerabilityAdvisorClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -3385,8 +3454,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
 
-	if os.Getenv("TF_LOG") != "" {
+	// the JSON API log, installed on the SDK services above, replaces the request dumps of the SDKs
+	session.instrumentSDKServices()
+	if os.Getenv("TF_LOG") != "" && !session.jsonAPILog {
 		logDestination := log.Writer()
+		if c.RedactSensitiveLogs {
+			logDestination = NewRedactingWriter(logDestination)
+		}
 		goLogger := log.New(logDestination, "", log.LstdFlags)
 		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
 	}
@@ -3591,6 +3665,139 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
+// instrumentSDKServices installs the audit log and JSON API log transports of the session on the HTTP clients of
+// its IBM Cloud SDK services. The SDK services send their requests through a pooled transport of their own rather
+// than through http.DefaultTransport or DefaultTransport.
+func (session *clientSession) instrumentSDKServices() {
+	clients := []interface{}{
+		session.appidAPI,
+		session.containerRegistryClient,
+		session.vulnerabilityAdvisorClient,
+		&session.globalTaggingServiceAPIV1,
+		&session.globalSearchServiceAPIV2,
+		session.ibmCloudShellClient,
+		session.cloudDatabasesClient,
+		session.ukoClient,
+		session.pDNSClient,
+		session.pushServiceClient,
+		session.eventNotificationsApiClient,
+		session.appConfigurationClient,
+		session.vpcAPI,
+		session.vpcBetaAPI,
+		session.directlinkAPI,
+		session.dlProviderAPI,
+		session.cosConfigAPI,
+		session.transitgatewayAPI,
+		session.cisZonesV1Client,
+		session.cisAlertsClient,
+		session.cisRulesetsClient,
+		session.cisOriginAuthClient,
+		session.cisDNSRecordsClient,
+		session.cisDNSRecordBulkClient,
+		session.cisGLBPoolClient,
+		session.cisGLBClient,
+		session.cisGLBHealthCheckClient,
+		session.cisIPClient,
+		session.cisRLClient,
+		session.cisPageRuleClient,
+		session.cisEdgeFunctionClient,
+		session.cisSSLClient,
+		session.cisWAFPackageClient,
+		session.cisDomainSettingsClient,
+		session.cisRoutingClient,
+		session.cisWAFGroupClient,
+		session.cisCacheClient,
+		session.cisCustomPageClient,
+		session.cisAccessRuleClient,
+		session.cisUARuleClient,
+		session.cisLockdownClient,
+		session.cisLogpushJobsClient,
+		session.cisRangeAppClient,
+		session.cisWAFRuleClient,
+		session.iamIdentityAPI,
+		session.resourceManagerAPI,
+		session.catalogManagementClient,
+		session.enterpriseManagementClient,
+		session.resourceControllerAPI,
+		session.secretsManagerClient,
+		session.schematicsClient,
+		session.satelliteClient,
+		session.iamPolicyManagementAPI,
+		session.iamAccessGroupsAPI,
+		session.cisMtlsClient,
+		session.cisBotManagementClient,
+		session.cisBotAnalyticsClient,
+		session.cisWebhooksClient,
+		session.cisFiltersClient,
+		session.cisFirewallRulesClient,
+		session.atrackerClientV2,
+		session.metricsRouterClient,
+		session.satelliteLinkClient,
+		session.esSchemaRegistryClient,
+		session.securityAndComplianceCenterClient,
+		session.contextBasedRestrictionsClient,
+		session.cdToolchainClient,
+		session.cdTektonPipelineClient,
+		session.codeEngineClient,
+		session.projectClient,
+		session.usageReportsClient,
+		session.mqcloudClient,
+		session.vmwareClient,
+		session.logsClient,
+	}
+	for _, client := range clients {
+		value := reflect.ValueOf(client)
+		if value.IsNil() {
+			continue
+		}
+		field := value.Elem().FieldByName("Service")
+		if !field.IsValid() {
+			continue
+		}
+		if service, ok := field.Interface().(*core.BaseService); ok {
+			session.instrumentService(service)
+		}
+	}
+
+	// the API Gateway SDK is built on version 3 of the IBM Cloud SDK core, whose services expose their client
+	if session.apigatewayAPI != nil && session.apigatewayAPI.Service != nil && session.apigatewayAPI.Service.Client != nil {
+		client := session.apigatewayAPI.Service.Client
+		client.Transport = session.instrumentTransport(client.Transport)
+	}
+}
+
+// instrumentService wraps the transport of the HTTP client of an IBM Cloud SDK service with the audit log and
+// JSON API log transports of the session.
+func (session *clientSession) instrumentService(service *core.BaseService) {
+	if service == nil {
+		return
+	}
+	client := service.GetHTTPClient()
+	if client == nil {
+		return
+	}
+	client.Transport = session.instrumentTransport(client.Transport)
+}
+
+// instrumentTransport wraps next with the audit log and JSON API log transports of the session. The transport
+// is wrapped once, so services sharing a client, such as cloned ones, are not logged twice.
+func (session clientSession) instrumentTransport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	switch next.(type) {
+	case *auditTransport, *apiLogTransport:
+		return next
+	}
+	if session.auditLog != nil {
+		next = &auditTransport{next: next, log: session.auditLog}
+	}
+	if session.jsonAPILog {
+		next = &apiLogTransport{next: next, logger: log.Default()}
+	}
+	return next
+}
+
 func isRetryable(err error) bool {
 	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
 		switch bmErr.StatusCode() {
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "time"
+
+// DataSourceCacheConfig holds the data source cache settings of a provider configuration. The cache is
+// disabled when TTL is zero.
+type DataSourceCacheConfig struct {
+	TTL    time.Duration
+	Dir    string
+	Region string
+}
+
+// DataSourceCache returns the data source cache settings of the provider configuration.
+func (sess clientSession) DataSourceCache() DataSourceCacheConfig {
+	return sess.dataSourceCache
+}
@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+// Values of the deprecated_image_policy provider argument.
+const (
+	DeprecatedImagePolicyWarn  = "warn"
+	DeprecatedImagePolicyError = "error"
+)
+
+// DeprecatedImagePolicy returns how the resources creating virtual server instances treat an image that is
+// deprecated or scheduled for obsolescence when the plan is built: DeprecatedImagePolicyError when it must
+// fail the plan, DeprecatedImagePolicyWarn otherwise.
+func (sess clientSession) DeprecatedImagePolicy() string {
+	if sess.deprecatedImagePolicy == DeprecatedImagePolicyError {
+		return DeprecatedImagePolicyError
+	}
+	return DeprecatedImagePolicyWarn
+}
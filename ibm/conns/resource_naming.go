@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "strings"
+
+// Values of the case argument of the resource_naming provider block.
+const (
+	ResourceNamingCaseNone  = "none"
+	ResourceNamingCaseLower = "lower"
+	ResourceNamingCaseUpper = "upper"
+)
+
+// Placeholders of the name arguments replaced by the prefix and the suffix of the resource_naming provider
+// block. A name opts in to the naming convention by containing one of them.
+const (
+	ResourceNamingPrefixPlaceholder = "{prefix}"
+	ResourceNamingSuffixPlaceholder = "{suffix}"
+)
+
+// ResourceNamingConfig holds the naming convention of the resource_naming provider block.
+type ResourceNamingConfig struct {
+	Prefix string
+	Suffix string
+	Case   string
+}
+
+// ResourceNaming returns the naming convention of the provider configuration.
+func (sess clientSession) ResourceNaming() ResourceNamingConfig {
+	return sess.resourceNaming
+}
+
+// HasResourceNamingPlaceholder reports whether name opts in to the naming convention.
+func HasResourceNamingPlaceholder(name string) bool {
+	return strings.Contains(name, ResourceNamingPrefixPlaceholder) || strings.Contains(name, ResourceNamingSuffixPlaceholder)
+}
+
+// ResourceName returns name with its placeholders replaced by the prefix and the suffix of the naming
+// convention, and the case rule applied. Names without a placeholder are returned unchanged.
+func (n ResourceNamingConfig) ResourceName(name string) string {
+	if !HasResourceNamingPlaceholder(name) {
+		return name
+	}
+	name = strings.ReplaceAll(name, ResourceNamingPrefixPlaceholder, n.Prefix)
+	name = strings.ReplaceAll(name, ResourceNamingSuffixPlaceholder, n.Suffix)
+	switch n.Case {
+	case ResourceNamingCaseLower:
+		name = strings.ToLower(name)
+	case ResourceNamingCaseUpper:
+		name = strings.ToUpper(name)
+	}
+	return name
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import "testing"
+
+func TestResourceName(t *testing.T) {
+	naming := ResourceNamingConfig{Prefix: "Prod-", Suffix: "-EU", Case: ResourceNamingCaseLower}
+
+	cases := map[string]string{
+		"web":                 "web",
+		"Web":                 "Web",
+		"{prefix}web":         "prod-web",
+		"{prefix}Web{suffix}": "prod-web-eu",
+		"web{suffix}":         "web-eu",
+	}
+	for name, expected := range cases {
+		if actual := naming.ResourceName(name); actual != expected {
+			t.Errorf("ResourceName(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+
+	naming = ResourceNamingConfig{Prefix: "prod-", Case: ResourceNamingCaseUpper}
+	if actual := naming.ResourceName("{prefix}web{suffix}"); actual != "PROD-WEB" {
+		t.Errorf("unexpected upper case name %q", actual)
+	}
+}
@@ -2620,6 +2620,19 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	return taggingResult, nil
 }
 
+// HasAllTags reports whether every tag of want is present in tags.
+func HasAllTags(tags, want *schema.Set) bool {
+	if tags == nil {
+		return want.Len() == 0
+	}
+	for _, tag := range want.List() {
+		if !tags.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPI()
 	if err != nil {
@@ -2949,6 +2962,9 @@ func ResourceRouteModeValidate(diff *schema.ResourceDiff) error {
 			return fmt.Errorf("'profile' must be 'network-fixed', route mode is supported by private network load balancer.")
 		}
 	}
+	if lbprofile == "network-private-path" && lbtype != "private" {
+		return fmt.Errorf("'type' must be 'private', private path load balancers are only supported as private load balancers.")
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +85,12 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestHasAllTags(t *testing.T) {
+	tags := NewStringSet(schema.HashString, []string{"env:prod", "team:a"})
+	assert.True(t, HasAllTags(tags, NewStringSet(schema.HashString, []string{"env:prod"})))
+	assert.True(t, HasAllTags(tags, NewStringSet(schema.HashString, []string{})))
+	assert.False(t, HasAllTags(tags, NewStringSet(schema.HashString, []string{"env:prod", "team:b"})))
+	assert.True(t, HasAllTags(nil, NewStringSet(schema.HashString, []string{})))
+	assert.False(t, HasAllTags(nil, NewStringSet(schema.HashString, []string{"env:prod"})))
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// tagBatchWindow is how long the tags of a new resource wait for other resources getting the
+	// same tags before they are attached.
+	tagBatchWindow = 2 * time.Second
+	// tagBatchMaxResources is the number of resources Global Tagging accepts in a single attach.
+	tagBatchMaxResources = 100
+)
+
+// tagBatch is a set of resources getting the same tags attached with a single call.
+type tagBatch struct {
+	accountID string
+	tagType   string
+	tagNames  []string
+	resources []globaltaggingv1.Resource
+	once      sync.Once
+	done      chan struct{}
+	failed    map[string]bool
+	err       error
+}
+
+// tagAttachFunc attaches the tags of a batch to all its resources, and returns the IDs of the
+// resources the tags could not be attached to.
+type tagAttachFunc func(meta interface{}, batch *tagBatch) ([]string, error)
+
+// tagWorker collects the tags of resources created in the same apply so that resources getting the
+// same tags are tagged with one Global Tagging call instead of one call each.
+type tagWorker struct {
+	mutex   sync.Mutex
+	batches map[string]*tagBatch
+	window  time.Duration
+	attach  tagAttachFunc
+}
+
+var globalTagWorker = &tagWorker{
+	batches: map[string]*tagBatch{},
+	window:  tagBatchWindow,
+	attach:  attachTagBatch,
+}
+
+// AttachGlobalTagsUsingCRN attaches tags to a resource that was just created and has no tags yet.
+// Use it instead of UpdateGlobalTagsUsingCRN at create time for resources whose API does not accept
+// tags in the create request.
+func AttachGlobalTagsUsingCRN(newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
+	news := new(schema.Set)
+	if newList != nil {
+		news = newList.(*schema.Set)
+	}
+	add := make([]string, 0, news.Len())
+	for _, v := range news.List() {
+		add = append(add, fmt.Sprint(v))
+	}
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			add = append(add, strings.Split(schematicTags, ",")...)
+		}
+	}
+	if len(add) == 0 {
+		return nil
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	resource := globaltaggingv1.Resource{ResourceID: PtrToString(resourceID)}
+	if resourceType != "" {
+		resource.ResourceType = PtrToString(resourceType)
+	}
+	if err = globalTagWorker.attachTags(meta, userDetails.UserAccount, tagType, add, resource); err != nil {
+		return err
+	}
+
+	response, errored := WaitForTagsAvailable(meta, resourceID, resourceType, tagType, news, 30*time.Second)
+	if errored != nil {
+		log.Printf(`[ERROR] Error waiting for resource tags %s : %v
+%v`, resourceID, errored, response)
+	}
+	return nil
+}
+
+// attachTags adds resource to the batch of the given tags and waits until the batch is attached.
+func (w *tagWorker) attachTags(meta interface{}, accountID, tagType string, tagNames []string, resource globaltaggingv1.Resource) error {
+	tagNames = append([]string{}, tagNames...)
+	sort.Strings(tagNames)
+	key := fmt.Sprintf("%s|%s|%s", accountID, tagType, strings.Join(tagNames, ","))
+
+	w.mutex.Lock()
+	batch, ok := w.batches[key]
+	if !ok {
+		batch = &tagBatch{
+			accountID: accountID,
+			tagType:   tagType,
+			tagNames:  tagNames,
+			done:      make(chan struct{}),
+		}
+		w.batches[key] = batch
+		time.AfterFunc(w.window, func() { w.flush(meta, key, batch) })
+	}
+	batch.resources = append(batch.resources, resource)
+	full := len(batch.resources) >= tagBatchMaxResources
+	if full {
+		// a full batch takes no more resources, the next resource starts a new batch
+		delete(w.batches, key)
+	}
+	w.mutex.Unlock()
+
+	if full {
+		w.flush(meta, key, batch)
+	}
+	<-batch.done
+	if batch.err != nil {
+		return batch.err
+	}
+	if batch.failed[*resource.ResourceID] {
+		return fmt.Errorf("[ERROR] Error attaching tags %v to %s", tagNames, *resource.ResourceID)
+	}
+	return nil
+}
+
+// flush attaches the tags of batch once, either when the batch window ends or when the batch is full.
+func (w *tagWorker) flush(meta interface{}, key string, batch *tagBatch) {
+	batch.once.Do(func() {
+		w.mutex.Lock()
+		if w.batches[key] == batch {
+			delete(w.batches, key)
+		}
+		w.mutex.Unlock()
+
+		failed, err := w.attach(meta, batch)
+		batch.failed = map[string]bool{}
+		for _, resourceID := range failed {
+			batch.failed[resourceID] = true
+		}
+		batch.err = err
+		close(batch.done)
+	})
+}
+
+func attachTagBatch(meta interface{}, batch *tagBatch) ([]string, error) {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	attachTagOptions := &globaltaggingv1.AttachTagOptions{}
+	attachTagOptions.Resources = batch.resources
+	attachTagOptions.TagNames = batch.tagNames
+	if len(batch.tagType) > 0 {
+		attachTagOptions.TagType = PtrToString(batch.tagType)
+		if batch.tagType == "service" {
+			attachTagOptions.AccountID = PtrToString(batch.accountID)
+		}
+	}
+
+	log.Printf("[DEBUG] Attaching tags %v to %d resources", batch.tagNames, len(batch.resources))
+	result, resp, err := gtClient.AttachTag(attachTagOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error attaching tags %v : %s\n%s", batch.tagNames, err, resp)
+	}
+	failed := []string{}
+	for _, item := range result.Results {
+		if item.IsError != nil && *item.IsError && item.ResourceID != nil {
+			failed = append(failed, *item.ResourceID)
+		}
+	}
+	return failed, nil
+}
@@ -0,0 +1,79 @@
+package flex
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagWorkerBatchesResourcesWithSameTags(t *testing.T) {
+	var mutex sync.Mutex
+	attached := map[string][]string{}
+	worker := &tagWorker{
+		batches: map[string]*tagBatch{},
+		window:  100 * time.Millisecond,
+		attach: func(meta interface{}, batch *tagBatch) ([]string, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, resource := range batch.resources {
+				attached[batch.tagNames[0]] = append(attached[batch.tagNames[0]], *resource.ResourceID)
+			}
+			attached["calls"] = append(attached["calls"], batch.tagType)
+			return []string{"crn-failed"}, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	errs := map[string]error{}
+	for _, r := range []struct{ id, tag string }{{"crn-1", "env:dev"}, {"crn-2", "env:dev"}, {"crn-failed", "env:dev"}, {"crn-3", "env:prod"}} {
+		wg.Add(1)
+		go func(id, tag string) {
+			defer wg.Done()
+			err := worker.attachTags(nil, "account", "user", []string{tag}, globaltaggingv1.Resource{ResourceID: PtrToString(id)})
+			mutex.Lock()
+			errs[id] = err
+			mutex.Unlock()
+		}(r.id, r.tag)
+	}
+	wg.Wait()
+
+	assert.Len(t, attached["calls"], 2)
+	assert.ElementsMatch(t, []string{"crn-1", "crn-2", "crn-failed"}, attached["env:dev"])
+	assert.ElementsMatch(t, []string{"crn-3"}, attached["env:prod"])
+	assert.NoError(t, errs["crn-1"])
+	assert.NoError(t, errs["crn-3"])
+	assert.Error(t, errs["crn-failed"])
+	assert.Empty(t, worker.batches)
+}
+
+func TestTagWorkerSplitsFullBatches(t *testing.T) {
+	var mutex sync.Mutex
+	var sizes []int
+	worker := &tagWorker{
+		batches: map[string]*tagBatch{},
+		window:  time.Minute,
+		attach: func(meta interface{}, batch *tagBatch) ([]string, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			sizes = append(sizes, len(batch.resources))
+			return nil, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2*tagBatchMaxResources; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			assert.NoError(t, worker.attachTags(nil, "account", "user", []string{"env:dev"}, globaltaggingv1.Resource{ResourceID: PtrToString(id)}))
+		}(fmt.Sprintf("crn-%d", i))
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{tagBatchMaxResources, tagBatchMaxResources}, sizes)
+	assert.Empty(t, worker.batches)
+}
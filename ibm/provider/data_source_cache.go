@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cacheableDataSources are the expensive read only data sources whose results can be served from the
+// data source cache when it is enabled with data_source_cache_ttl.
+var cacheableDataSources = map[string]bool{
+	"ibm_is_images":            true,
+	"ibm_is_image_index":       true,
+	"ibm_iam_account_settings": true,
+	"ibm_resource_group":       true,
+}
+
+type dataSourceCacheEntry struct {
+	CreatedAt time.Time              `json:"created_at"`
+	ID        string                 `json:"id"`
+	Values    map[string]interface{} `json:"values"`
+}
+
+// dataSourceCacheConfig returns the data source cache settings of the provider configuration.
+func dataSourceCacheConfig(d *schema.ResourceData) conns.DataSourceCacheConfig {
+	config := conns.DataSourceCacheConfig{
+		TTL:    time.Duration(d.Get("data_source_cache_ttl").(int)) * time.Second,
+		Dir:    d.Get("data_source_cache_dir").(string),
+		Region: d.Get("region").(string),
+	}
+	if config.Dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			cacheDir = os.TempDir()
+		}
+		config.Dir = filepath.Join(cacheDir, "terraform-provider-ibm", "data-sources")
+	}
+	return config
+}
+
+// withDataSourceCache serves the data source from the on-disk cache when an entry for the same
+// arguments, account and region is younger than the configured TTL, and stores the result of the
+// read otherwise. The cache is shared between provider runs so that repeated plans skip the API calls.
+func withDataSourceCache(name string, resource *schema.Resource,
+	read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if read == nil {
+		return nil
+	}
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		cache := meta.(conns.ClientSession).DataSourceCache()
+		if cache.TTL <= 0 {
+			return read(context, d, meta)
+		}
+
+		// Without the account the entries of different accounts could be mixed up, so the cache is skipped
+		account, err := dataSourceCacheAccount(meta)
+		if err != nil {
+			log.Printf("[DEBUG] Data source %s not cached: %s", name, err)
+			return read(context, d, meta)
+		}
+
+		path := filepath.Join(cache.Dir, dataSourceCacheKey(name, resource, d, account, cache.Region)+".json")
+		if entry, ok := readDataSourceCacheEntry(path, cache.TTL); ok {
+			err := restoreDataSourceCacheEntry(resource, d, entry)
+			if err == nil {
+				log.Printf("[DEBUG] Data source %s served from cache %s", name, path)
+				return nil
+			}
+			log.Printf("[DEBUG] Error restoring data source %s from cache %s: %s", name, path, err)
+		}
+
+		diags := read(context, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		entry := dataSourceCacheEntry{
+			CreatedAt: time.Now(),
+			ID:        d.Id(),
+			Values:    map[string]interface{}{},
+		}
+		for key := range resource.Schema {
+			entry.Values[key] = dataSourceCacheValue(d.Get(key))
+		}
+		if err := writeDataSourceCacheEntry(path, entry); err != nil {
+			log.Printf("[DEBUG] Error writing data source %s to cache %s: %s", name, path, err)
+		}
+		return diags
+	}
+}
+
+// dataSourceCacheAccount returns the account of the provider credentials.
+func dataSourceCacheAccount(meta interface{}) (string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	if userDetails == nil || userDetails.UserAccount == "" {
+		return "", fmt.Errorf("the account of the provider credentials is unknown")
+	}
+	return userDetails.UserAccount, nil
+}
+
+// dataSourceCacheKey hashes the data source name, the arguments set in the configuration, the account
+// and the region of the provider.
+func dataSourceCacheKey(name string, resource *schema.Resource, d *schema.ResourceData, account, region string) string {
+	arguments := map[string]interface{}{}
+	for key, s := range resource.Schema {
+		if s.Required || s.Optional {
+			arguments[key] = dataSourceCacheValue(d.Get(key))
+		}
+	}
+	// json.Marshal sorts the map keys, which keeps the key stable across runs
+	body, _ := json.Marshal(map[string]interface{}{
+		"name":      name,
+		"account":   account,
+		"region":    region,
+		"arguments": arguments,
+	})
+	sum := sha256.Sum256(body)
+	return name + "-" + hex.EncodeToString(sum[:])
+}
+
+// dataSourceCacheValue converts the sets of a value returned by d.Get into lists, which can be
+// serialized and passed back to d.Set.
+func dataSourceCacheValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return dataSourceCacheValue(value.List())
+	case []interface{}:
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			list[i] = dataSourceCacheValue(item)
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			m[key] = dataSourceCacheValue(item)
+		}
+		return m
+	}
+	return v
+}
+
+func readDataSourceCacheEntry(path string, ttl time.Duration) (dataSourceCacheEntry, bool) {
+	entry := dataSourceCacheEntry{}
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return entry, false
+	}
+	if err = json.Unmarshal(body, &entry); err != nil {
+		log.Printf("[DEBUG] Ignoring invalid data source cache entry %s: %s", path, err)
+		return entry, false
+	}
+	if entry.ID == "" || time.Since(entry.CreatedAt) > ttl {
+		return entry, false
+	}
+	return entry, true
+}
+
+func restoreDataSourceCacheEntry(resource *schema.Resource, d *schema.ResourceData, entry dataSourceCacheEntry) error {
+	for key := range resource.Schema {
+		value, ok := entry.Values[key]
+		if !ok {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	d.SetId(entry.ID)
+	return nil
+}
+
+// writeDataSourceCacheEntry writes the entry to a temporary file first so that concurrent plans never
+// read a partially written entry. Entries hold data source results, so they are only readable by the owner.
+func writeDataSourceCacheEntry(path string, entry dataSourceCacheEntry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if err = file.Chmod(0600); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if _, err = file.Write(body); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err = file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
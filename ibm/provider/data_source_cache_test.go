@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDataSourceCacheResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"visibility": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func TestDataSourceCacheKey(t *testing.T) {
+	resource := testDataSourceCacheResource()
+	data := func(raw map[string]interface{}) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resource.Schema, raw)
+	}
+	base := dataSourceCacheKey("ibm_is_images", resource, data(map[string]interface{}{"name": "ubuntu", "visibility": []interface{}{"public", "private"}}), "account", "us-south")
+
+	// Set elements are not ordered and computed attributes are not part of the key
+	same := data(map[string]interface{}{"name": "ubuntu", "visibility": []interface{}{"private", "public"}})
+	same.Set("images", []interface{}{"r006-image"})
+	assert.Equal(t, base, dataSourceCacheKey("ibm_is_images", resource, same, "account", "us-south"))
+
+	differences := map[string]string{
+		"data source": dataSourceCacheKey("ibm_is_image_index", resource, data(map[string]interface{}{"name": "ubuntu", "visibility": []interface{}{"public", "private"}}), "account", "us-south"),
+		"argument":    dataSourceCacheKey("ibm_is_images", resource, data(map[string]interface{}{"name": "rhel", "visibility": []interface{}{"public", "private"}}), "account", "us-south"),
+		"account":     dataSourceCacheKey("ibm_is_images", resource, data(map[string]interface{}{"name": "ubuntu", "visibility": []interface{}{"public", "private"}}), "other-account", "us-south"),
+		"region":      dataSourceCacheKey("ibm_is_images", resource, data(map[string]interface{}{"name": "ubuntu", "visibility": []interface{}{"public", "private"}}), "account", "eu-de"),
+	}
+	for difference, key := range differences {
+		assert.NotEqual(t, base, key, "a different %s must give a different key", difference)
+	}
+}
+
+func TestDataSourceCacheEntryTTL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "entry.json")
+	entry := dataSourceCacheEntry{
+		CreatedAt: time.Now().Add(-2 * time.Minute),
+		ID:        "id",
+		Values:    map[string]interface{}{"name": "ubuntu"},
+	}
+	assert.Nil(t, writeDataSourceCacheEntry(path, entry))
+
+	read, ok := readDataSourceCacheEntry(path, 5*time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, "id", read.ID)
+	assert.Equal(t, "ubuntu", read.Values["name"])
+
+	_, ok = readDataSourceCacheEntry(path, time.Minute)
+	assert.False(t, ok, "an entry older than the TTL must not be served")
+
+	_, ok = readDataSourceCacheEntry(filepath.Join(t.TempDir(), "missing.json"), 5*time.Minute)
+	assert.False(t, ok)
+}
+
+func TestDataSourceCacheEntryPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "entry.json")
+	assert.Nil(t, writeDataSourceCacheEntry(path, dataSourceCacheEntry{CreatedAt: time.Now(), ID: "id"}))
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	info, err = os.Stat(filepath.Dir(path))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+}
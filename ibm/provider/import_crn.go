@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// crnImporter resolves the Terraform ID of a resource from the CRN given to terraform import.
+type crnImporter func(ctx context.Context, crn flex.CRN, rawCRN string, meta interface{}) (string, error)
+
+// crnImporters are the resources that can be imported with their CRN in addition to their ID.
+var crnImporters = map[string]crnImporter{
+	"ibm_is_bare_metal_server":                importCRNResource("is", "bare-metal-server"),
+	"ibm_is_dedicated_host":                   importCRNResource("is", "dedicated-host"),
+	"ibm_is_flow_log":                         importCRNResource("is", "flow-log-collector"),
+	"ibm_is_floating_ip":                      importCRNResource("is", "floating-ip"),
+	"ibm_is_image":                            importCRNResource("is", "image"),
+	"ibm_is_instance":                         importCRNResource("is", "instance"),
+	"ibm_is_instance_template":                importCRNResource("is", "instance-template"),
+	"ibm_is_instance_volume_attachment":       importInstanceVolumeAttachmentCRN,
+	"ibm_is_lb":                               importCRNResource("is", "load-balancer"),
+	"ibm_is_network_acl":                      importCRNResource("is", "network-acl"),
+	"ibm_is_placement_group":                  importCRNResource("is", "placement-group"),
+	"ibm_is_public_gateway":                   importCRNResource("is", "public-gateway"),
+	"ibm_is_security_group":                   importCRNResource("is", "security-group"),
+	"ibm_is_share":                            importCRNResource("is", "share"),
+	"ibm_is_snapshot":                         importCRNResource("is", "snapshot"),
+	"ibm_is_ssh_key":                          importCRNResource("is", "key"),
+	"ibm_is_subnet":                           importCRNResource("is", "subnet"),
+	"ibm_is_subnet_network_acl_attachment":    importCRNResource("is", "subnet"),
+	"ibm_is_subnet_public_gateway_attachment": importCRNResource("is", "subnet"),
+	"ibm_is_virtual_endpoint_gateway":         importCRNResource("is", "endpoint-gateway"),
+	"ibm_is_volume":                           importCRNResource("is", "volume"),
+	"ibm_is_vpc":                              importCRNResource("is", "vpc"),
+	"ibm_is_vpn_gateway":                      importCRNResource("is", "vpn"),
+	"ibm_iam_service_id":                      importCRNResource("iam-identity", "serviceid"),
+	"ibm_iam_trusted_profile":                 importCRNResource("iam-identity", "profile"),
+	"ibm_container_cluster":                   importCRNServiceInstance("containers-kubernetes"),
+	"ibm_container_vpc_cluster":               importCRNServiceInstance("containers-kubernetes"),
+	"ibm_cis_domain":                          importCISDomainCRN,
+	"ibm_sm_arbitrary_secret":                 importSecretsManagerCRN("secret"),
+	"ibm_sm_iam_credentials_secret":           importSecretsManagerCRN("secret"),
+	"ibm_sm_imported_certificate":             importSecretsManagerCRN("secret"),
+	"ibm_sm_kv_secret":                        importSecretsManagerCRN("secret"),
+	"ibm_sm_private_certificate":              importSecretsManagerCRN("secret"),
+	"ibm_sm_public_certificate":               importSecretsManagerCRN("secret"),
+	"ibm_sm_service_credentials_secret":       importSecretsManagerCRN("secret"),
+	"ibm_sm_username_password_secret":         importSecretsManagerCRN("secret"),
+	"ibm_sm_secret_group":                     importSecretsManagerCRN("secret-group"),
+}
+
+// withCRNImport lets terraform import take the CRN of a resource registered in crnImporters and
+// resolves it to the ID the resource uses, before running the importer of the resource. Other IDs,
+// and the CRNs of resources whose ID is their CRN, are passed to the importer unchanged.
+func withCRNImport(name string, importer *schema.ResourceImporter) *schema.ResourceImporter {
+	resolve, ok := crnImporters[name]
+	if importer == nil || !ok {
+		return importer
+	}
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if strings.HasPrefix(d.Id(), "crn:") {
+				crn, err := flex.Parse(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error parsing CRN %s for import of %s: %s", d.Id(), name, err)
+				}
+				id, err := resolve(ctx, crn, d.Id(), meta)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error importing %s from CRN %s: %s", name, d.Id(), err)
+				}
+				log.Printf("[DEBUG] Importing %s with ID %s resolved from CRN %s", name, id, d.Id())
+				d.SetId(id)
+			}
+			switch {
+			case importer.StateContext != nil:
+				return importer.StateContext(ctx, d, meta)
+			case importer.State != nil:
+				return importer.State(d, meta)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func checkCRNType(crn flex.CRN, serviceName, resourceType string) error {
+	if crn.ServiceName != serviceName || crn.ResourceType != resourceType {
+		return fmt.Errorf("expected the CRN of a %s %s, got a %s %s", serviceName, resourceType, crn.ServiceName, crn.ResourceType)
+	}
+	return nil
+}
+
+// importCRNResource is used by resources whose ID is the resource segment of their CRN.
+func importCRNResource(serviceName, resourceType string) crnImporter {
+	return func(_ context.Context, crn flex.CRN, _ string, _ interface{}) (string, error) {
+		if err := checkCRNType(crn, serviceName, resourceType); err != nil {
+			return "", err
+		}
+		return crn.Resource, nil
+	}
+}
+
+// importCRNServiceInstance is used by resources whose ID is the service instance segment of their CRN.
+func importCRNServiceInstance(serviceName string) crnImporter {
+	return func(_ context.Context, crn flex.CRN, _ string, _ interface{}) (string, error) {
+		if err := checkCRNType(crn, serviceName, ""); err != nil {
+			return "", err
+		}
+		return crn.ServiceInstance, nil
+	}
+}
+
+// importCISDomainCRN resolves the zone CRN of a CIS domain to `<zone_id>:<cis_crn>`.
+func importCISDomainCRN(_ context.Context, crn flex.CRN, rawCRN string, _ interface{}) (string, error) {
+	if err := checkCRNType(crn, "internet-svcs", "zone"); err != nil {
+		return "", err
+	}
+	cisCRN := strings.TrimSuffix(rawCRN, fmt.Sprintf("%s:%s", crn.ResourceType, crn.Resource)) + ":"
+	return flex.ConvertCisToTfTwoVar(crn.Resource, cisCRN), nil
+}
+
+// importSecretsManagerCRN resolves the CRN of a secret or secret group to `<region>/<instance_id>/<id>`.
+func importSecretsManagerCRN(resourceType string) crnImporter {
+	return func(_ context.Context, crn flex.CRN, _ string, _ interface{}) (string, error) {
+		if err := checkCRNType(crn, "secrets-manager", resourceType); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s/%s", crn.Region, crn.ServiceInstance, crn.Resource), nil
+	}
+}
+
+// importInstanceVolumeAttachmentCRN resolves the CRN of a volume to `<instance_id>/<attachment_id>`
+// of the instance the volume is attached to.
+func importInstanceVolumeAttachmentCRN(ctx context.Context, crn flex.CRN, _ string, meta interface{}) (string, error) {
+	if err := checkCRNType(crn, "is", "volume"); err != nil {
+		return "", err
+	}
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return "", err
+	}
+	volume, response, err := sess.GetVolumeWithContext(ctx, &vpcv1.GetVolumeOptions{
+		ID: &crn.Resource,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting volume %s: %s\n%s", crn.Resource, err, response)
+	}
+	if len(volume.VolumeAttachments) != 1 {
+		return "", fmt.Errorf("volume %s has %d attachments, import the attachment with `<instance_id>/<attachment_id>` instead", crn.Resource, len(volume.VolumeAttachments))
+	}
+	attachment := volume.VolumeAttachments[0]
+	return fmt.Sprintf("%s/%s", *attachment.Instance.ID, *attachment.ID), nil
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// importCRN runs the CRN importer of the resource name on id and returns the resolved ID.
+func importCRN(name, id string, meta interface{}) (string, error) {
+	importer := withCRNImport(name, &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	})
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).Data(nil)
+	d.SetId(id)
+	states, err := importer.StateContext(context.Background(), d, meta)
+	if err != nil {
+		return "", err
+	}
+	return states[0].Id(), nil
+}
+
+func TestWithCRNImport(t *testing.T) {
+	testcases := []struct {
+		name     string
+		resource string
+		id       string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "vpc resource",
+			resource: "ibm_is_vpc",
+			id:       "crn:v1:bluemix:public:is:us-south:a/account::vpc:r006-vpc",
+			expected: "r006-vpc",
+		},
+		{
+			name:     "iam resource",
+			resource: "ibm_iam_service_id",
+			id:       "crn:v1:bluemix:public:iam-identity::a/account::serviceid:ServiceId-1",
+			expected: "ServiceId-1",
+		},
+		{
+			name:     "service instance",
+			resource: "ibm_container_vpc_cluster",
+			id:       "crn:v1:bluemix:public:containers-kubernetes:us-south:a/account:cluster-id::",
+			expected: "cluster-id",
+		},
+		{
+			name:     "cis domain",
+			resource: "ibm_cis_domain",
+			id:       "crn:v1:bluemix:public:internet-svcs:global:a/account:cis-guid:zone:zone-id",
+			expected: "zone-id:crn:v1:bluemix:public:internet-svcs:global:a/account:cis-guid::",
+		},
+		{
+			name:     "secrets manager secret",
+			resource: "ibm_sm_arbitrary_secret",
+			id:       "crn:v1:bluemix:public:secrets-manager:us-south:a/account:sm-guid:secret:secret-id",
+			expected: "us-south/sm-guid/secret-id",
+		},
+		{
+			name:     "secrets manager secret group",
+			resource: "ibm_sm_secret_group",
+			id:       "crn:v1:bluemix:public:secrets-manager:eu-de:a/account:sm-guid:secret-group:group-id",
+			expected: "eu-de/sm-guid/group-id",
+		},
+		{
+			name:     "plain id",
+			resource: "ibm_is_vpc",
+			id:       "r006-vpc",
+			expected: "r006-vpc",
+		},
+		{
+			name:     "resource without crn import",
+			resource: "ibm_resource_instance",
+			id:       "crn:v1:bluemix:public:cloud-object-storage:global:a/account:cos-guid::",
+			expected: "crn:v1:bluemix:public:cloud-object-storage:global:a/account:cos-guid::",
+		},
+		{
+			name:     "malformed crn",
+			resource: "ibm_is_vpc",
+			id:       "crn:v1:bluemix:public:is:us-south",
+			err:      true,
+		},
+		{
+			name:     "wrong service",
+			resource: "ibm_is_vpc",
+			id:       "crn:v1:bluemix:public:iam-identity::a/account::serviceid:ServiceId-1",
+			err:      true,
+		},
+		{
+			name:     "wrong resource type",
+			resource: "ibm_is_subnet",
+			id:       "crn:v1:bluemix:public:is:us-south:a/account::vpc:r006-vpc",
+			err:      true,
+		},
+		{
+			name:     "secret group crn for a secret",
+			resource: "ibm_sm_kv_secret",
+			id:       "crn:v1:bluemix:public:secrets-manager:eu-de:a/account:sm-guid:secret-group:group-id",
+			err:      true,
+		},
+		{
+			name:     "cis instance crn for a domain",
+			resource: "ibm_cis_domain",
+			id:       "crn:v1:bluemix:public:internet-svcs:global:a/account:cis-guid::",
+			err:      true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := importCRN(tc.resource, tc.id, nil)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+// vpcSession is a client session that only serves the VPC client.
+type vpcSession struct {
+	conns.ClientSession
+	client *vpcv1.VpcV1
+}
+
+func (sess vpcSession) VpcV1API() (*vpcv1.VpcV1, error) {
+	return sess.client, nil
+}
+
+func TestWithCRNImportInstanceVolumeAttachment(t *testing.T) {
+	testcases := []struct {
+		name        string
+		attachments string
+		expected    string
+		err         bool
+	}{
+		{
+			name:        "attached volume",
+			attachments: `[{"id": "attachment-id", "instance": {"id": "instance-id"}}]`,
+			expected:    "instance-id/attachment-id",
+		},
+		{
+			name:        "detached volume",
+			attachments: `[]`,
+			err:         true,
+		},
+		{
+			name:        "volume with several attachments",
+			attachments: `[{"id": "attachment-1", "instance": {"id": "instance-1"}}, {"id": "attachment-2", "instance": {"id": "instance-2"}}]`,
+			err:         true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/volumes/volume-id", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"id": "volume-id", "volume_attachments": %s}`, tc.attachments)
+			}))
+			defer server.Close()
+			client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			assert.NoError(t, err)
+
+			id, err := importCRN("ibm_is_instance_volume_attachment", "crn:v1:bluemix:public:is:us-south-1:a/account::volume:volume-id", vpcSession{client: client})
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, id)
+		})
+	}
+
+	_, err := importCRN("ibm_is_instance_volume_attachment", "crn:v1:bluemix:public:is:us-south-1:a/account::instance:instance-id", nil)
+	assert.Error(t, err, "the CRN of an instance is not the CRN of a volume")
+}
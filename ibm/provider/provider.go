@@ -45,6 +45,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/logs"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/metricsrouter"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/monitoring"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/mqcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/pag"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
@@ -224,6 +225,71 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"data_source_cache_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Time in seconds for which the results of expensive read only data sources such as ibm_is_images are cached between runs. The cache is disabled by default.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_DATA_SOURCE_CACHE_TTL", "IBMCLOUD_DATA_SOURCE_CACHE_TTL"}, 0),
+			},
+			"data_source_cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Directory of the data source cache. Defaults to terraform-provider-ibm/data-sources in the user cache directory.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_DATA_SOURCE_CACHE_DIR", "IBMCLOUD_DATA_SOURCE_CACHE_DIR"}, nil),
+			},
+			"redact_sensitive_logs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Redact credentials, connection strings and private keys from the API request and response dumps written to the provider logs when TF_LOG is set.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_REDACT_SENSITIVE_LOGS", "IBMCLOUD_REDACT_SENSITIVE_LOGS"}, true),
+			},
+			"audit_log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a JSONL file to which the provider appends the service, operation, resource CRN, correlation ID and duration of every mutating API call. The audit log is disabled by default.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_AUDIT_LOG_FILE", "IBMCLOUD_AUDIT_LOG_FILE"}, nil),
+			},
+			"api_log_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Format of the API calls written to the provider logs when TF_LOG is set: text for the request dumps of the SDKs, json for one JSON document per call with the secrets of the headers, query strings and bodies redacted. Defaults to text.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_API_LOG_FORMAT", "IBMCLOUD_API_LOG_FORMAT"}, conns.APILogFormatText),
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.APILogFormatText, conns.APILogFormatJSON}),
+			},
+			"deprecated_image_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "How VPC virtual server instances and instance templates referencing a deprecated image, or an image scheduled for obsolescence, are planned: warn logs a warning, error fails the plan. Defaults to warn.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_DEPRECATED_IMAGE_POLICY", "IBMCLOUD_DEPRECATED_IMAGE_POLICY"}, conns.DeprecatedImagePolicyWarn),
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.DeprecatedImagePolicyWarn, conns.DeprecatedImagePolicyError}),
+			},
+			"resource_naming": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Naming convention applied to the name argument of the resources whose name contains the {prefix} or {suffix} placeholder.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the {prefix} placeholder.",
+						},
+						"suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the {suffix} placeholder.",
+						},
+						"case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      conns.ResourceNamingCaseNone,
+							Description:  "Case applied to the names with a placeholder: none, lower or upper. Defaults to none.",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.ResourceNamingCaseNone, conns.ResourceNamingCaseLower, conns.ResourceNamingCaseUpper}),
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -307,8 +373,10 @@ func Provider() *schema.Provider {
 			"ibm_database_connection":                      database.DataSourceIBMDatabaseConnection(),
 			"ibm_database_point_in_time_recovery":          database.DataSourceIBMDatabasePointInTimeRecovery(),
 			"ibm_database_remotes":                         database.DataSourceIBMDatabaseRemotes(),
+			"ibm_database_replication_topology":            database.DataSourceIBMDatabaseReplicationTopology(),
 			"ibm_database_task":                            database.DataSourceIBMDatabaseTask(),
 			"ibm_database_tasks":                           database.DataSourceIBMDatabaseTasks(),
+			"ibm_database_upgrade_preview":                 database.DataSourceIBMDatabaseUpgradePreview(),
 			"ibm_database_backup":                          database.DataSourceIBMDatabaseBackup(),
 			"ibm_database_backups":                         database.DataSourceIBMDatabaseBackups(),
 			"ibm_compute_bare_metal":                       classicinfrastructure.DataSourceIBMComputeBareMetal(),
@@ -341,6 +409,7 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host_flavor":          kubernetes.DataSourceIBMContainerDedicatedHostFlavor(),
 			"ibm_container_dedicated_host_flavors":         kubernetes.DataSourceIBMContainerDedicatedHostFlavors(),
 			"ibm_container_dedicated_host":                 kubernetes.DataSourceIBMContainerDedicatedHost(),
+			"ibm_cr_image_vulnerability_report":            registry.DataIBMContainerRegistryImageVulnerabilityReport(),
 			"ibm_cr_namespaces":                            registry.DataIBMContainerRegistryNamespaces(),
 			"ibm_cloud_shell_account_settings":             cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                               cos.DataSourceIBMCosBucket(),
@@ -368,8 +437,10 @@ func Provider() *schema.Provider {
 			"ibm_iam_authorization_policies":               iampolicy.DataSourceIBMIAMAuthorizationPolicies(),
 			"ibm_iam_user_profile":                         iamidentity.DataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                           iamidentity.DataSourceIBMIAMServiceID(),
+			"ibm_iam_service_ids":                          iamidentity.DataSourceIBMIamServiceIds(),
 			"ibm_iam_service_policy":                       iampolicy.DataSourceIBMIAMServicePolicy(),
 			"ibm_iam_api_key":                              iamidentity.DataSourceIBMIamApiKey(),
+			"ibm_iam_api_keys":                             iamidentity.DataSourceIBMIamApiKeys(),
 			"ibm_iam_trusted_profile":                      iamidentity.DataSourceIBMIamTrustedProfile(),
 			"ibm_iam_trusted_profile_identity":             iamidentity.DataSourceIBMIamTrustedProfileIdentity(),
 			"ibm_iam_trusted_profile_identities":           iamidentity.DataSourceIBMIamTrustedProfileIdentities(),
@@ -380,6 +451,8 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profiles":                     iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":               iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
 			"ibm_iam_user_mfa_enrollments":                 iamidentity.DataSourceIBMIamUserMfaEnrollments(),
+			"ibm_iam_identity_inactivity_report":           iamidentity.DataSourceIBMIamIdentityInactivityReport(),
+			"ibm_iam_mfa_report":                           iamidentity.DataSourceIBMIamMfaReport(),
 			"ibm_iam_account_settings_template":            iamidentity.DataSourceIBMAccountSettingsTemplate(),
 			"ibm_iam_trusted_profile_template":             iamidentity.DataSourceIBMTrustedProfileTemplate(),
 			"ibm_iam_account_settings_template_assignment": iamidentity.DataSourceIBMAccountSettingsTemplateAssignment(),
@@ -431,6 +504,7 @@ func Provider() *schema.Provider {
 			"ibm_is_image":                           vpc.DataSourceIBMISImage(),
 			"ibm_is_images":                          vpc.DataSourceIBMISImages(),
 			"ibm_is_image_export_job":                vpc.DataSourceIBMIsImageExport(),
+			"ibm_is_image_index":                     vpc.DataSourceIBMISImageIndex(),
 			"ibm_is_image_export_jobs":               vpc.DataSourceIBMIsImageExports(),
 			"ibm_is_endpoint_gateway_targets":        vpc.DataSourceIBMISEndpointGatewayTargets(),
 			"ibm_is_instance_group":                  vpc.DataSourceIBMISInstanceGroup(),
@@ -456,6 +530,7 @@ func Provider() *schema.Provider {
 			"ibm_is_instance_network_attachments":    vpc.DataSourceIBMIsInstanceNetworkAttachments(),
 			"ibm_is_instance_network_interface":      vpc.DataSourceIBMIsInstanceNetworkInterface(),
 			"ibm_is_instance_network_interfaces":     vpc.DataSourceIBMIsInstanceNetworkInterfaces(),
+			"ibm_is_instance_console_access":         vpc.DataSourceIBMISInstanceConsoleAccess(),
 			"ibm_is_instance_disk":                   vpc.DataSourceIbmIsInstanceDisk(),
 			"ibm_is_instance_disks":                  vpc.DataSourceIbmIsInstanceDisks(),
 
@@ -480,6 +555,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_pools":                    vpc.DataSourceIBMISLBPools(),
 			"ibm_is_lb_pool_member":              vpc.DataSourceIBMIBLBPoolMember(),
 			"ibm_is_lb_pool_members":             vpc.DataSourceIBMISLBPoolMembers(),
+			"ibm_is_lb_pool_member_health":       vpc.DataSourceIBMISLBPoolMemberHealth(),
 			"ibm_is_lb_profile":                  vpc.DataSourceIBMISLbProfile(),
 			"ibm_is_lb_profiles":                 vpc.DataSourceIBMISLbProfiles(),
 			"ibm_is_lbs":                         vpc.DataSourceIBMISLBS(),
@@ -493,6 +569,7 @@ func Provider() *schema.Provider {
 			"ibm_is_ssh_keys":                    vpc.DataSourceIBMIsSshKeys(),
 			"ibm_is_subnet":                      vpc.DataSourceIBMISSubnet(),
 			"ibm_is_subnets":                     vpc.DataSourceIBMISSubnets(),
+			"ibm_is_subnet_cidr":                 vpc.DataSourceIBMIsSubnetCidr(),
 			"ibm_is_subnet_reserved_ip":          vpc.DataSourceIBMISReservedIP(),
 			"ibm_is_subnet_reserved_ips":         vpc.DataSourceIBMISReservedIPs(),
 			"ibm_is_security_group":              vpc.DataSourceIBMISSecurityGroup(),
@@ -515,6 +592,9 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_network_interface":   vpc.DataSourceIBMIsVirtualNetworkInterface(),
 			"ibm_is_virtual_network_interfaces":  vpc.DataSourceIBMIsVirtualNetworkInterfaces(),
 
+			// private path service gateway
+			"ibm_is_private_path_service_gateway_endpoint_gateway_bindings": vpc.DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings(),
+
 			// vni
 
 			"ibm_is_virtual_network_interface_floating_ip":  vpc.DataSourceIBMIsVirtualNetworkInterfaceFloatingIP(),
@@ -538,7 +618,9 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_address_prefix":              vpc.DataSourceIBMIsVPCAddressPrefix(),
 			"ibm_is_vpn_gateway_connection":          vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":         vpc.DataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpn_gateway_tunnels":             vpc.DataSourceIBMISVPNGatewayTunnels(),
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
+			"ibm_is_vpc_default_security_rules":      vpc.DataSourceIBMISVPCDefaultSecurityRules(),
 			"ibm_is_vpc_routing_table":               vpc.DataSourceIBMIBMIsVPCRoutingTable(),
 			"ibm_is_vpc_routing_tables":              vpc.DataSourceIBMISVPCRoutingTables(),
 			"ibm_is_vpc_routing_table_route":         vpc.DataSourceIBMIBMIsVPCRoutingTableRoute(),
@@ -552,6 +634,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_server_routes":               vpc.DataSourceIBMIsVPNServerRoutes(),
 			"ibm_is_zone":                            vpc.DataSourceIBMISZone(),
 			"ibm_is_zones":                           vpc.DataSourceIBMISZones(),
+			"ibm_is_zone_capabilities":               vpc.DataSourceIBMISZoneCapabilities(),
 			"ibm_is_operating_system":                vpc.DataSourceIBMISOperatingSystem(),
 			"ibm_is_operating_systems":               vpc.DataSourceIBMISOperatingSystems(),
 			"ibm_is_network_acls":                    vpc.DataSourceIBMIsNetworkAcls(),
@@ -582,21 +665,24 @@ func Provider() *schema.Provider {
 			"ibm_app_config_feature":                 appconfiguration.DataSourceIBMAppConfigFeature(),
 			"ibm_app_config_features":                appconfiguration.DataSourceIBMAppConfigFeatures(),
 			"ibm_app_config_property":                appconfiguration.DataSourceIBMAppConfigProperty(),
+			"ibm_app_config_property_evaluation":     appconfiguration.DataSourceIBMAppConfigPropertyEvaluation(),
 			"ibm_app_config_properties":              appconfiguration.DataSourceIBMAppConfigProperties(),
 			"ibm_app_config_segment":                 appconfiguration.DataSourceIBMAppConfigSegment(),
 			"ibm_app_config_segments":                appconfiguration.DataSourceIBMAppConfigSegments(),
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
-			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
-			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
-			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
-			"ibm_resource_key":      resourcecontroller.DataSourceIBMResourceKey(),
-			"ibm_security_group":    classicinfrastructure.DataSourceIBMSecurityGroup(),
-			"ibm_service_instance":  cloudfoundry.DataSourceIBMServiceInstance(),
-			"ibm_service_key":       cloudfoundry.DataSourceIBMServiceKey(),
-			"ibm_service_plan":      cloudfoundry.DataSourceIBMServicePlan(),
-			"ibm_space":             cloudfoundry.DataSourceIBMSpace(),
+			"ibm_resource_quota":     resourcecontroller.DataSourceIBMResourceQuota(),
+			"ibm_resource_group":     resourcemanager.DataSourceIBMResourceGroup(),
+			"ibm_resource_groups":    resourcemanager.DataSourceIBMResourceGroups(),
+			"ibm_resource_instance":  resourcecontroller.DataSourceIBMResourceInstance(),
+			"ibm_resource_instances": resourcecontroller.DataSourceIBMResourceInstances(),
+			"ibm_resource_key":       resourcecontroller.DataSourceIBMResourceKey(),
+			"ibm_security_group":     classicinfrastructure.DataSourceIBMSecurityGroup(),
+			"ibm_service_instance":   cloudfoundry.DataSourceIBMServiceInstance(),
+			"ibm_service_key":        cloudfoundry.DataSourceIBMServiceKey(),
+			"ibm_service_plan":       cloudfoundry.DataSourceIBMServicePlan(),
+			"ibm_space":              cloudfoundry.DataSourceIBMSpace(),
 
 			// Added for Schematics
 			"ibm_schematics_workspace":      schematics.DataSourceIBMSchematicsWorkspace(),
@@ -640,6 +726,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_network":                                power.DataSourceIBMPINetwork(),
 			"ibm_pi_networks":                               power.DataSourceIBMPINetworks(),
 			"ibm_pi_placement_group":                        power.DataSourceIBMPIPlacementGroup(),
+			"ibm_pi_placement_group_compliance":             power.DataSourceIBMPIPlacementGroupCompliance(),
 			"ibm_pi_placement_groups":                       power.DataSourceIBMPIPlacementGroups(),
 			"ibm_pi_public_network":                         power.DataSourceIBMPIPublicNetwork(),
 			"ibm_pi_pvm_snapshots":                          power.DataSourceIBMPIPVMSnapshot(),
@@ -769,7 +856,8 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.DataSourceIBMCmObject(),
 
 			// Added for Resource Tag
-			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resource_tag":     globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resources_search": globaltagging.DataSourceIBMResourcesSearch(),
 
 			// Atracker
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
@@ -827,6 +915,7 @@ func Provider() *schema.Provider {
 			"ibm_en_destinations":              eventnotification.DataSourceIBMEnDestinations(),
 			"ibm_en_topic":                     eventnotification.DataSourceIBMEnTopic(),
 			"ibm_en_topics":                    eventnotification.DataSourceIBMEnTopics(),
+			"ibm_en_topic_rule_filter":         eventnotification.DataSourceIBMEnTopicRuleFilter(),
 			"ibm_en_subscriptions":             eventnotification.DataSourceIBMEnSubscriptions(),
 			"ibm_en_destination_webhook":       eventnotification.DataSourceIBMEnWebhookDestination(),
 			"ibm_en_destination_android":       eventnotification.DataSourceIBMEnFCMDestination(),
@@ -1000,6 +1089,9 @@ func Provider() *schema.Provider {
 			"ibm_cis_tls_settings":               cis.ResourceIBMCISTLSSettings(),
 			"ibm_cis_waf_package":                cis.ResourceIBMCISWAFPackage(),
 			"ibm_cis_webhook":                    cis.ResourceIBMCISWebhooks(),
+			"ibm_cis_secondary_dns":              cis.ResourceIBMCISSecondaryDNS(),
+			"ibm_cis_secondary_dns_peer":         cis.ResourceIBMCISSecondaryDNSPeer(),
+			"ibm_cis_secondary_dns_tsig":         cis.ResourceIBMCISSecondaryDNSTsig(),
 			"ibm_cis_origin_auth":                cis.ResourceIBMCISOriginAuthPull(),
 			"ibm_cis_mtls":                       cis.ResourceIBMCISMtls(),
 			"ibm_cis_mtls_app":                   cis.ResourceIBMCISMtlsApp(),
@@ -1012,6 +1104,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_custom_page":                cis.ResourceIBMCISCustomPage(),
 			"ibm_cis_waf_rule":                   cis.ResourceIBMCISWAFRule(),
 			"ibm_cis_certificate_order":          cis.ResourceIBMCISCertificateOrder(),
+			"ibm_cis_origin_certificate_order":   cis.ResourceIBMCISOriginCertificateOrder(),
 			"ibm_cis_filter":                     cis.ResourceIBMCISFilter(),
 			"ibm_cis_firewall_rule":              cis.ResourceIBMCISFirewallrules(),
 			"ibm_cis_ruleset":                    cis.ResourceIBMCISRuleset(),
@@ -1021,6 +1114,7 @@ func Provider() *schema.Provider {
 
 			"ibm_cloudant":                                 cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                        cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_replication":                     cloudant.ResourceIBMCloudantReplication(),
 			"ibm_cloud_shell_account_settings":             cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":                  classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":                 classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -1035,6 +1129,7 @@ func Provider() *schema.Provider {
 			"ibm_compute_user":                             classicinfrastructure.ResourceIBMComputeUser(),
 			"ibm_compute_vm_instance":                      classicinfrastructure.ResourceIBMComputeVmInstance(),
 			"ibm_container_addons":                         kubernetes.ResourceIBMContainerAddOns(),
+			"ibm_container_cluster_autoscaler":             kubernetes.ResourceIBMContainerClusterAutoscaler(),
 			"ibm_container_alb":                            kubernetes.ResourceIBMContainerALB(),
 			"ibm_container_alb_create":                     kubernetes.ResourceIBMContainerAlbCreate(),
 			"ibm_container_api_key_reset":                  kubernetes.ResourceIBMContainerAPIKeyReset(),
@@ -1060,11 +1155,15 @@ func Provider() *schema.Provider {
 			"ibm_cr_retention_policy":                      registry.ResourceIBMCrRetentionPolicy(),
 			"ibm_ob_logging":                               kubernetes.ResourceIBMObLogging(),
 			"ibm_ob_monitoring":                            kubernetes.ResourceIBMObMonitoring(),
+			"ibm_ob_alert":                                 monitoring.AddMonitoringInstanceFields(monitoring.ResourceIBMObAlert()),
+			"ibm_ob_notification_channel":                  monitoring.AddMonitoringInstanceFields(monitoring.ResourceIBMObNotificationChannel()),
 			"ibm_cos_bucket":                               cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":              cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":     cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":         cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_object_lock_legal_hold":               cos.ResourceIBMCOSObjectLockLegalHold(),
+			"ibm_cos_object_lock_retention":                cos.ResourceIBMCOSObjectLockRetention(),
 			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1123,6 +1222,12 @@ func Provider() *schema.Provider {
 			"ibm_is_bare_metal_server_network_interface":             vpc.ResourceIBMIsBareMetalServerNetworkInterface(),
 			"ibm_is_bare_metal_server":                               vpc.ResourceIBMIsBareMetalServer(),
 
+			// private path service gateway
+			"ibm_is_private_path_service_gateway":                                     vpc.ResourceIBMIsPrivatePathServiceGateway(),
+			"ibm_is_private_path_service_gateway_operations":                          vpc.ResourceIBMIsPrivatePathServiceGatewayOperations(),
+			"ibm_is_private_path_service_gateway_account_policy":                      vpc.ResourceIBMIsPrivatePathServiceGatewayAccountPolicy(),
+			"ibm_is_private_path_service_gateway_endpoint_gateway_binding_operations": vpc.ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperations(),
+
 			"ibm_is_dedicated_host":                         vpc.ResourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                   vpc.ResourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":         vpc.ResourceIBMISDedicatedHostDiskManagement(),
@@ -1167,6 +1272,7 @@ func Provider() *schema.Provider {
 			"ibm_is_subnet_reserved_ip":                     vpc.ResourceIBMISReservedIP(),
 			"ibm_is_subnet_network_acl_attachment":          vpc.ResourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_subnet_public_gateway_attachment":       vpc.ResourceIBMISSubnetPublicGatewayAttachment(),
+			"ibm_is_vpc_zonal_public_gateways":              vpc.ResourceIBMISVPCZonalPublicGateways(),
 			"ibm_is_subnet_routing_table_attachment":        vpc.ResourceIBMISSubnetRoutingTableAttachment(),
 			"ibm_is_ssh_key":                                vpc.ResourceIBMISSSHKey(),
 			"ibm_is_snapshot":                               vpc.ResourceIBMSnapshot(),
@@ -1182,6 +1288,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_dns_resolution_binding":             vpc.ResourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_routing_table":                      vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                vpc.ResourceIBMISVPCRoutingTableRoute(),
+			"ibm_is_vpc_routing_table_routes":               vpc.ResourceIBMISVPCRoutingTableRoutes(),
 			"ibm_is_vpn_server":                             vpc.ResourceIBMIsVPNServer(),
 			"ibm_is_vpn_server_client":                      vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                       vpc.ResourceIBMIsVPNServerRoute(),
@@ -1215,6 +1322,7 @@ func Provider() *schema.Provider {
 			"ibm_app_config_property":                       appconfiguration.ResourceIBMIbmAppConfigProperty(),
 			"ibm_app_config_segment":                        appconfiguration.ResourceIBMIbmAppConfigSegment(),
 			"ibm_app_config_snapshot":                       appconfiguration.ResourceIBMIbmAppConfigSnapshot(),
+			"ibm_app_config_snapshot_action":                appconfiguration.ResourceIBMAppConfigSnapshotAction(),
 			"ibm_kms_key":                                   kms.ResourceIBMKmskey(),
 			"ibm_kms_key_with_policy_overrides":             kms.ResourceIBMKmsKeyWithPolicyOverrides(),
 			"ibm_kms_key_alias":                             kms.ResourceIBMKmskeyAlias(),
@@ -1252,8 +1360,10 @@ func Provider() *schema.Provider {
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
+			"ibm_pi_instance_clone":                  power.ResourceIBMPIInstanceClone(),
 			"ibm_pi_ipsec_policy":                    power.ResourceIBMPIIPSecPolicy(),
 			"ibm_pi_key":                             power.ResourceIBMPIKey(),
+			"ibm_pi_key_sync":                        power.ResourceIBMPIKeySync(),
 			"ibm_pi_network_port_attach":             power.ResourceIBMPINetworkPortAttach(),
 			"ibm_pi_network":                         power.ResourceIBMPINetwork(),
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
@@ -1312,7 +1422,9 @@ func Provider() *schema.Provider {
 			"ibm_enterprise_account":       enterprise.ResourceIBMEnterpriseAccount(),
 
 			// //Added for Usage Reports
-			"ibm_billing_report_snapshot": usagereports.ResourceIBMBillingReportSnapshot(),
+			"ibm_billing_report_snapshot":     usagereports.ResourceIBMBillingReportSnapshot(),
+			"ibm_billing_budget":              usagereports.ResourceIBMBillingBudget(),
+			"ibm_billing_budget_notification": usagereports.ResourceIBMBillingBudgetNotification(),
 
 			// Added for Schematics
 			"ibm_schematics_workspace":      schematics.ResourceIBMSchematicsWorkspace(),
@@ -1377,6 +1489,8 @@ func Provider() *schema.Provider {
 			// MQ on Cloud
 			"ibm_mqcloud_queue_manager":          mqcloud.ResourceIbmMqcloudQueueManager(),
 			"ibm_mqcloud_application":            mqcloud.ResourceIbmMqcloudApplication(),
+			"ibm_mqcloud_application_api_key":    mqcloud.ResourceIbmMqcloudApplicationApiKey(),
+			"ibm_mqcloud_channel":                mqcloud.ResourceIbmMqcloudChannel(),
 			"ibm_mqcloud_user":                   mqcloud.ResourceIbmMqcloudUser(),
 			"ibm_mqcloud_keystore_certificate":   mqcloud.ResourceIbmMqcloudKeystoreCertificate(),
 			"ibm_mqcloud_truststore_certificate": mqcloud.ResourceIbmMqcloudTruststoreCertificate(),
@@ -1491,12 +1605,14 @@ func Provider() *schema.Provider {
 			"ibm_code_engine_secret":         codeengine.ResourceIbmCodeEngineSecret(),
 
 			// Added for Project
-			"ibm_project":             project.ResourceIbmProject(),
-			"ibm_project_config":      project.ResourceIbmProjectConfig(),
-			"ibm_project_environment": project.ResourceIbmProjectEnvironment(),
+			"ibm_project":                   project.ResourceIbmProject(),
+			"ibm_project_config":            project.ResourceIbmProjectConfig(),
+			"ibm_project_config_deployment": project.ResourceIbmProjectConfigDeployment(),
+			"ibm_project_environment":       project.ResourceIbmProjectEnvironment(),
 
 			// Added for VMware as a Service
-			"ibm_vmaas_vdc": vmware.ResourceIbmVmaasVdc(),
+			"ibm_vmaas_vdc":           vmware.ResourceIbmVmaasVdc(),
+			"ibm_vmaas_director_site": vmware.ResourceIbmVmaasDirectorSite(),
 			// Logs Service
 			"ibm_logs_alert":            logs.AddLogsInstanceFields(logs.ResourceIbmLogsAlert()),
 			"ibm_logs_rule_group":       logs.AddLogsInstanceFields(logs.ResourceIbmLogsRuleGroup()),
@@ -1511,47 +1627,45 @@ func Provider() *schema.Provider {
 		ConfigureFunc: providerConfigure,
 	}
 
-	wrappedProvider := wrapProvider(provider)
-	return &wrappedProvider
+	return wrapProvider(provider)
 }
 
-func wrapProvider(provider schema.Provider) schema.Provider {
-	wrappedResourcesMap := map[string]*schema.Resource{}
-	wrappedDataSourcesMap := map[string]*schema.Resource{}
+func wrapProvider(provider schema.Provider) *schema.Provider {
+	wrappedProvider := &schema.Provider{
+		Schema:         provider.Schema,
+		DataSourcesMap: map[string]*schema.Resource{},
+		ResourcesMap:   map[string]*schema.Resource{},
+		ConfigureFunc:  provider.ConfigureFunc,
+	}
 
 	for key, value := range provider.ResourcesMap {
-		wrappedResourcesMap[key] = wrapResource(key, value)
+		wrappedProvider.ResourcesMap[key] = wrapResource(key, value, wrappedProvider.Meta)
 	}
 
 	for key, value := range provider.DataSourcesMap {
-		wrappedDataSourcesMap[key] = wrapDataSource(key, value)
+		wrappedProvider.DataSourcesMap[key] = wrapDataSource(key, value)
 	}
 
-	return schema.Provider{
-		Schema:         provider.Schema,
-		DataSourcesMap: wrappedDataSourcesMap,
-		ResourcesMap:   wrappedResourcesMap,
-		ConfigureFunc:  provider.ConfigureFunc,
-	}
+	return wrappedProvider
 }
 
-func wrapResource(name string, resource *schema.Resource) *schema.Resource {
+func wrapResource(name string, resource *schema.Resource, providerMeta func() interface{}) *schema.Resource {
 	return &schema.Resource{
-		Schema:               resource.Schema,
+		Schema:               withResourceNaming(resource.Schema, providerMeta),
 		SchemaVersion:        resource.SchemaVersion,
 		MigrateState:         resource.MigrateState,
 		StateUpgraders:       resource.StateUpgraders,
 		Exists:               resource.Exists,
-		CreateContext:        wrapFunction(name, "create", resource.CreateContext, resource.Create, false),
+		CreateContext:        withResourceName(resource.Schema, wrapFunction(name, "create", resource.CreateContext, resource.Create, false)),
 		ReadContext:          wrapFunction(name, "read", resource.ReadContext, resource.Read, false),
-		UpdateContext:        wrapFunction(name, "update", resource.UpdateContext, resource.Update, false),
+		UpdateContext:        withResourceName(resource.Schema, wrapFunction(name, "update", resource.UpdateContext, resource.Update, false)),
 		DeleteContext:        wrapFunction(name, "delete", resource.DeleteContext, resource.Delete, false),
-		CreateWithoutTimeout: wrapFunction(name, "create", resource.CreateWithoutTimeout, nil, false),
+		CreateWithoutTimeout: withResourceName(resource.Schema, wrapFunction(name, "create", resource.CreateWithoutTimeout, nil, false)),
 		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
-		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
+		UpdateWithoutTimeout: withResourceName(resource.Schema, wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false)),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
 		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
-		Importer:             resource.Importer,
+		Importer:             withCRNImport(name, resource.Importer),
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
 		Description:          resource.Description,
@@ -1560,13 +1674,17 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 }
 
 func wrapDataSource(name string, resource *schema.Resource) *schema.Resource {
+	readContext := wrapFunction(name, "read", resource.ReadContext, resource.Read, true)
+	if cacheableDataSources[name] {
+		readContext = withDataSourceCache(name, resource, readContext)
+	}
 	return &schema.Resource{
 		Schema:             resource.Schema,
 		SchemaVersion:      resource.SchemaVersion,
 		MigrateState:       resource.MigrateState,
 		StateUpgraders:     resource.StateUpgraders,
 		Exists:             resource.Exists,
-		ReadContext:        wrapFunction(name, "read", resource.ReadContext, resource.Read, true),
+		ReadContext:        readContext,
 		ReadWithoutTimeout: wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, true),
 		Importer:           resource.Importer,
 		DeprecationMessage: resource.DeprecationMessage,
@@ -1691,9 +1809,13 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_range_app":                            cis.ResourceIBMCISRangeAppValidator(),
 				"ibm_cis_waf_rule":                             cis.ResourceIBMCISWAFRuleValidator(),
 				"ibm_cis_certificate_order":                    cis.ResourceIBMCISCertificateOrderValidator(),
+				"ibm_cis_origin_certificate_order":             cis.ResourceIBMCISOriginCertificateOrderValidator(),
 				"ibm_cis_filter":                               cis.ResourceIBMCISFilterValidator(),
 				"ibm_cis_firewall_rules":                       cis.ResourceIBMCISFirewallrulesValidator(),
 				"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooksValidator(),
+				"ibm_cis_secondary_dns":                        cis.ResourceIBMCISSecondaryDNSValidator(),
+				"ibm_cis_secondary_dns_peer":                   cis.ResourceIBMCISSecondaryDNSPeerValidator(),
+				"ibm_cis_secondary_dns_tsig":                   cis.ResourceIBMCISSecondaryDNSTsigValidator(),
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDnsRecordsImportValidator(),
@@ -1719,6 +1841,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cr_namespace":                             registry.ResourceIBMCrNamespaceValidator(),
 				"ibm_tg_gateway":                               transitgateway.ResourceIBMTGValidator(),
 				"ibm_app_config_feature":                       appconfiguration.ResourceIBMAppConfigFeatureValidator(),
+				"ibm_app_config_snapshot_action":               appconfiguration.ResourceIBMAppConfigSnapshotActionValidator(),
 				"ibm_tg_connection":                            transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
 				"ibm_tg_connection_action":                     transitgateway.ResourceIBMTransitGatewayConnectionActionValidator(),
 				"ibm_tg_connection_prefix_filter":              transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilterValidator(),
@@ -1741,6 +1864,8 @@ func Validator() validate.ValidatorDict {
 				// MQ on Cloud
 				"ibm_mqcloud_queue_manager":          mqcloud.ResourceIbmMqcloudQueueManagerValidator(),
 				"ibm_mqcloud_application":            mqcloud.ResourceIbmMqcloudApplicationValidator(),
+				"ibm_mqcloud_application_api_key":    mqcloud.ResourceIbmMqcloudApplicationApiKeyValidator(),
+				"ibm_mqcloud_channel":                mqcloud.ResourceIbmMqcloudChannelValidator(),
 				"ibm_mqcloud_user":                   mqcloud.ResourceIbmMqcloudUserValidator(),
 				"ibm_mqcloud_keystore_certificate":   mqcloud.ResourceIbmMqcloudKeystoreCertificateValidator(),
 				"ibm_mqcloud_truststore_certificate": mqcloud.ResourceIbmMqcloudTruststoreCertificateValidator(),
@@ -1875,6 +2000,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerValidator(),
 
 				"ibm_container_addons":                      kubernetes.ResourceIBMContainerAddOnsValidator(),
+				"ibm_container_cluster_autoscaler":          kubernetes.ResourceIBMContainerClusterAutoscalerValidator(),
 				"ibm_container_alb_create":                  kubernetes.ResourceIBMContainerAlbCreateValidator(),
 				"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDnsValidator(),
 				"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNewValidator(),
@@ -1905,7 +2031,9 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_policy_template_version": iampolicy.ResourceIBMIAMPolicyTemplateVersionValidator(),
 
 				// // Added for Usage Reports
-				"ibm_billing_report_snapshot": usagereports.ResourceIBMBillingReportSnapshotValidator(),
+				"ibm_billing_report_snapshot":     usagereports.ResourceIBMBillingReportSnapshotValidator(),
+				"ibm_billing_budget":              usagereports.ResourceIBMBillingBudgetValidator(),
+				"ibm_billing_budget_notification": usagereports.ResourceIBMBillingBudgetNotificationValidator(),
 
 				// // Added for Secrets Manager
 				"ibm_sm_secret_group":                                                secretsmanager.ResourceIbmSmSecretGroupValidator(),
@@ -1924,9 +2052,10 @@ func Validator() validate.ValidatorDict {
 				"ibm_code_engine_secret":         codeengine.ResourceIbmCodeEngineSecretValidator(),
 
 				// Added for Project
-				"ibm_project":             project.ResourceIbmProjectValidator(),
-				"ibm_project_config":      project.ResourceIbmProjectConfigValidator(),
-				"ibm_project_environment": project.ResourceIbmProjectEnvironmentValidator(),
+				"ibm_project":                   project.ResourceIbmProjectValidator(),
+				"ibm_project_config":            project.ResourceIbmProjectConfigValidator(),
+				"ibm_project_config_deployment": project.ResourceIbmProjectConfigDeploymentValidator(),
+				"ibm_project_environment":       project.ResourceIbmProjectEnvironmentValidator(),
 
 				// Added for Event Notifications
 
@@ -1935,6 +2064,7 @@ func Validator() validate.ValidatorDict {
 
 				// Added for VMware as a Service
 				"ibm_vmaas_vdc":             vmware.ResourceIbmVmaasVdcValidator(),
+				"ibm_vmaas_director_site":   vmware.ResourceIbmVmaasDirectorSiteValidator(),
 				"ibm_logs_alert":            logs.ResourceIbmLogsAlertValidator(),
 				"ibm_logs_rule_group":       logs.ResourceIbmLogsRuleGroupValidator(),
 				"ibm_logs_outgoing_webhook": logs.ResourceIbmLogsOutgoingWebhookValidator(),
@@ -1943,21 +2073,34 @@ func Validator() validate.ValidatorDict {
 				"ibm_logs_e2m":              logs.ResourceIbmLogsE2mValidator(),
 				"ibm_logs_view":             logs.ResourceIbmLogsViewValidator(),
 				"ibm_logs_view_folder":      logs.ResourceIbmLogsViewFolderValidator(),
+
+				// private path service gateway
+				"ibm_is_private_path_service_gateway":                                     vpc.ResourceIBMIsPrivatePathServiceGatewayValidator(),
+				"ibm_is_private_path_service_gateway_account_policy":                      vpc.ResourceIBMIsPrivatePathServiceGatewayAccountPolicyValidator(),
+				"ibm_is_private_path_service_gateway_endpoint_gateway_binding_operations": vpc.ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsValidator(),
+				// Added for Cloud Monitoring
+				"ibm_ob_alert":                monitoring.ResourceIBMObAlertValidator(),
+				"ibm_ob_notification_channel": monitoring.ResourceIBMObNotificationChannelValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*validate.ResourceValidator{
 				"ibm_is_subnet":                     vpc.DataSourceIBMISSubnetValidator(),
 				"ibm_is_snapshot_consistency_group": vpc.DataSourceIBMISSnapshotConsistencyGroupValidator(),
 				"ibm_is_snapshot":                   vpc.DataSourceIBMISSnapshotValidator(),
 				"ibm_is_images":                     vpc.DataSourceIBMISImagesValidator(),
+				"ibm_is_subnet_cidr":                vpc.DataSourceIBMIsSubnetCidrValidator(),
 				"ibm_dl_offering_speeds":            directlink.DataSourceIBMDLOfferingSpeedsValidator(),
 				"ibm_dl_routers":                    directlink.DataSourceIBMDLRoutersValidator(),
 				"ibm_resource_instance":             resourcecontroller.DataSourceIBMResourceInstanceValidator(),
+				"ibm_resource_instances":            resourcecontroller.DataSourceIBMResourceInstancesValidator(),
 				"ibm_resource_key":                  resourcecontroller.DataSourceIBMResourceKeyValidator(),
 				"ibm_resource_group":                resourcemanager.DataSourceIBMResourceGroupValidator(),
 
 				// bare_metal_server
 				"ibm_is_bare_metal_server": vpc.DataSourceIBMIsBareMetalServerValidator(),
 
+				// private path service gateway
+				"ibm_is_private_path_service_gateway_endpoint_gateway_bindings": vpc.DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsValidator(),
+
 				"ibm_is_vpc":                          vpc.DataSourceIBMISVpcValidator(),
 				"ibm_is_volume":                       vpc.DataSourceIBMISVolumeValidator(),
 				"ibm_cis_webhooks":                    cis.DataSourceIBMCISAlertWebhooksValidator(),
@@ -2000,6 +2143,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_database_point_in_time_recovery": database.DataSourceIBMDatabasePointInTimeRecoveryValidator(),
 				"ibm_database_remotes":                database.DataSourceIBMDatabaseRemotesValidator(),
 				"ibm_database_tasks":                  database.DataSourceIBMDatabaseTasksValidator(),
+				"ibm_database_upgrade_preview":        database.DataSourceIBMDatabaseUpgradePreviewValidator(),
 				"ibm_database":                        database.DataSourceIBMDatabaseInstanceValidator(),
 
 				"ibm_container_addons":                  kubernetes.DataSourceIBMContainerAddOnsValidator(),
@@ -2114,24 +2258,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:         bluemixAPIKey,
+		Region:                region,
+		ResourceGroup:         resourceGrp,
+		BluemixTimeout:        time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:      time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:     softlayerUsername,
+		SoftLayerAPIKey:       softlayerAPIKey,
+		RetryCount:            retryCount,
+		SoftLayerEndpointURL:  softlayerEndpointUrl,
+		RetryDelay:            conns.RetryAPIDelay,
+		FunctionNameSpace:     wskNameSpace,
+		RiaasEndPoint:         riaasEndPoint,
+		IAMToken:              iamToken,
+		IAMRefreshToken:       iamRefreshToken,
+		Zone:                  zone,
+		Visibility:            visibility,
+		EndpointsFile:         file,
+		IAMTrustedProfileID:   iamTrustedProfileId,
+		DataSourceCache:       dataSourceCacheConfig(d),
+		ResourceNaming:        resourceNamingConfig(d),
+		DeprecatedImagePolicy: d.Get("deprecated_image_policy").(string),
+		AuditLogFile:          d.Get("audit_log_file").(string),
+		APILogFormat:          d.Get("api_log_format").(string),
+		RedactSensitiveLogs:   d.Get("redact_sensitive_logs").(bool),
 	}
 
 	return config.ClientSession()
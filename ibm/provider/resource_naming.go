@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceNamingConfig returns the naming convention of the resource_naming provider block.
+func resourceNamingConfig(d *schema.ResourceData) conns.ResourceNamingConfig {
+	config := conns.ResourceNamingConfig{
+		Prefix: d.Get("resource_naming.0.prefix").(string),
+		Suffix: d.Get("resource_naming.0.suffix").(string),
+		Case:   d.Get("resource_naming.0.case").(string),
+	}
+	if config.Case == "" {
+		config.Case = conns.ResourceNamingCaseNone
+	}
+	return config
+}
+
+// resourceNamingSession is the part of conns.ClientSession holding the naming convention.
+type resourceNamingSession interface {
+	ResourceNaming() conns.ResourceNamingConfig
+}
+
+// resourceNaming returns the naming convention of the configured provider, and false when the provider is not
+// configured yet.
+func resourceNaming(meta interface{}) (conns.ResourceNamingConfig, bool) {
+	session, ok := meta.(resourceNamingSession)
+	if !ok {
+		return conns.ResourceNamingConfig{}, false
+	}
+	return session.ResourceNaming(), true
+}
+
+// hasResourceNaming reports whether the name argument of a resource schema can use the naming convention.
+func hasResourceNaming(resourceSchema map[string]*schema.Schema) bool {
+	nameSchema, ok := resourceSchema["name"]
+	return ok && nameSchema.Type == schema.TypeString && (nameSchema.Required || nameSchema.Optional)
+}
+
+// withResourceNaming returns the schema of a resource whose name argument applies the naming convention of
+// the resource_naming provider block. The names are stored in the state with the placeholders replaced, so a
+// name read back from the API does not show a difference. providerMeta returns the meta of the provider, as
+// the StateFunc of the name has no access to it.
+//
+// Terraform validates the configuration before it configures the provider, so a name with a placeholder
+// can't be validated then. Its validation is left to withResourceName, which validates the replaced name.
+func withResourceNaming(resourceSchema map[string]*schema.Schema, providerMeta func() interface{}) map[string]*schema.Schema {
+	if !hasResourceNaming(resourceSchema) {
+		return resourceSchema
+	}
+	nameSchema := resourceSchema["name"]
+
+	namedSchema := *nameSchema
+	stateFunc := nameSchema.StateFunc
+	namedSchema.StateFunc = func(v interface{}) string {
+		name := v.(string)
+		if naming, ok := resourceNaming(providerMeta()); ok {
+			name = naming.ResourceName(name)
+		}
+		if stateFunc != nil {
+			return stateFunc(name)
+		}
+		return name
+	}
+	if validateFunc := nameSchema.ValidateFunc; validateFunc != nil {
+		namedSchema.ValidateFunc = func(v interface{}, k string) ([]string, []error) {
+			if name, ok := v.(string); ok && conns.HasResourceNamingPlaceholder(name) {
+				return nil, nil
+			}
+			return validateFunc(v, k)
+		}
+	}
+	if validateDiagFunc := nameSchema.ValidateDiagFunc; validateDiagFunc != nil {
+		namedSchema.ValidateDiagFunc = func(v interface{}, path cty.Path) diag.Diagnostics {
+			if name, ok := v.(string); ok && conns.HasResourceNamingPlaceholder(name) {
+				return nil
+			}
+			return validateDiagFunc(v, path)
+		}
+	}
+
+	namedResourceSchema := make(map[string]*schema.Schema, len(resourceSchema))
+	for k, v := range resourceSchema {
+		namedResourceSchema[k] = v
+	}
+	namedResourceSchema["name"] = &namedSchema
+	return namedResourceSchema
+}
+
+// validateResourceName validates a name with the placeholders replaced against the validation of the name
+// argument of the resource schema.
+func validateResourceName(nameSchema *schema.Schema, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if nameSchema.ValidateFunc != nil {
+		_, errs := nameSchema.ValidateFunc(name, "name")
+		for _, err := range errs {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	if nameSchema.ValidateDiagFunc != nil {
+		diags = append(diags, nameSchema.ValidateDiagFunc(name, cty.GetAttrPath("name"))...)
+	}
+	return diags
+}
+
+// withResourceName wraps the create or update function of a resource using withResourceNaming, so that the
+// function reads the name with the placeholders replaced and sends that name to the API. The StateFunc of the
+// name only applies to the plan and the state, d.Get returns the configured name during the apply.
+func withResourceName(resourceSchema map[string]*schema.Schema, function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if function == nil || !hasResourceNaming(resourceSchema) {
+		return function
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if name, ok := d.Get("name").(string); ok && conns.HasResourceNamingPlaceholder(name) {
+			naming, _ := resourceNaming(meta)
+			resourceName := naming.ResourceName(name)
+			if diags := validateResourceName(resourceSchema["name"], resourceName); diags.HasError() {
+				return diags
+			}
+			if err := d.Set("name", resourceName); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return function(ctx, d, meta)
+	}
+}
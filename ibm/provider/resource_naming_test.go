@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+type testResourceNamingSession struct {
+	naming conns.ResourceNamingConfig
+}
+
+func (s testResourceNamingSession) ResourceNaming() conns.ResourceNamingConfig {
+	return s.naming
+}
+
+func TestResourceNamingApply(t *testing.T) {
+	meta := testResourceNamingSession{conns.ResourceNamingConfig{Prefix: "dev-", Suffix: "-01", Case: conns.ResourceNamingCaseLower}}
+
+	var created, updated string
+	resource := wrapResource("ibm_test_named", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][-a-z0-9]*$`), "must be a lowercase name"),
+			},
+		},
+		CreateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			created = d.Get("name").(string)
+			d.SetId("named")
+			return nil
+		},
+		ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+		UpdateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			updated = d.Get("name").(string)
+			return nil
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+	}, func() interface{} { return meta })
+	config := func(name string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{"name": name})
+	}
+	ctx := context.Background()
+
+	// the create function reads and the state records the replaced name
+	diff, err := resource.Diff(ctx, nil, config("{prefix}VPC{suffix}"), nil)
+	assert.NoError(t, err)
+	state, diags := resource.Apply(ctx, nil, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "dev-vpc-01", created)
+	assert.Equal(t, "dev-vpc-01", state.Attributes["name"])
+
+	// the same configuration plans no change
+	diff, err = resource.Diff(ctx, state, config("{prefix}VPC{suffix}"), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+
+	// a renamed resource is updated with the replaced name
+	diff, err = resource.Diff(ctx, state, config("{prefix}Subnet"), nil)
+	assert.NoError(t, err)
+	state, diags = resource.Apply(ctx, state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "dev-subnet", updated)
+	assert.Equal(t, "dev-subnet", state.Attributes["name"])
+
+	// names without a placeholder are unchanged
+	diff, err = resource.Diff(ctx, nil, config("plain"), nil)
+	assert.NoError(t, err)
+	state, diags = resource.Apply(ctx, nil, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "plain", created)
+	assert.Equal(t, "plain", state.Attributes["name"])
+}
+
+func TestResourceNamingValidate(t *testing.T) {
+	meta := testResourceNamingSession{conns.ResourceNamingConfig{Prefix: "Dev_", Case: conns.ResourceNamingCaseNone}}
+
+	created := false
+	resource := wrapResource("ibm_test_named", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][-a-z0-9]*$`), "must be a lowercase name"),
+			},
+		},
+		CreateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			created = true
+			d.SetId("named")
+			return nil
+		},
+		ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+	}, func() interface{} { return meta })
+	config := func(name string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{"name": name})
+	}
+	ctx := context.Background()
+
+	// the configuration is validated before the provider is configured, names with a placeholder are left
+	// to the validation of the replaced name
+	assert.False(t, resource.Validate(config("{prefix}vpc")).HasError())
+	assert.True(t, resource.Validate(config("VPC")).HasError())
+
+	// the replaced name is validated before the resource is created
+	diff, err := resource.Diff(ctx, nil, config("{prefix}vpc"), nil)
+	assert.NoError(t, err)
+	_, diags := resource.Apply(ctx, nil, diff, meta)
+	assert.True(t, diags.HasError())
+	assert.False(t, created)
+}
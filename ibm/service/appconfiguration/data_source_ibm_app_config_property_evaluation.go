@@ -0,0 +1,256 @@
+package appconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func DataSourceIBMAppConfigPropertyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigPropertyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Environment Id.",
+			},
+			"property_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Property Id.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the entity for which the property is evaluated.",
+			},
+			"entity_attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Attributes of the entity that are matched against the rules of the segments.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the Property (BOOLEAN, STRING, NUMERIC).",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Format of the STRING property (TEXT, JSON, YAML).",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the property for the entity. The value can be Boolean, String or a Numeric value as per the `type` attribute. Values of JSON properties are returned as a JSON encoded string.",
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the segment that the entity matched. Empty when the default value of the property is used.",
+			},
+			"segment_rule_order": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Order of the segment rule that provided the value. `0` when the default value of the property is used.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigPropertyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return fmt.Errorf("getAppConfigClient failed %s", err)
+	}
+
+	options := &appconfigurationv1.GetPropertyOptions{}
+	options.SetEnvironmentID(d.Get("environment_id").(string))
+	options.SetPropertyID(d.Get("property_id").(string))
+
+	property, response, err := appconfigClient.GetProperty(options)
+	if err != nil {
+		return fmt.Errorf("GetProperty failed %s\n%s", err, response)
+	}
+
+	attributes := map[string]string{}
+	for key, value := range d.Get("entity_attributes").(map[string]interface{}) {
+		attributes[key] = value.(string)
+	}
+
+	// segment rules are evaluated in their order, the first rule with a matching segment provides the value
+	segmentRules := make([]appconfigurationv1.SegmentRule, len(property.SegmentRules))
+	copy(segmentRules, property.SegmentRules)
+	sort.SliceStable(segmentRules, func(i, j int) bool {
+		return appConfigInt64Value(segmentRules[i].Order) < appConfigInt64Value(segmentRules[j].Order)
+	})
+
+	segments := map[string]*appconfigurationv1.Segment{}
+	value := property.Value
+	matchedSegmentID := ""
+	matchedOrder := int64(0)
+	for _, segmentRule := range segmentRules {
+		for _, targetSegments := range segmentRule.Rules {
+			for _, segmentID := range targetSegments.Segments {
+				segment, ok := segments[segmentID]
+				if !ok {
+					segmentOptions := &appconfigurationv1.GetSegmentOptions{}
+					segmentOptions.SetSegmentID(segmentID)
+					segment, response, err = appconfigClient.GetSegment(segmentOptions)
+					if err != nil {
+						return fmt.Errorf("GetSegment failed %s\n%s", err, response)
+					}
+					segments[segmentID] = segment
+				}
+				if appConfigSegmentMatches(segment, attributes) {
+					matchedSegmentID = segmentID
+					break
+				}
+			}
+			if matchedSegmentID != "" {
+				break
+			}
+		}
+		if matchedSegmentID != "" {
+			matchedOrder = appConfigInt64Value(segmentRule.Order)
+			// "$default" keeps the default value of the property
+			if ruleValue, ok := segmentRule.Value.(string); !ok || ruleValue != "$default" {
+				value = segmentRule.Value
+			}
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", guid, *options.EnvironmentID, *options.PropertyID, d.Get("entity_id").(string)))
+
+	if property.Type != nil {
+		if err = d.Set("type", property.Type); err != nil {
+			return fmt.Errorf("error setting type: %s", err)
+		}
+	}
+	if property.Format != nil {
+		if err = d.Set("format", property.Format); err != nil {
+			return fmt.Errorf("error setting format: %s", err)
+		}
+	}
+	propertyValue, err := appConfigPropertyValueString(value)
+	if err != nil {
+		return fmt.Errorf("error reading value of property %s: %s", *options.PropertyID, err)
+	}
+	if err = d.Set("value", propertyValue); err != nil {
+		return fmt.Errorf("error setting value: %s", err)
+	}
+	if err = d.Set("segment_id", matchedSegmentID); err != nil {
+		return fmt.Errorf("error setting segment_id: %s", err)
+	}
+	if err = d.Set("segment_rule_order", matchedOrder); err != nil {
+		return fmt.Errorf("error setting segment_rule_order: %s", err)
+	}
+	return nil
+}
+
+// appConfigPropertyValueString returns the value of a property as a string. Values of JSON
+// properties are returned by the API as objects and are encoded back to JSON, YAML values are
+// already strings.
+func appConfigPropertyValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
+
+// appConfigSegmentMatches reports whether the entity attributes satisfy every rule of the segment.
+func appConfigSegmentMatches(segment *appconfigurationv1.Segment, attributes map[string]string) bool {
+	if segment == nil || len(segment.Rules) == 0 {
+		return false
+	}
+	for _, rule := range segment.Rules {
+		if rule.AttributeName == nil || rule.Operator == nil {
+			return false
+		}
+		attribute, ok := attributes[*rule.AttributeName]
+		if !ok {
+			return false
+		}
+		// a rule matches when any of its values matches
+		matched := false
+		for _, ruleValue := range rule.Values {
+			if appConfigRuleValueMatches(*rule.Operator, attribute, ruleValue) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func appConfigRuleValueMatches(operator, attribute, ruleValue string) bool {
+	switch operator {
+	case "is":
+		return attribute == ruleValue
+	case "contains":
+		return strings.Contains(attribute, ruleValue)
+	case "startsWith":
+		return strings.HasPrefix(attribute, ruleValue)
+	case "endsWith":
+		return strings.HasSuffix(attribute, ruleValue)
+	case "greaterThan", "lesserThan", "greaterThanEquals", "lesserThanEquals":
+		attributeNumber, err := strconv.ParseFloat(attribute, 64)
+		if err != nil {
+			return false
+		}
+		ruleNumber, err := strconv.ParseFloat(ruleValue, 64)
+		if err != nil {
+			return false
+		}
+		switch operator {
+		case "greaterThan":
+			return attributeNumber > ruleNumber
+		case "lesserThan":
+			return attributeNumber < ruleNumber
+		case "greaterThanEquals":
+			return attributeNumber >= ruleNumber
+		default:
+			return attributeNumber <= ruleNumber
+		}
+	}
+	return false
+}
+
+func appConfigInt64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigPropertyEvaluationDataSource(t *testing.T) {
+	environmentID := "dev"
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	propertyID := fmt.Sprintf("tf_property_id_%d", acctest.RandIntRange(10, 100))
+	segmentID := fmt.Sprintf("tf_segment_id_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigPropertyEvaluationDataSourceConfigBasic(instanceName, environmentID, name, propertyID, segmentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.matched", "value", "segment_value"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.matched", "segment_id", segmentID),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.matched", "segment_rule_order", "1"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.default", "value", "default_value"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.default", "segment_id", ""),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.default", "type", "STRING"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.json", "format", "JSON"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property_evaluation.json", "value", `{"key":"value"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigPropertyEvaluationDataSourceConfigBasic(instanceName, environmentID, name, propertyID, segmentID string) string {
+	return fmt.Sprintf(`
+	    resource "ibm_resource_instance" "app_config_terraform_test482" {
+    		name     = "%s"
+    		location = "us-south"
+    		service  = "apprapp"
+    		plan     = "lite"
+    	}
+		resource "ibm_app_config_segment" "app_config_segment" {
+			guid       = ibm_resource_instance.app_config_terraform_test482.guid
+			name       = "%s"
+			segment_id = "%s"
+			rules {
+				attribute_name = "email"
+				operator       = "endsWith"
+				values         = ["@example.com"]
+			}
+		}
+		resource "ibm_app_config_property" "app_config_property" {
+		    guid           	= ibm_resource_instance.app_config_terraform_test482.guid
+			environment_id = "%s"
+			name = "%s"
+			property_id = "%s"
+			type = "STRING"
+			value = "default_value"
+			segment_rules {
+				rules {
+					segments = [ibm_app_config_segment.app_config_segment.segment_id]
+				}
+				value = "segment_value"
+				order = 1
+			}
+		}
+		data "ibm_app_config_property_evaluation" "matched" {
+		    guid           	= ibm_resource_instance.app_config_terraform_test482.guid
+			environment_id = ibm_app_config_property.app_config_property.environment_id
+			property_id = ibm_app_config_property.app_config_property.property_id
+			entity_id = "user1"
+			entity_attributes = {
+				email = "user1@example.com"
+			}
+		}
+		data "ibm_app_config_property_evaluation" "default" {
+		    guid           	= ibm_resource_instance.app_config_terraform_test482.guid
+			environment_id = ibm_app_config_property.app_config_property.environment_id
+			property_id = ibm_app_config_property.app_config_property.property_id
+			entity_id = "user2"
+			entity_attributes = {
+				email = "user2@other.com"
+			}
+		}
+		resource "ibm_app_config_property" "app_config_property_json" {
+		    guid           	= ibm_resource_instance.app_config_terraform_test482.guid
+			environment_id = "%s"
+			name = "%s_json"
+			property_id = "%s_json"
+			type = "STRING"
+			format = "JSON"
+			value = jsonencode({ key = "value" })
+		}
+		data "ibm_app_config_property_evaluation" "json" {
+		    guid           	= ibm_resource_instance.app_config_terraform_test482.guid
+			environment_id = ibm_app_config_property.app_config_property_json.environment_id
+			property_id = ibm_app_config_property.app_config_property_json.property_id
+			entity_id = "user1"
+		}
+	`, instanceName, name, segmentID, environmentID, name, propertyID, environmentID, name, propertyID)
+}
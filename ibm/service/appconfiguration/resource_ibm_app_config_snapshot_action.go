@@ -0,0 +1,183 @@
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMAppConfigSnapshotAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIbmAppConfigSnapshotActionCreate,
+		Read:   resourceIbmAppConfigSnapshotActionRead,
+		Delete: resourceIbmAppConfigSnapshotActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"git_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Git config id of the snapshot.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_app_config_snapshot_action", "action"),
+				Description:  "Action to run on the snapshot. `promote` writes the configuration of the environment to the git repository, `restore` applies the configuration stored in the git repository to the environment.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, runs the action again.",
+			},
+			"git_commit_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Git commit id created by the promote action.",
+			},
+			"git_commit_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Message describing the result of the promote action.",
+			},
+			"last_sync_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last time the snapshot was synchronized with the git repository.",
+			},
+		},
+	}
+}
+
+func ResourceIBMAppConfigSnapshotActionValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "action",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "promote, restore",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_app_config_snapshot_action", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmAppConfigSnapshotActionCreate(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	gitConfigID := d.Get("git_config_id").(string)
+	action := d.Get("action").(string)
+
+	switch action {
+	case "promote":
+		options := &appconfigurationv1.PromoteGitconfigOptions{}
+		options.SetGitConfigID(gitConfigID)
+		result, response, err := appconfigClient.PromoteGitconfig(options)
+		if err != nil {
+			log.Printf("[DEBUG] PromoteGitconfig failed %s\n%s", err, response)
+			return fmt.Errorf("PromoteGitconfig failed %s\n%s", err, response)
+		}
+		if result.GitCommitID != nil {
+			d.Set("git_commit_id", result.GitCommitID)
+		}
+		if result.Message != nil {
+			d.Set("git_commit_message", result.Message)
+		}
+		if result.LastSyncTime != nil {
+			d.Set("last_sync_time", result.LastSyncTime.String())
+		}
+	case "restore":
+		response, err := restoreGitconfig(appconfigClient, gitConfigID)
+		if err != nil {
+			log.Printf("[DEBUG] RestoreGitconfig failed %s\n%s", err, response)
+			return fmt.Errorf("RestoreGitconfig failed %s\n%s", err, response)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", guid, gitConfigID, action))
+
+	return resourceIbmAppConfigSnapshotActionRead(d, meta)
+}
+
+func resourceIbmAppConfigSnapshotActionRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 3 {
+		return fmt.Errorf("Kindly check the id")
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetGitconfigOptions{}
+	options.SetGitConfigID(parts[1])
+
+	result, response, err := appconfigClient.GetGitconfig(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] GetGitconfig failed %s\n%s", err, response)
+	}
+
+	d.Set("guid", parts[0])
+	d.Set("git_config_id", parts[1])
+	d.Set("action", parts[2])
+	if result.LastSyncTime != nil {
+		if err = d.Set("last_sync_time", result.LastSyncTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting last_sync_time: %s", err)
+		}
+	}
+	return nil
+}
+
+// Removing the action resource leaves the snapshot and the environment as they are.
+func resourceIbmAppConfigSnapshotActionDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// restoreGitconfig applies the configuration stored in the git repository to the environment of the git config.
+// The restore operation is not part of the SDK in use, the request is sent through the service of the SDK client.
+func restoreGitconfig(appconfigClient *appconfigurationv1.AppConfigurationV1, gitConfigID string) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.PUT)
+	builder.EnableGzipCompression = appconfigClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(appconfigClient.Service.GetServiceURL(), `/gitconfigs/{git_config_id}/restore`, map[string]string{"git_config_id": gitConfigID})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	return appconfigClient.Service.Request(request, &result)
+}
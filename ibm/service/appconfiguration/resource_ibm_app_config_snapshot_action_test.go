@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigSnapshotActionBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	collectionID := fmt.Sprintf("tf_collection_id_%d", acctest.RandIntRange(10, 100))
+	gitConfigID := fmt.Sprintf("tf_git_config_id_%d", acctest.RandIntRange(10, 100))
+	gitURL := os.Getenv("IBM_APPCONFIG_GIT_URL")
+	gitToken := os.Getenv("IBM_APPCONFIG_GIT_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acc.TestAccPreCheck(t)
+			if gitURL == "" || gitToken == "" {
+				t.Fatal("IBM_APPCONFIG_GIT_URL and IBM_APPCONFIG_GIT_TOKEN must be set for the snapshot action acceptance test")
+			}
+		},
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigSnapshotActionConfigBasic(instanceName, collectionID, gitConfigID, gitURL, gitToken, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_action.promote", "action", "promote"),
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_action.promote", "git_config_id", gitConfigID),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_action.promote", "git_commit_id"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_action.promote", "last_sync_time"),
+				),
+			},
+			{
+				// Changing the triggers runs the action again
+				Config: testAccCheckIbmAppConfigSnapshotActionConfigBasic(instanceName, collectionID, gitConfigID, gitURL, gitToken, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_snapshot_action.promote", "triggers.release", "2"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_snapshot_action.promote", "git_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigSnapshotActionConfigBasic(instanceName, collectionID, gitConfigID, gitURL, gitToken, release string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test482" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standardv2"
+		}
+		resource "ibm_app_config_collection" "app_config_collection" {
+			guid          = ibm_resource_instance.app_config_terraform_test482.guid
+			name          = "%s"
+			collection_id = "%s"
+		}
+		resource "ibm_app_config_snapshot" "app_config_snapshot" {
+			guid            = ibm_resource_instance.app_config_terraform_test482.guid
+			collection_id   = ibm_app_config_collection.app_config_collection.collection_id
+			environment_id  = "dev"
+			git_config_id   = "%s"
+			git_config_name = "%s"
+			git_url         = "%s"
+			git_branch      = "main"
+			git_file_path   = "%s.json"
+			git_token       = "%s"
+		}
+		resource "ibm_app_config_snapshot_action" "promote" {
+			guid          = ibm_app_config_snapshot.app_config_snapshot.guid
+			git_config_id = ibm_app_config_snapshot.app_config_snapshot.git_config_id
+			action        = "promote"
+			triggers = {
+				release = "%s"
+			}
+		}
+	`, instanceName, collectionID, collectionID, gitConfigID, gitConfigID, gitURL, gitConfigID, gitToken, release)
+}
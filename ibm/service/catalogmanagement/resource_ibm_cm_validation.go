@@ -199,8 +199,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	conns.IbmMutexKV.Lock(mk)
 	defer conns.IbmMutexKV.Unlock(mk)
 
-	valid := "valid"
-	if version.Validation.State == &valid && d.Get("revalidate_if_validated") != true {
+	if version.Validation != nil && version.Validation.State != nil && *version.Validation.State == "valid" && d.Get("revalidate_if_validated") != true {
 		// version already validated and do not wish to revalidate
 		d.SetId(*validateInstallOptions.VersionLocID)
 		if _, ok := d.GetOk("mark_version_consumable"); ok && d.Get("mark_version_consumable").(bool) {
@@ -26,6 +26,7 @@ func ResourceIBMCmVersion() *schema.Resource {
 		ReadContext:   resourceIBMCmVersionRead,
 		UpdateContext: resourceIBMCmVersionUpdate,
 		DeleteContext: resourceIBMCmVersionDelete,
+		CustomizeDiff: resourceIBMCmVersionPublishDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +70,11 @@ func ResourceIBMCmVersion() *schema.Resource {
 				Optional:    true,
 				Description: "Deprecate this version.",
 			},
+			"publish": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Publish this version to the account. The version must have passed validation, see ibm_cm_validation, so it can only be set once the version exists.",
+			},
 			"install_kind": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -2279,6 +2285,12 @@ func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, m
 	if err = d.Set("is_consumable", version.IsConsumable); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting is_consumable: %s", err))
 	}
+	// Publishing cannot be undone from here, so only a published version that was unpublished is reported.
+	if d.Get("publish").(bool) {
+		if err = d.Set("publish", isVersionPublished(version)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting publish: %s", err))
+		}
+	}
 	if err = d.Set("version_id", version.ID); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
 	}
@@ -2525,6 +2537,13 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("publish") && d.Get("publish").(bool) {
+		err = publishVersion(context, catalogManagementClient, activeVersion)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMCmVersionRead(context, d, meta)
 }
 
@@ -2553,6 +2572,50 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceIBMCmVersionPublishDiff refuses to publish a version that is being imported. An imported version is not
+// validated yet, it is published in a second apply once ibm_cm_validation has validated it.
+func resourceIBMCmVersionPublishDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" && diff.Get("publish").(bool) {
+		return fmt.Errorf("[ERROR] publish can not be set when the version is imported, validate the version with ibm_cm_validation first and set publish in a later apply")
+	}
+	return nil
+}
+
+// publishVersion marks a validated version as consumable, the version is then shared with the accounts the
+// offering is shared with.
+func publishVersion(context context.Context, catalogManagementClient *catalogmanagementv1.CatalogManagementV1, version catalogmanagementv1.Version) error {
+	if version.Validation == nil || version.Validation.State == nil || *version.Validation.State != "valid" {
+		state := ""
+		if version.Validation != nil && version.Validation.State != nil {
+			state = *version.Validation.State
+		}
+		return fmt.Errorf("Version %s cannot be published before it passes validation, current validation state is %q", *version.VersionLocator, state)
+	}
+	if isVersionPublished(version) {
+		return nil
+	}
+
+	consumableVersionOptions := &catalogmanagementv1.ConsumableVersionOptions{}
+	consumableVersionOptions.SetVersionLocID(*version.VersionLocator)
+	response, err := catalogManagementClient.ConsumableVersionWithContext(context, consumableVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ConsumableVersionWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("ConsumableVersionWithContext failed %s\n%s", err, response)
+	}
+
+	return nil
+}
+
+func isVersionPublished(version catalogmanagementv1.Version) bool {
+	if version.IsConsumable != nil && *version.IsConsumable {
+		return true
+	}
+	if version.State == nil || version.State.Current == nil {
+		return false
+	}
+	return strings.HasSuffix(*version.State.Current, "-published")
+}
+
 func resourceIBMCmVersionMapToFlavor(modelMap map[string]interface{}) (*catalogmanagementv1.Flavor, error) {
 	model := &catalogmanagementv1.Flavor{}
 	if modelMap["name"] != nil && modelMap["name"].(string) != "" {
@@ -4,6 +4,7 @@
 package catalogmanagement_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/catalogmanagement"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
 
@@ -349,3 +351,23 @@ func testAccCheckIBMCmVersionDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestResourceIBMCmVersionPublishOnCreate(t *testing.T) {
+	config := func(publish bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"catalog_id":  "catalog-id",
+			"offering_id": "offering-id",
+			"publish":     publish,
+		})
+	}
+
+	_, err := catalogmanagement.ResourceIBMCmVersion().Diff(context.Background(), nil, config(true), nil)
+	if err == nil || !strings.Contains(err.Error(), "publish can not be set when the version is imported") {
+		t.Fatalf("expected publish to be rejected on create, got %v", err)
+	}
+
+	_, err = catalogmanagement.ResourceIBMCmVersion().Diff(context.Background(), nil, config(false), nil)
+	if err != nil {
+		t.Fatalf("expected the version to be imported without publish, got %s", err)
+	}
+}
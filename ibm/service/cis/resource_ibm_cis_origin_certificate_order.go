@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISOriginCertificateOrder          = "ibm_cis_origin_certificate_order"
+	cisOriginCertificateID                = "certificate_id"
+	cisOriginCertificateHostnames         = "hostnames"
+	cisOriginCertificateRequestType       = "request_type"
+	cisOriginCertificateRequestedValidity = "requested_validity"
+	cisOriginCertificateCSR               = "csr"
+	cisOriginCertificate                  = "certificate"
+	cisOriginCertificateExpiresOn         = "expires_on"
+)
+
+func ResourceIBMCISOriginCertificateOrder() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISOriginCertificateOrderCreate,
+		Read:     ResourceIBMCISOriginCertificateOrderRead,
+		Delete:   ResourceIBMCISOriginCertificateOrderDelete,
+		Exists:   ResourceIBMCISOriginCertificateOrderExist,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS object id or CRN",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisOriginCertificateHostnames: {
+				Type:        schema.TypeList,
+				Description: "Hostnames or wildcard names bound to the certificate",
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			cisOriginCertificateRequestType: {
+				Type:        schema.TypeString,
+				Description: "Signature type of the certificate, origin-rsa or origin-ecc",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					cisOriginCertificateRequestType),
+			},
+			cisOriginCertificateRequestedValidity: {
+				Type:        schema.TypeInt,
+				Description: "Number of days for which the certificate is valid",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     5475,
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					cisOriginCertificateRequestedValidity),
+			},
+			cisOriginCertificateCSR: {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate signing request of the origin server",
+				Required:    true,
+				ForceNew:    true,
+			},
+			cisOriginCertificateID: {
+				Type:        schema.TypeString,
+				Description: "Origin certificate id",
+				Computed:    true,
+			},
+			cisOriginCertificate: {
+				Type:        schema.TypeString,
+				Description: "PEM encoded origin certificate signed by the CIS origin CA",
+				Computed:    true,
+			},
+			cisOriginCertificateExpiresOn: {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ResourceIBMCISOriginCertificateOrderValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisOriginCertificateRequestType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "origin-rsa, origin-ecc"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisOriginCertificateRequestedValidity,
+			ValidateFunctionIdentifier: validate.ValidateAllowedIntValue,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			AllowedValues:              "7, 30, 90, 365, 730, 1095, 5475"})
+
+	cisOriginCertificateOrderValidator := validate.ResourceValidator{
+		ResourceName: ibmCISOriginCertificateOrder,
+		Schema:       validateSchema}
+	return &cisOriginCertificateOrderValidator
+}
+
+func ResourceIBMCISOriginCertificateOrderCreate(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return err
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	opt := map[string]interface{}{
+		cisOriginCertificateHostnames:         flex.ExpandStringList(d.Get(cisOriginCertificateHostnames).([]interface{})),
+		cisOriginCertificateRequestType:       d.Get(cisOriginCertificateRequestType).(string),
+		cisOriginCertificateRequestedValidity: d.Get(cisOriginCertificateRequestedValidity).(int),
+		cisOriginCertificateCSR:               d.Get(cisOriginCertificateCSR).(string),
+	}
+
+	result, resp, err := cisOriginCertificateRequest(cisClient, core.POST, "", opt)
+	if err != nil {
+		log.Printf("Origin certificate order failed: %v", resp)
+		return fmt.Errorf("[ERROR] Error ordering origin certificate: %s", err)
+	}
+
+	d.SetId(flex.ConvertCisToTfThreeVar(result.Result.ID, zoneID, crn))
+	return ResourceIBMCISOriginCertificateOrderRead(d, meta)
+}
+
+func ResourceIBMCISOriginCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return err
+	}
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		log.Println("Error in reading origin certificate id")
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	result, resp, err := cisOriginCertificateRequest(cisClient, core.GET, certificateID, nil)
+	if err != nil {
+		log.Printf("Origin certificate read failed: %v", resp)
+		return fmt.Errorf("[ERROR] Error reading origin certificate %s: %s", certificateID, err)
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisOriginCertificateID, result.Result.ID)
+	d.Set(cisOriginCertificateHostnames, result.Result.Hostnames)
+	d.Set(cisOriginCertificateRequestType, result.Result.RequestType)
+	d.Set(cisOriginCertificateRequestedValidity, result.Result.RequestedValidity)
+	d.Set(cisOriginCertificateCSR, result.Result.Csr)
+	d.Set(cisOriginCertificate, result.Result.Certificate)
+	d.Set(cisOriginCertificateExpiresOn, result.Result.ExpiresOn)
+	return nil
+}
+
+func ResourceIBMCISOriginCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return err
+	}
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		log.Println("Error in reading origin certificate id")
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	// origin certificates can not be deleted, revoking them stops the origin from being trusted by CIS
+	_, resp, err := cisOriginCertificateRequest(cisClient, core.DELETE, certificateID, nil)
+	if err != nil {
+		log.Printf("Origin certificate revoke failed: %v", resp)
+		return fmt.Errorf("[ERROR] Error revoking origin certificate %s: %s", certificateID, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func ResourceIBMCISOriginCertificateOrderExist(d *schema.ResourceData, meta interface{}) (bool, error) {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return false, err
+	}
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		log.Println("Error in reading origin certificate id")
+		return false, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	_, response, err := cisOriginCertificateRequest(cisClient, core.GET, certificateID, nil)
+	if err != nil {
+		if response != nil && (response.StatusCode == 400 || response.StatusCode == 404) {
+			log.Printf("Origin certificate is not found")
+			return false, nil
+		}
+		log.Printf("Get origin certificate failed: %v", response)
+		return false, err
+	}
+	return true, nil
+}
+
+// cisOriginCertificateResult is the response of the origin certificate operations of the CIS API.
+type cisOriginCertificateResult struct {
+	Result struct {
+		ID                string   `json:"id"`
+		Certificate       string   `json:"certificate"`
+		Hostnames         []string `json:"hostnames"`
+		ExpiresOn         string   `json:"expires_on"`
+		RequestType       string   `json:"request_type"`
+		RequestedValidity int64    `json:"requested_validity"`
+		Csr               string   `json:"csr"`
+	} `json:"result"`
+}
+
+// cisOriginCertificateRequest sends an origin certificate request for the zone of cisClient, the certificate
+// with the given ID when certificateID is set. The origin certificate operations are not part of the SDK in use.
+func cisOriginCertificateRequest(cisClient *sslcertificateapiv1.SslCertificateApiV1, method, certificateID string, body interface{}) (*cisOriginCertificateResult, *core.DetailedResponse, error) {
+	path := `/v1/{crn}/zones/{zone_identifier}/ssl/origin_certificates`
+	pathParamsMap := map[string]string{
+		"crn":             *cisClient.Crn,
+		"zone_identifier": *cisClient.ZoneIdentifier,
+	}
+	if certificateID != "" {
+		path += `/{cert_identifier}`
+		pathParamsMap["cert_identifier"] = certificateID
+	}
+
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = cisClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(cisClient.Service.GetServiceURL(), path, pathParamsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &cisOriginCertificateResult{}
+	response, err := cisClient.Service.Request(request, result)
+	return result, response, err
+}
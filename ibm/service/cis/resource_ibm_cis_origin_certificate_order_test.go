@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisOriginCertificateOrder_Basic(t *testing.T) {
+	name := "ibm_cis_origin_certificate_order.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {Source: "hashicorp/tls"},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisOriginCertificateOrderConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-rsa"),
+					resource.TestCheckResourceAttr(name, "requested_validity", "7"),
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisOriginCertificateOrderConfigBasic() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "tls_private_key" "origin" {
+		algorithm = "RSA"
+	}
+
+	resource "tls_cert_request" "origin" {
+		private_key_pem = tls_private_key.origin.private_key_pem
+		dns_names       = ["%[1]s"]
+		subject {
+			common_name = "%[1]s"
+		}
+	}
+
+	resource "ibm_cis_origin_certificate_order" "test" {
+		cis_id             = data.ibm_cis.cis.id
+		domain_id          = data.ibm_cis_domain.cis_domain.domain_id
+		hostnames          = ["%[1]s"]
+		request_type       = "origin-rsa"
+		requested_validity = 7
+		csr                = tls_cert_request.origin.cert_request_pem
+	}
+	`, acc.CisDomainStatic)
+}
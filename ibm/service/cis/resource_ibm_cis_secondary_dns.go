@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisSecondaryDNSName               = "name"
+	cisSecondaryDNSPeers              = "peers"
+	cisSecondaryDNSAutoRefreshSeconds = "auto_refresh_seconds"
+	cisSecondaryDNSSoaSerial          = "soa_serial"
+	cisSecondaryDNSCheckedTime        = "checked_time"
+)
+
+// cisSecondaryDNSZone is the incoming zone transfer model of the CIS secondary DNS API.
+type cisSecondaryDNSZone struct {
+	ID                 string   `json:"id,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	Peers              []string `json:"peers"`
+	AutoRefreshSeconds int64    `json:"auto_refresh_seconds,omitempty"`
+	SoaSerial          int64    `json:"soa_serial,omitempty"`
+	CheckedTime        string   `json:"checked_time,omitempty"`
+}
+
+type cisSecondaryDNSZoneResponse struct {
+	Result cisSecondaryDNSZone `json:"result"`
+}
+
+func ResourceIBMCISSecondaryDNS() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISSecondaryDNSCreate,
+		Read:     ResourceIBMCISSecondaryDNSRead,
+		Update:   ResourceIBMCISSecondaryDNSUpdate,
+		Delete:   ResourceIBMCISSecondaryDNSDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisSecondaryDNSName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the zone transfer configuration",
+			},
+			cisSecondaryDNSPeers: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         cisSecondaryDNSPeerIDHash,
+				Description: "IDs of the peers that the zone is transferred from, either the IDs of ibm_cis_secondary_dns_peer resources or the peer IDs",
+			},
+			cisSecondaryDNSAutoRefreshSeconds: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     86400,
+				Description: "How often the primary name servers are polled for changes, in seconds. NOTIFY messages from the primary trigger a transfer immediately.",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns",
+					cisSecondaryDNSAutoRefreshSeconds),
+			},
+			cisSecondaryDNSSoaSerial: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "SOA serial of the last transferred version of the zone",
+			},
+			cisSecondaryDNSCheckedTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last check of the primary name servers for changes",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISSecondaryDNSValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisSecondaryDNSAutoRefreshSeconds,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "300",
+			MaxValue:                   "86400"})
+	ibmCISSecondaryDNSValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_secondary_dns",
+		Schema:       validateSchema}
+	return &ibmCISSecondaryDNSValidator
+}
+
+func expandCISSecondaryDNSZone(d *schema.ResourceData) cisSecondaryDNSZone {
+	zone := cisSecondaryDNSZone{
+		Name:               d.Get(cisSecondaryDNSName).(string),
+		AutoRefreshSeconds: int64(d.Get(cisSecondaryDNSAutoRefreshSeconds).(int)),
+		Peers:              []string{},
+	}
+	for _, peer := range d.Get(cisSecondaryDNSPeers).(*schema.Set).List() {
+		zone.Peers = append(zone.Peers, cisSecondaryDNSPeerIDValue(peer.(string)))
+	}
+	return zone
+}
+
+func ResourceIBMCISSecondaryDNSCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	pathParams := map[string]string{"crn": crn, "zone_id": zoneID}
+	resp, err := cisSecondaryDNSRequest(meta, core.POST, "/v1/{crn}/zones/{zone_id}/secondary_dns", pathParams, expandCISSecondaryDNSZone(d), nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating secondary DNS configuration %s %s", err, resp)
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return ResourceIBMCISSecondaryDNSRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSRead(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	result := &cisSecondaryDNSZoneResponse{}
+	pathParams := map[string]string{"crn": crn, "zone_id": zoneID}
+	response, err := cisSecondaryDNSRequest(meta, core.GET, "/v1/{crn}/zones/{zone_id}/secondary_dns", pathParams, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting secondary DNS configuration detail %s, %s", err, response)
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisSecondaryDNSName, result.Result.Name)
+	d.Set(cisSecondaryDNSPeers, flex.NewStringSet(cisSecondaryDNSPeerIDHash, result.Result.Peers))
+	d.Set(cisSecondaryDNSAutoRefreshSeconds, result.Result.AutoRefreshSeconds)
+	d.Set(cisSecondaryDNSSoaSerial, result.Result.SoaSerial)
+	d.Set(cisSecondaryDNSCheckedTime, result.Result.CheckedTime)
+	return nil
+}
+
+func ResourceIBMCISSecondaryDNSUpdate(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange(cisSecondaryDNSName) ||
+		d.HasChange(cisSecondaryDNSPeers) ||
+		d.HasChange(cisSecondaryDNSAutoRefreshSeconds) {
+		pathParams := map[string]string{"crn": crn, "zone_id": zoneID}
+		response, err := cisSecondaryDNSRequest(meta, core.PUT, "/v1/{crn}/zones/{zone_id}/secondary_dns", pathParams, expandCISSecondaryDNSZone(d), nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the secondary DNS configuration %s %s", err, response)
+		}
+	}
+	return ResourceIBMCISSecondaryDNSRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSDelete(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	pathParams := map[string]string{"crn": crn, "zone_id": zoneID}
+	response, err := cisSecondaryDNSRequest(meta, core.DELETE, "/v1/{crn}/zones/{zone_id}/secondary_dns", pathParams, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting the secondary DNS configuration %s:%s", err, response)
+	}
+	return nil
+}
+
+// cisSecondaryDNSPeerIDValue accepts both the Terraform ID of an ibm_cis_secondary_dns_peer resource
+// and a plain peer ID.
+func cisSecondaryDNSPeerIDValue(peerID string) string {
+	if id, _, err := flex.ConvertTftoCisTwoVar(peerID); err == nil {
+		return id
+	}
+	return peerID
+}
+
+func cisSecondaryDNSPeerIDHash(v interface{}) int {
+	return schema.HashString(cisSecondaryDNSPeerIDValue(v.(string)))
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisSecondaryDNSPeerID         = "peer_id"
+	cisSecondaryDNSPeerName       = "name"
+	cisSecondaryDNSPeerIP         = "ip"
+	cisSecondaryDNSPeerPort       = "port"
+	cisSecondaryDNSPeerTsigID     = "tsig_id"
+	cisSecondaryDNSPeerIxfrEnable = "ixfr_enable"
+)
+
+// cisSecondaryDNSPeer is the peer name server model of the CIS secondary DNS API.
+type cisSecondaryDNSPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	Port       int64  `json:"port,omitempty"`
+	TsigID     string `json:"tsig_id,omitempty"`
+	IxfrEnable bool   `json:"ixfr_enable"`
+}
+
+type cisSecondaryDNSPeerResponse struct {
+	Result cisSecondaryDNSPeer `json:"result"`
+}
+
+func ResourceIBMCISSecondaryDNSPeer() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISSecondaryDNSPeerCreate,
+		Read:     ResourceIBMCISSecondaryDNSPeerRead,
+		Update:   ResourceIBMCISSecondaryDNSPeerUpdate,
+		Delete:   ResourceIBMCISSecondaryDNSPeerDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns_peer",
+					"cis_id"),
+			},
+			cisSecondaryDNSPeerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Peer ID",
+			},
+			cisSecondaryDNSPeerName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Peer name",
+			},
+			cisSecondaryDNSPeerIP: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "IP address of the primary name server that the zones are transferred from",
+			},
+			cisSecondaryDNSPeerPort: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     53,
+				Description: "DNS port of the primary name server",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns_peer",
+					cisSecondaryDNSPeerPort),
+			},
+			cisSecondaryDNSPeerTsigID: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSecondaryDNSTsigID,
+				Description:      "ID of the TSIG key that authenticates the zone transfers, either the ID of an ibm_cis_secondary_dns_tsig resource or the TSIG key ID",
+			},
+			cisSecondaryDNSPeerIxfrEnable: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether incremental zone transfers (IXFR) are used instead of full zone transfers (AXFR)",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISSecondaryDNSPeerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisSecondaryDNSPeerPort,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	ibmCISSecondaryDNSPeerValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_secondary_dns_peer",
+		Schema:       validateSchema}
+	return &ibmCISSecondaryDNSPeerValidator
+}
+
+func expandCISSecondaryDNSPeer(d *schema.ResourceData) cisSecondaryDNSPeer {
+	peer := cisSecondaryDNSPeer{
+		Name:       d.Get(cisSecondaryDNSPeerName).(string),
+		IP:         d.Get(cisSecondaryDNSPeerIP).(string),
+		Port:       int64(d.Get(cisSecondaryDNSPeerPort).(int)),
+		IxfrEnable: d.Get(cisSecondaryDNSPeerIxfrEnable).(bool),
+	}
+	if tsigID, ok := d.GetOk(cisSecondaryDNSPeerTsigID); ok {
+		peer.TsigID = cisSecondaryDNSTsigIDValue(tsigID.(string))
+	}
+	return peer
+}
+
+func ResourceIBMCISSecondaryDNSPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+
+	result := &cisSecondaryDNSPeerResponse{}
+	resp, err := cisSecondaryDNSRequest(meta, core.POST, "/v1/{crn}/secondary_dns/peers", map[string]string{"crn": crn}, expandCISSecondaryDNSPeer(d), result)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating secondary DNS peer %s %s", err, resp)
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(result.Result.ID, crn))
+	return ResourceIBMCISSecondaryDNSPeerRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSPeerRead(d *schema.ResourceData, meta interface{}) error {
+	peerID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	result := &cisSecondaryDNSPeerResponse{}
+	pathParams := map[string]string{"crn": crn, "peer_id": peerID}
+	response, err := cisSecondaryDNSRequest(meta, core.GET, "/v1/{crn}/secondary_dns/peers/{peer_id}", pathParams, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting secondary DNS peer detail %s, %s", err, response)
+	}
+	d.Set(cisID, crn)
+	d.Set(cisSecondaryDNSPeerID, result.Result.ID)
+	d.Set(cisSecondaryDNSPeerName, result.Result.Name)
+	d.Set(cisSecondaryDNSPeerIP, result.Result.IP)
+	d.Set(cisSecondaryDNSPeerPort, result.Result.Port)
+	d.Set(cisSecondaryDNSPeerTsigID, result.Result.TsigID)
+	d.Set(cisSecondaryDNSPeerIxfrEnable, result.Result.IxfrEnable)
+	return nil
+}
+
+func ResourceIBMCISSecondaryDNSPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	peerID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange(cisSecondaryDNSPeerName) ||
+		d.HasChange(cisSecondaryDNSPeerIP) ||
+		d.HasChange(cisSecondaryDNSPeerPort) ||
+		d.HasChange(cisSecondaryDNSPeerTsigID) ||
+		d.HasChange(cisSecondaryDNSPeerIxfrEnable) {
+		pathParams := map[string]string{"crn": crn, "peer_id": peerID}
+		response, err := cisSecondaryDNSRequest(meta, core.PUT, "/v1/{crn}/secondary_dns/peers/{peer_id}", pathParams, expandCISSecondaryDNSPeer(d), nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the secondary DNS peer %s %s", err, response)
+		}
+	}
+	return ResourceIBMCISSecondaryDNSPeerRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	peerID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	pathParams := map[string]string{"crn": crn, "peer_id": peerID}
+	response, err := cisSecondaryDNSRequest(meta, core.DELETE, "/v1/{crn}/secondary_dns/peers/{peer_id}", pathParams, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting the secondary DNS peer %s:%s", err, response)
+	}
+	return nil
+}
+
+// cisSecondaryDNSTsigIDValue accepts both the Terraform ID of an ibm_cis_secondary_dns_tsig resource
+// and a plain TSIG key ID.
+func cisSecondaryDNSTsigIDValue(tsigID string) string {
+	if id, _, err := flex.ConvertTftoCisTwoVar(tsigID); err == nil {
+		return id
+	}
+	return tsigID
+}
+
+func suppressSecondaryDNSTsigID(k, old, new string, d *schema.ResourceData) bool {
+	return cisSecondaryDNSTsigIDValue(old) == cisSecondaryDNSTsigIDValue(new)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisSecondaryDNS_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisSecondaryDNSBasic("test", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cis_secondary_dns_tsig.test", "algo", "hmac-sha256."),
+					resource.TestCheckResourceAttrSet("ibm_cis_secondary_dns_tsig.test", "tsig_id"),
+					resource.TestCheckResourceAttr("ibm_cis_secondary_dns_peer.test", "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttrPair("ibm_cis_secondary_dns_peer.test", "tsig_id", "ibm_cis_secondary_dns_tsig.test", "tsig_id"),
+					resource.TestCheckResourceAttr("ibm_cis_secondary_dns.test", "peers.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cis_secondary_dns.test", "auto_refresh_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccCheckCisSecondaryDNSBasic("test", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cis_secondary_dns.test", "auto_refresh_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cis_secondary_dns_peer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "ibm_cis_secondary_dns_tsig.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckCisSecondaryDNSBasic(id string, autoRefreshSeconds int) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_secondary_dns_tsig" "%[1]s" {
+		cis_id = data.ibm_cis.cis.id
+		name   = "tsig.example.com."
+		algo   = "hmac-sha256."
+		secret = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+	}
+
+	resource "ibm_cis_secondary_dns_peer" "%[1]s" {
+		cis_id  = data.ibm_cis.cis.id
+		name    = "on-prem-primary"
+		ip      = "192.0.2.53"
+		tsig_id = ibm_cis_secondary_dns_tsig.%[1]s.id
+	}
+
+	resource "ibm_cis_secondary_dns" "%[1]s" {
+		cis_id               = data.ibm_cis.cis.id
+		domain_id            = data.ibm_cis_domain.cis_domain.domain_id
+		peers                = [ibm_cis_secondary_dns_peer.%[1]s.id]
+		auto_refresh_seconds = %[2]d
+	}
+	`, id, autoRefreshSeconds)
+}
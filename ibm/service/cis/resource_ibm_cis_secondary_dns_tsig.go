@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisSecondaryDNSTsigID     = "tsig_id"
+	cisSecondaryDNSTsigName   = "name"
+	cisSecondaryDNSTsigAlgo   = "algo"
+	cisSecondaryDNSTsigSecret = "secret"
+)
+
+// cisSecondaryDNSTsig is the TSIG key model of the CIS secondary DNS API.
+type cisSecondaryDNSTsig struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Algo   string `json:"algo,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
+type cisSecondaryDNSTsigResponse struct {
+	Result cisSecondaryDNSTsig `json:"result"`
+}
+
+// cisSecondaryDNSRequest sends a request to the secondary DNS endpoints of the CIS API, which are not
+// covered by the networking SDK yet. It reuses the authenticator and endpoint of the DNS records client.
+func cisSecondaryDNSRequest(meta interface{}, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := core.NewRequestBuilder(method)
+	_, err = builder.ResolveRequestURL(sess.Service.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return sess.Service.Request(request, result)
+}
+
+func ResourceIBMCISSecondaryDNSTsig() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISSecondaryDNSTsigCreate,
+		Read:     ResourceIBMCISSecondaryDNSTsigRead,
+		Update:   ResourceIBMCISSecondaryDNSTsigUpdate,
+		Delete:   ResourceIBMCISSecondaryDNSTsigDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns_tsig",
+					"cis_id"),
+			},
+			cisSecondaryDNSTsigID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "TSIG key ID",
+			},
+			cisSecondaryDNSTsigName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "TSIG key name, as configured on the primary name servers",
+			},
+			cisSecondaryDNSTsigAlgo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "TSIG algorithm",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_secondary_dns_tsig",
+					cisSecondaryDNSTsigAlgo),
+			},
+			cisSecondaryDNSTsigSecret: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "TSIG secret, base64 encoded",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISSecondaryDNSTsigValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisSecondaryDNSTsigAlgo,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "hmac-md5., hmac-sha1., hmac-sha256., hmac-sha512."})
+	ibmCISSecondaryDNSTsigValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_secondary_dns_tsig",
+		Schema:       validateSchema}
+	return &ibmCISSecondaryDNSTsigValidator
+}
+
+func ResourceIBMCISSecondaryDNSTsigCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	tsig := cisSecondaryDNSTsig{
+		Name:   d.Get(cisSecondaryDNSTsigName).(string),
+		Algo:   d.Get(cisSecondaryDNSTsigAlgo).(string),
+		Secret: d.Get(cisSecondaryDNSTsigSecret).(string),
+	}
+
+	result := &cisSecondaryDNSTsigResponse{}
+	resp, err := cisSecondaryDNSRequest(meta, core.POST, "/v1/{crn}/secondary_dns/tsigs", map[string]string{"crn": crn}, tsig, result)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating secondary DNS TSIG key %s %s", err, resp)
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(result.Result.ID, crn))
+	return ResourceIBMCISSecondaryDNSTsigRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSTsigRead(d *schema.ResourceData, meta interface{}) error {
+	tsigID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	result := &cisSecondaryDNSTsigResponse{}
+	pathParams := map[string]string{"crn": crn, "tsig_id": tsigID}
+	response, err := cisSecondaryDNSRequest(meta, core.GET, "/v1/{crn}/secondary_dns/tsigs/{tsig_id}", pathParams, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting secondary DNS TSIG key detail %s, %s", err, response)
+	}
+	d.Set(cisID, crn)
+	d.Set(cisSecondaryDNSTsigID, result.Result.ID)
+	d.Set(cisSecondaryDNSTsigName, result.Result.Name)
+	d.Set(cisSecondaryDNSTsigAlgo, result.Result.Algo)
+	// the secret is write only and is not returned by the API
+	if result.Result.Secret != "" {
+		d.Set(cisSecondaryDNSTsigSecret, result.Result.Secret)
+	}
+	return nil
+}
+
+func ResourceIBMCISSecondaryDNSTsigUpdate(d *schema.ResourceData, meta interface{}) error {
+	tsigID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange(cisSecondaryDNSTsigName) ||
+		d.HasChange(cisSecondaryDNSTsigAlgo) ||
+		d.HasChange(cisSecondaryDNSTsigSecret) {
+		tsig := cisSecondaryDNSTsig{
+			Name:   d.Get(cisSecondaryDNSTsigName).(string),
+			Algo:   d.Get(cisSecondaryDNSTsigAlgo).(string),
+			Secret: d.Get(cisSecondaryDNSTsigSecret).(string),
+		}
+		pathParams := map[string]string{"crn": crn, "tsig_id": tsigID}
+		response, err := cisSecondaryDNSRequest(meta, core.PUT, "/v1/{crn}/secondary_dns/tsigs/{tsig_id}", pathParams, tsig, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the secondary DNS TSIG key %s %s", err, response)
+		}
+	}
+	return ResourceIBMCISSecondaryDNSTsigRead(d, meta)
+}
+
+func ResourceIBMCISSecondaryDNSTsigDelete(d *schema.ResourceData, meta interface{}) error {
+	tsigID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	pathParams := map[string]string{"crn": crn, "tsig_id": tsigID}
+	response, err := cisSecondaryDNSRequest(meta, core.DELETE, "/v1/{crn}/secondary_dns/tsigs/{tsig_id}", pathParams, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting the secondary DNS TSIG key %s:%s", err, response)
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ const (
 	cisTLSSettingsTLS12Only     = "tls_1_2_only"
 	cisTLSSettingsTLS13         = "tls_1_3"
 	cisTLSSettingsMinTLSVersion = "min_tls_version"
+	cisTLSSettingsCiphers       = "ciphers"
+	cisTLSSettingsHSTS          = "hsts"
 )
 
 func ResourceIBMCISTLSSettings() *schema.Resource {
@@ -58,6 +60,58 @@ func ResourceIBMCISTLSSettings() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator(ibmCISTLSSettings, cisTLSSettingsMinTLSVersion),
 				Default:      "1.2",
 			},
+			cisTLSSettingsCiphers: {
+				Type:        schema.TypeSet,
+				Description: "Cipher suites allowed for TLS 1.2 and earlier; an empty set selects the default cipher suites",
+				Optional:    true,
+				Computed:    true,
+				Set:         schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validate.InvokeValidator(
+						ibmCISDomainSettings,
+						cisDomainSettingsCipherValidatorID),
+				},
+			},
+			cisTLSSettingsHSTS: {
+				Type:        schema.TypeList,
+				Description: "HTTP Strict Transport Security (HSTS) setting",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDomainSettingsSecurityHeaderEnabled: {
+							Type:        schema.TypeBool,
+							Description: "HSTS enabled/disabled",
+							Required:    true,
+						},
+						cisDomainSettingsSecurityHeaderMaxAge: {
+							Type:        schema.TypeInt,
+							Description: "Time in seconds browsers remember to only use HTTPS",
+							Required:    true,
+						},
+						cisDomainSettingsSecurityHeaderIncludeSubdomains: {
+							Type:        schema.TypeBool,
+							Description: "HSTS applies to the subdomains",
+							Optional:    true,
+							Default:     false,
+						},
+						cisDomainSettingsSecurityHeaderPreload: {
+							Type:        schema.TypeBool,
+							Description: "HSTS preload",
+							Optional:    true,
+							Default:     false,
+						},
+						cisDomainSettingsSecurityHeaderNoSniff: {
+							Type:        schema.TypeBool,
+							Description: "Sends the X-Content-Type-Options: nosniff header",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
 		},
 		Create:   resourceCISTLSSettingsUpdate,
 		Read:     resourceCISTLSSettingsRead,
@@ -152,6 +206,56 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	}
+	if d.HasChange(cisTLSSettingsCiphers) || d.HasChange(cisTLSSettingsHSTS) {
+		settingsClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+		if err != nil {
+			return err
+		}
+		settingsClient.Crn = core.StringPtr(crn)
+		settingsClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+		// Cipher suites
+		if d.HasChange(cisTLSSettingsCiphers) {
+			if v, ok := d.GetOk(cisTLSSettingsCiphers); ok {
+				opt := settingsClient.NewUpdateCiphersOptions()
+				opt.SetValue(flex.ExpandStringList(v.(*schema.Set).List()))
+				_, resp, err := settingsClient.UpdateCiphers(opt)
+				if err != nil {
+					log.Printf("Update ciphers setting Failed : %v\n", resp)
+					return err
+				}
+			}
+		}
+
+		// HSTS setting
+		if d.HasChange(cisTLSSettingsHSTS) {
+			if v, ok := d.GetOk(cisTLSSettingsHSTS); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				hsts := v.([]interface{})[0].(map[string]interface{})
+				hstsVal, err := settingsClient.NewSecurityHeaderSettingValueStrictTransportSecurity(
+					hsts[cisDomainSettingsSecurityHeaderEnabled].(bool),
+					int64(hsts[cisDomainSettingsSecurityHeaderMaxAge].(int)),
+					hsts[cisDomainSettingsSecurityHeaderIncludeSubdomains].(bool),
+					hsts[cisDomainSettingsSecurityHeaderPreload].(bool),
+					hsts[cisDomainSettingsSecurityHeaderNoSniff].(bool))
+				if err != nil {
+					log.Println("Invalid HSTS setting values")
+					return err
+				}
+				securityOpt, err := settingsClient.NewSecurityHeaderSettingValue(hstsVal)
+				if err != nil {
+					log.Println("Invalid HSTS setting options")
+					return err
+				}
+				opt := settingsClient.NewUpdateSecurityHeaderOptions()
+				opt.SetValue(securityOpt)
+				_, resp, err := settingsClient.UpdateSecurityHeader(opt)
+				if err != nil {
+					log.Printf("Update HSTS setting Failed : %v\n", resp)
+					return err
+				}
+			}
+		}
+	}
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
 	return resourceCISTLSSettingsRead(d, meta)
 }
@@ -193,11 +297,49 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
 		return err
 	}
+
+	// Cipher suites
+	ciphersResult, resp, err := minTLSClient.GetCiphers(minTLSClient.NewGetCiphersOptions())
+	if err != nil {
+		log.Printf("Ciphers setting get request failed : %v", resp)
+		return err
+	}
+
+	// HSTS setting
+	hstsResult, resp, err := minTLSClient.GetSecurityHeader(minTLSClient.NewGetSecurityHeaderOptions())
+	if err != nil {
+		log.Printf("HSTS setting get request failed : %v", resp)
+		return err
+	}
+	hsts := []interface{}{}
+	if hstsResult.Result != nil && hstsResult.Result.Value != nil && hstsResult.Result.Value.StrictTransportSecurity != nil {
+		sts := hstsResult.Result.Value.StrictTransportSecurity
+		value := map[string]interface{}{}
+		if sts.Enabled != nil {
+			value[cisDomainSettingsSecurityHeaderEnabled] = *sts.Enabled
+		}
+		if sts.MaxAge != nil {
+			value[cisDomainSettingsSecurityHeaderMaxAge] = *sts.MaxAge
+		}
+		if sts.IncludeSubdomains != nil {
+			value[cisDomainSettingsSecurityHeaderIncludeSubdomains] = *sts.IncludeSubdomains
+		}
+		if sts.Preload != nil {
+			value[cisDomainSettingsSecurityHeaderPreload] = *sts.Preload
+		}
+		if sts.Nosniff != nil {
+			value[cisDomainSettingsSecurityHeaderNoSniff] = *sts.Nosniff
+		}
+		hsts = append(hsts, value)
+	}
+
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
 	d.Set(cisTLSSettingsTLS13, tls13Result.Result.Value)
 	d.Set(cisTLSSettingsUniversalSSL, universalSSLResult.Result.Enabled)
 	d.Set(cisTLSSettingsMinTLSVersion, minTLSVerResult.Result.Value)
+	d.Set(cisTLSSettingsCiphers, ciphersResult.Result.Value)
+	d.Set(cisTLSSettingsHSTS, hsts)
 	return nil
 }
 
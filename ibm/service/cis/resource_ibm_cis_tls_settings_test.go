@@ -46,6 +46,27 @@ func TestAccIBMCisTLSSettings_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisTLSSettings_CiphersHSTS(t *testing.T) {
+	name := "ibm_cis_tls_settings." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisTLSSettingsConfigCiphersHSTS("test", acc.CisDomainStatic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "min_tls_version", "1.2"),
+					resource.TestCheckResourceAttr(name, "ciphers.#", "2"),
+					resource.TestCheckResourceAttr(name, "hsts.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "hsts.0.max_age", "86400"),
+					resource.TestCheckResourceAttr(name, "hsts.0.include_subdomains", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCisTLSSettings_Import(t *testing.T) {
 	name := "ibm_cis_tls_settings." + "test"
 
@@ -104,3 +125,20 @@ func testAccCheckCisTLSSettingsConfigBasic3(id string, CisDomainStatic string) s
 	  }
 `, id)
 }
+
+func testAccCheckCisTLSSettingsConfigCiphersHSTS(id string, CisDomainStatic string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_tls_settings" "%[1]s" {
+		cis_id          = data.ibm_cis.cis.id
+		domain_id       = data.ibm_cis_domain.cis_domain.domain_id
+		tls_1_3         = "on"
+		min_tls_version = "1.2"
+		ciphers         = ["ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"]
+		hsts {
+			enabled            = true
+			max_age            = 86400
+			include_subdomains = true
+		}
+	  }
+`, id)
+}
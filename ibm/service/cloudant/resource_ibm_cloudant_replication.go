@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantReplicationCreate,
+		ReadContext:   resourceIBMCloudantReplicationRead,
+		DeleteContext: resourceIBMCloudantReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"doc_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the replication document in the `_replicator` database.",
+			},
+			"source": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the source database.",
+			},
+			"source_apikey": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key used to authenticate to the source database.",
+			},
+			"target": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the target database.",
+			},
+			"target_apikey": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key used to authenticate to the target database.",
+			},
+			"create_target": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the target database is created if it does not exist.",
+			},
+			"continuous": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the replication keeps running and replicates new changes of the source database.",
+			},
+			"doc_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the documents to replicate. All documents are replicated when unset.",
+			},
+			"selector": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "A JSON selector of the documents to replicate.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the replication in the scheduler, for example `running`, `completed` or `failed`.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the replication document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	docID := d.Get("doc_id").(string)
+	replicationDocument := &cloudantv1.ReplicationDocument{
+		Source:       cloudantReplicationDatabase(d.Get("source").(string), d.Get("source_apikey").(string)),
+		Target:       cloudantReplicationDatabase(d.Get("target").(string), d.Get("target_apikey").(string)),
+		CreateTarget: flex.PtrToBool(d.Get("create_target").(bool)),
+		Continuous:   flex.PtrToBool(d.Get("continuous").(bool)),
+	}
+	if v, ok := d.GetOk("doc_ids"); ok {
+		replicationDocument.DocIds = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("selector"); ok {
+		selector := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(v.(string)), &selector); err != nil {
+			return diag.FromErr(fmt.Errorf("Error parsing selector: %s", err))
+		}
+		replicationDocument.Selector = selector
+	}
+
+	putReplicationDocumentOptions := cloudantClient.NewPutReplicationDocumentOptions(docID, replicationDocument)
+
+	_, response, err := cloudantClient.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, docID))
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(docID)
+
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("doc_id", docID)
+	if replicationDocument.Rev != nil {
+		d.Set("rev", *replicationDocument.Rev)
+	}
+	// The API keys are not returned, so the ones in the configuration are kept.
+	if replicationDocument.Source != nil && replicationDocument.Source.URL != nil {
+		d.Set("source", *replicationDocument.Source.URL)
+	}
+	if replicationDocument.Target != nil && replicationDocument.Target.URL != nil {
+		d.Set("target", *replicationDocument.Target.URL)
+	}
+	if replicationDocument.CreateTarget != nil {
+		d.Set("create_target", *replicationDocument.CreateTarget)
+	}
+	if replicationDocument.Continuous != nil {
+		d.Set("continuous", *replicationDocument.Continuous)
+	}
+	if replicationDocument.DocIds != nil {
+		d.Set("doc_ids", replicationDocument.DocIds)
+	}
+	if replicationDocument.Selector != nil {
+		selector, err := json.Marshal(replicationDocument.Selector)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting selector: %s", err))
+		}
+		d.Set("selector", string(selector))
+	}
+
+	getSchedulerDocumentOptions := cloudantClient.NewGetSchedulerDocumentOptions(docID)
+	schedulerDocument, response, err := cloudantClient.GetSchedulerDocumentWithContext(context, getSchedulerDocumentOptions)
+	if err != nil {
+		// The scheduler forgets completed replications after a while, which is not an error.
+		log.Printf("[DEBUG] GetSchedulerDocumentWithContext failed %s\n%s", err, response)
+	} else if schedulerDocument.State != nil {
+		d.Set("state", *schedulerDocument.State)
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteReplicationDocumentOptions := cloudantClient.NewDeleteReplicationDocumentOptions(docID)
+	deleteReplicationDocumentOptions.SetRev(d.Get("rev").(string))
+
+	_, response, err := cloudantClient.DeleteReplicationDocumentWithContext(context, deleteReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func cloudantReplicationDatabase(url, apikey string) *cloudantv1.ReplicationDatabase {
+	database := &cloudantv1.ReplicationDatabase{
+		URL: &url,
+	}
+	if apikey != "" {
+		database.Auth = &cloudantv1.ReplicationDatabaseAuth{
+			Iam: &cloudantv1.ReplicationDatabaseAuthIam{
+				ApiKey: &apikey,
+			},
+		}
+	}
+	return database
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantReplicationBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	docID := fmt.Sprintf("tf_replication_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantReplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantReplicationConfigBasic(instanceName, docID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "doc_id", docID),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "create_target", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_replication.cloudant_replication", "rev"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cloudant_replication.cloudant_replication",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_apikey", "target_apikey", "state"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantReplicationConfigBasic(instanceName, docID string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db           = "tf_source"
+		}
+
+		resource "ibm_cloudant_replication" "cloudant_replication" {
+			instance_crn  = ibm_cloudant.cloudant_instance.crn
+			doc_id        = "%s"
+			source        = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/${ibm_cloudant_database.cloudant_database.db}"
+			source_apikey = "%s"
+			target        = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/tf_target"
+			target_apikey = "%s"
+			create_target = true
+		}
+	`, instanceName, docID, os.Getenv("IC_API_KEY"), os.Getenv("IC_API_KEY"))
+}
+
+func testAccCheckIBMCloudantReplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_replication" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(rs.Primary.Attributes["doc_id"])
+		_, _, err = cloudantClient.GetReplicationDocument(getReplicationDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_replication still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -135,13 +135,13 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 	if _, ok := d.GetOk("rev"); ok {
 		updateAccountSettingsOptions.SetRev(d.Get("rev").(string))
 	}
-	if _, ok := d.GetOk("default_enable_new_features"); ok {
+	if _, ok := d.GetOkExists("default_enable_new_features"); ok {
 		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(d.Get("default_enable_new_features").(bool))
 	}
-	if _, ok := d.GetOk("default_enable_new_regions"); ok {
+	if _, ok := d.GetOkExists("default_enable_new_regions"); ok {
 		updateAccountSettingsOptions.SetDefaultEnableNewRegions(d.Get("default_enable_new_regions").(bool))
 	}
-	if _, ok := d.GetOk("enabled"); ok {
+	if _, ok := d.GetOkExists("enabled"); ok {
 		updateAccountSettingsOptions.SetEnabled(d.Get("enabled").(bool))
 	}
 	if _, ok := d.GetOk("features"); ok {
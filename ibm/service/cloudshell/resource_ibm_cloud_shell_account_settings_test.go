@@ -81,6 +81,24 @@ func TestAccIBMCloudShellAccountSettingsAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCloudShellAccountSettingsDisabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudShell(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigDisabled(acc.CloudShellAccountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "account_id", acc.CloudShellAccountID),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "default_enable_new_features", "false"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "default_enable_new_regions", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCloudShellAccountSettingsConfigBasic(accountID string) string {
 	return fmt.Sprintf(`
 	data "ibm_cloud_shell_account_settings" "account_settings" {
@@ -152,3 +170,19 @@ func testAccCheckIBMCloudShellAccountSettingsConfig(suffix, accountID string, de
 	}
 	`, suffix, accountID, suffix, accountID, suffix, defaultEnableNewFeatures, defaultEnableNewRegions, enabled, featureWebPreview, regionJpTok)
 }
+
+func testAccCheckIBMCloudShellAccountSettingsConfigDisabled(accountID string) string {
+	return fmt.Sprintf(`
+	data "ibm_cloud_shell_account_settings" "account_settings" {
+		account_id = "%s"
+	}
+
+	resource "ibm_cloud_shell_account_settings" "cloud_shell_account_settings" {
+		account_id = "%s"
+		rev = data.ibm_cloud_shell_account_settings.account_settings.rev
+		default_enable_new_features = false
+		default_enable_new_regions = false
+		enabled = false
+	}
+	`, accountID, accountID)
+}
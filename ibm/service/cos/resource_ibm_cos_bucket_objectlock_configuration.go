@@ -1,7 +1,9 @@
 package cos
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -11,15 +13,17 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMCOSBucketObjectlock() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCOSBucketObjectlockCreate,
-		Read:     resourceIBMCOSBucketObjectlockRead,
-		Update:   resourceIBMCOSBucketObjectlockUpdate,
-		Delete:   resourceIBMCOSBucketObjectlockDelete,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMCOSBucketObjectlockCreate,
+		Read:          resourceIBMCOSBucketObjectlockRead,
+		Update:        resourceIBMCOSBucketObjectlockUpdate,
+		Delete:        resourceIBMCOSBucketObjectlockDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceObjectLockVersioningValidate,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -72,9 +76,10 @@ func ResourceIBMCOSBucketObjectlock() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"mode": {
-													Type:        schema.TypeString,
-													Required:    true,
-													Description: "Retention modes apply different levels of protection to the objects.",
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+													Description:  "Retention modes apply different levels of protection to the objects.",
 												},
 												"years": {
 													Type:          schema.TypeInt,
@@ -101,6 +106,58 @@ func ResourceIBMCOSBucketObjectlock() *schema.Resource {
 	}
 }
 
+// resourceObjectLockVersioningValidate warns at plan time when versioning is not enabled on the bucket, object
+// lock cannot be enabled on a bucket without versioning. The plan does not fail, versioning may be enabled in
+// the same apply, for example by the object_versioning of the bucket, so it is checked again before object lock
+// is configured. A bucket that does not exist yet, like a bucket created in the same plan, is not checked.
+func resourceObjectLockVersioningValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("bucket_crn") || !diff.NewValueKnown("bucket_location") || !diff.NewValueKnown("endpoint_type") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("object_lock_configuration") {
+		return nil
+	}
+	bucketCRN := diff.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, diff.Get("bucket_location").(string), diff.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return err
+	}
+	if err = objectLockVersioningCheck(s3Client, bucketName); err != nil {
+		log.Printf("[WARN] %s, unless versioning is enabled in the same apply object lock fails to be configured", err)
+	}
+	return nil
+}
+
+// objectLockVersioningCheck returns an error when versioning is not enabled on the bucket. A failure to get the
+// versioning of the bucket is only logged, the object lock request then reports the missing prerequisite.
+func objectLockVersioningCheck(s3Client *s3.S3, bucketName string) error {
+	output, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		log.Printf("[DEBUG] failed to get the versioning of the COS bucket %s, the versioning prerequisite of object lock is not checked: %v", bucketName, err)
+		return nil
+	}
+	return objectLockVersioningStatusError(bucketName, aws.StringValue(output.Status))
+}
+
+func objectLockVersioningStatusError(bucketName, status string) error {
+	if status != s3.BucketVersioningStatusEnabled {
+		return fmt.Errorf("object lock requires versioning to be enabled on the COS bucket %s, enable object_versioning on the bucket first", bucketName)
+	}
+	return nil
+}
+
 func resourceIBMCOSBucketObjectlockCreate(d *schema.ResourceData, meta interface{}) error {
 
 	bucketCRN := d.Get("bucket_crn").(string)
@@ -116,6 +173,12 @@ func resourceIBMCOSBucketObjectlockCreate(d *schema.ResourceData, meta interface
 	}
 
 	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if err = objectLockVersioningCheck(s3Client, bucketName); err != nil {
+		return err
+	}
 	var objectLockConfiguration *s3.ObjectLockConfiguration
 	configuration, ok := d.GetOk("object_lock_configuration")
 	if ok {
@@ -154,6 +217,9 @@ func resourceIBMCOSBucketObjectlockUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 	if d.HasChange("object_lock_configuration") {
+		if err = objectLockVersioningCheck(s3Client, bucketName); err != nil {
+			return err
+		}
 		var objectLockConfiguration *s3.ObjectLockConfiguration
 		configuration, ok := d.GetOk("object_lock_configuration")
 		if ok {
@@ -301,7 +301,7 @@ func TestAccIBMCosBucket_Objectlock_Configuration_Invalid_Mode(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccCheckIBMCosBucket_Objectlock_Invalid_Mode(serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass, objectLockEnabled, mode, years),
-				ExpectError: regexp.MustCompile("MalformedXML: The XML you provided was not well-formed or did not validate against our published schema."),
+				ExpectError: regexp.MustCompile("expected object_lock_configuration.0.object_lock_rule.0.default_retention.0.mode to be one of"),
 			},
 		},
 	})
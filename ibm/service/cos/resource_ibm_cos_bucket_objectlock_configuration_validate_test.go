@@ -0,0 +1,87 @@
+package cos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectLockVersioningStatusError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		status        string
+		expectedError string
+	}{
+		{
+			name:   "enabled",
+			status: s3.BucketVersioningStatusEnabled,
+		},
+		{
+			name:          "suspended",
+			status:        s3.BucketVersioningStatusSuspended,
+			expectedError: "requires versioning to be enabled on the COS bucket bucket-name",
+		},
+		{
+			name:          "never enabled",
+			status:        "",
+			expectedError: "requires versioning to be enabled on the COS bucket bucket-name",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := objectLockVersioningStatusError("bucket-name", tc.status)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+// The plan does not look up the versioning of a bucket that is not known yet, no client session is needed.
+func TestResourceObjectLockVersioningValidateSkipsUnknownBucket(t *testing.T) {
+	// the value that the plugin SDK uses for a value that is unknown until apply
+	unknownValue := "74D93920-ED26-11E3-AC10-0800200C9A66"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_crn":      unknownValue,
+		"bucket_location": "us-south",
+		"object_lock_configuration": []interface{}{
+			map[string]interface{}{"object_lock_enabled": "Enabled"},
+		},
+	})
+
+	diff, err := ResourceIBMCOSBucketObjectlock().Diff(context.Background(), nil, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["bucket_crn"].NewComputed)
+}
+
+// An existing configuration is only checked when the object lock configuration changes.
+func TestResourceObjectLockVersioningValidateSkipsUnchangedConfiguration(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_crn":      "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:bucket-name",
+		"bucket_location": "us-south",
+		"object_lock_configuration": []interface{}{
+			map[string]interface{}{"object_lock_enabled": "Enabled"},
+		},
+	})
+	state := &terraform.InstanceState{
+		ID: "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:bucket-name:meta:us-south:public",
+		Attributes: map[string]string{
+			"id":                          "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:bucket-name:meta:us-south:public",
+			"bucket_crn":                  "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:bucket-name",
+			"bucket_location":             "us-south",
+			"endpoint_type":               "public",
+			"object_lock_configuration.#": "1",
+			"object_lock_configuration.0.object_lock_enabled": "Enabled",
+			"object_lock_configuration.0.object_lock_rule.#":  "0",
+		},
+	}
+
+	diff, err := ResourceIBMCOSBucketObjectlock().Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+}
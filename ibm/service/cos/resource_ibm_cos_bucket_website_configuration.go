@@ -3,6 +3,7 @@ package cos
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -185,6 +186,31 @@ func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"validate_public_access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to check that the public access block of the bucket allows objects made public with ACLs to be served before configuring the website.",
+			},
+			"public_access_block": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public access block configuration of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether public ACLs on the bucket and its objects are rejected.",
+						},
+						"ignore_public_acls": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether public ACLs on the bucket and its objects are ignored.",
+						},
+					},
+				},
+			},
 			"website_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -354,6 +380,14 @@ func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta
 		return err
 	}
 	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.Get("validate_public_access").(bool) {
+		if err = validateWebsitePublicAccess(s3Client, bucketName); err != nil {
+			return err
+		}
+	}
 	var websiteConfiguration *s3.WebsiteConfiguration
 	configuration, ok := d.GetOk("website_configuration")
 	if ok {
@@ -391,6 +425,11 @@ func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta
 		return err
 	}
 	if d.HasChange("website_configuration") {
+		if d.Get("validate_public_access").(bool) {
+			if err = validateWebsitePublicAccess(s3Client, bucketName); err != nil {
+				return err
+			}
+		}
 		var websiteConfiguration *s3.WebsiteConfiguration
 		configuration, ok := d.GetOk("website_configuration")
 		if ok {
@@ -452,6 +491,19 @@ func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta i
 			d.Set("website_endpoint", websiteEndpoint)
 		}
 	}
+	publicAccessBlock, err := getBucketPublicAccessBlock(s3Client, bucketName)
+	if err != nil {
+		// the public access block is informational, the website configuration is read without it
+		log.Printf("[WARN] failed to get the public access block configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if publicAccessBlock != nil {
+		d.Set("public_access_block", []map[string]interface{}{
+			{
+				"block_public_acls":  aws.BoolValue(publicAccessBlock.BlockPublicAcls),
+				"ignore_public_acls": aws.BoolValue(publicAccessBlock.IgnorePublicAcls),
+			},
+		})
+	}
 	return nil
 }
 
@@ -505,3 +557,38 @@ func parseWebsiteId(id string, info string) string {
 func getWebsiteEndpoint(bucketName string, bucketLocation string) string {
 	return fmt.Sprintf("https://%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation)
 }
+
+// getBucketPublicAccessBlock returns the public access block configuration of a bucket, a bucket without
+// one does not block public ACLs.
+func getBucketPublicAccessBlock(s3Client *s3.S3, bucketName string) (*s3.PublicAccessBlockConfiguration, error) {
+	output, err := s3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchPublicAccessBlockConfiguration") {
+			return &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:  aws.Bool(false),
+				IgnorePublicAcls: aws.Bool(false),
+			}, nil
+		}
+		return nil, err
+	}
+	return output.PublicAccessBlockConfiguration, nil
+}
+
+// validateWebsitePublicAccess checks the objects of a bucket can be made public with ACLs, the website
+// serves the objects anonymously and a public access block ignoring or blocking public ACLs prevents
+// it unless the bucket is made public through the Public Access group of IAM.
+func validateWebsitePublicAccess(s3Client *s3.S3, bucketName string) error {
+	publicAccessBlock, err := getBucketPublicAccessBlock(s3Client, bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to get the public access block configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if publicAccessBlock == nil {
+		return nil
+	}
+	if aws.BoolValue(publicAccessBlock.BlockPublicAcls) || aws.BoolValue(publicAccessBlock.IgnorePublicAcls) {
+		return fmt.Errorf("the public access block configuration of the COS bucket %s blocks or ignores public ACLs, the website cannot serve objects made public with ACLs. Remove the public access block or grant public access with the Public Access group of IAM and unset validate_public_access", bucketName)
+	}
+	return nil
+}
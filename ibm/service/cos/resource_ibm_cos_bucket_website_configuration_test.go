@@ -94,6 +94,32 @@ func TestAccIBMCosBucket_Website_Configuration_Bucket_Index_Document_Only(t *tes
 	})
 }
 
+func TestAccIBMCosBucket_Website_Configuration_Bucket_Validate_Public_Access(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-static-web-hosting%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	bucketRegionType := "cross_region_location"
+	indexSuffix := "index.html"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Website_Configuration_Bucket_Validate_Public_Access(serviceName, bucketName, bucketRegion, bucketClass, indexSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "validate_public_access", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "public_access_block.0.block_public_acls", "false"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "public_access_block.0.ignore_public_acls", "false"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.index_document.0.suffix", indexSuffix),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Website_Configuration_Bucket_With_Routing_Rule(t *testing.T) {
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	bucketName := fmt.Sprintf("terraform-static-web-hosting%d", acctest.RandIntRange(10, 100))
@@ -1002,3 +1028,37 @@ func testAccCheckIBMCosBucket_Website_Configuration_Bucket_No_Config(cosServiceN
 	 
 	`, cosServiceName, bucketName, region, storageClass, cosServiceName, bucketName)
 }
+
+func testAccCheckIBMCosBucket_Website_Configuration_Bucket_Validate_Public_Access(cosServiceName string, bucketName string, region string, storageClass string, indexSuffix string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		cross_region_location = "%s"
+		storage_class         = "%s"
+	}
+
+	resource ibm_cos_bucket_website_configuration "website" {
+		bucket_crn = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.cross_region_location
+		validate_public_access = true
+		website_configuration {
+		  index_document{
+		    suffix = "%s"
+		  }
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, indexSuffix)
+}
@@ -0,0 +1,206 @@
+package cos
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSObjectLockLegalHold() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSObjectLockLegalHoldCreate,
+		Read:     resourceIBMCOSObjectLockLegalHoldRead,
+		Update:   resourceIBMCOSObjectLockLegalHoldUpdate,
+		Delete:   resourceIBMCOSObjectLockLegalHoldDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The key of the object the legal hold is placed on.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The version of the object the legal hold is placed on, the current version when not set.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectLockLegalHoldStatusOn,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockLegalHoldStatus_Values(), false),
+				Description:  "The status of the legal hold, ON or OFF. When ON prevents deletion of the object version.",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSObjectLockLegalHoldCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	objectKey := d.Get("key").(string)
+	versionID := d.Get("version_id").(string)
+
+	if err := putObjectLegalHold(d, meta, bucketCRN, bucketLocation, endpointType, objectKey, versionID, d.Get("status").(string)); err != nil {
+		return err
+	}
+	d.SetId(getObjectLockObjectId(bucketCRN, bucketLocation, endpointType, objectKey, versionID))
+	return resourceIBMCOSObjectLockLegalHoldRead(d, meta)
+}
+
+func resourceIBMCOSObjectLockLegalHoldUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("status") {
+		err := putObjectLegalHold(d, meta, d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string), d.Get("key").(string), d.Get("version_id").(string), d.Get("status").(string))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMCOSObjectLockLegalHoldRead(d, meta)
+}
+
+func resourceIBMCOSObjectLockLegalHoldRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseObjectLockObjectId(d.Id(), "bucketCRN")
+	bucketName := parseObjectLockObjectId(d.Id(), "bucketName")
+	bucketLocation := parseObjectLockObjectId(d.Id(), "bucketLocation")
+	instanceCRN := parseObjectLockObjectId(d.Id(), "instanceCRN")
+	endpointType := parseObjectLockObjectId(d.Id(), "endpointType")
+	objectKey := parseObjectLockObjectId(d.Id(), "keyName")
+	versionID := parseObjectLockObjectId(d.Id(), "versionID")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getObjectLegalHoldInput := &s3.GetObjectLegalHoldInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}
+	if versionID != "" {
+		getObjectLegalHoldInput.VersionId = aws.String(versionID)
+	}
+	output, err := s3Client.GetObjectLegalHold(getObjectLegalHoldInput)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchKey") || strings.Contains(err.Error(), "NoSuchVersion") {
+			log.Printf("[WARN] COS bucket (%s) object (%s) not found, removing the legal hold from state", bucketName, objectKey)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the legal hold of the object %s in the COS bucket %s, %v", objectKey, bucketName, err)
+	}
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("endpoint_type", endpointType)
+	d.Set("key", objectKey)
+	d.Set("version_id", versionID)
+	if output.LegalHold != nil && output.LegalHold.Status != nil {
+		d.Set("status", output.LegalHold.Status)
+	} else {
+		d.Set("status", s3.ObjectLockLegalHoldStatusOff)
+	}
+	return nil
+}
+
+func resourceIBMCOSObjectLockLegalHoldDelete(d *schema.ResourceData, meta interface{}) error {
+	err := putObjectLegalHold(d, meta, parseObjectLockObjectId(d.Id(), "bucketCRN"), parseObjectLockObjectId(d.Id(), "bucketLocation"), parseObjectLockObjectId(d.Id(), "endpointType"), parseObjectLockObjectId(d.Id(), "keyName"), parseObjectLockObjectId(d.Id(), "versionID"), s3.ObjectLockLegalHoldStatusOff)
+	if err != nil && !strings.Contains(err.Error(), "NoSuchKey") && !strings.Contains(err.Error(), "NoSuchVersion") {
+		return err
+	}
+	return nil
+}
+
+func putObjectLegalHold(d *schema.ResourceData, meta interface{}, bucketCRN, bucketLocation, endpointType, objectKey, versionID, status string) error {
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		LegalHold: &s3.ObjectLockLegalHold{
+			Status: aws.String(status),
+		},
+	}
+	if versionID != "" {
+		putObjectLegalHoldInput.VersionId = aws.String(versionID)
+	}
+	_, err = s3Client.PutObjectLegalHold(putObjectLegalHoldInput)
+	if err != nil {
+		return fmt.Errorf("failed to put the legal hold %s on the object %s in the COS bucket %s, %v", status, objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// getObjectLockObjectId returns the ID of the object lock settings of an object, the version of the
+// object is part of the ID only when set.
+func getObjectLockObjectId(bucketCRN, bucketLocation, endpointType, objectKey, versionID string) string {
+	id := fmt.Sprintf("%s:meta:%s:%s:key:%s", bucketCRN, bucketLocation, endpointType, objectKey)
+	if versionID != "" {
+		id = fmt.Sprintf("%s:version:%s", id, versionID)
+	}
+	return id
+}
+
+func parseObjectLockObjectId(id string, info string) string {
+	if info == "keyName" || info == "versionID" {
+		object := strings.SplitN(strings.SplitN(id, ":key:", 2)[1], ":version:", 2)
+		if info == "keyName" {
+			return object[0]
+		}
+		if len(object) > 1 {
+			return object[1]
+		}
+		return ""
+	}
+	return parseObjectLockId(id, info)
+}
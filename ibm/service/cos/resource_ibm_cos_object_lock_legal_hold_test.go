@@ -0,0 +1,93 @@
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosObjectLock_Legal_Hold_And_Retention(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	retainUntilDate := "2030-01-01T00:00:00Z"
+	extendedRetainUntilDate := "2031-01-01T00:00:00Z"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosObjectLock_Legal_Hold_And_Retention(serviceName, bucketName, bucketRegion, bucketClass, "ON", retainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_object_lock_legal_hold.legal_hold", "status", "ON"),
+					resource.TestCheckResourceAttr("ibm_cos_object_lock_retention.retention", "mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_object_lock_retention.retention", "retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosObjectLock_Legal_Hold_And_Retention(serviceName, bucketName, bucketRegion, bucketClass, "OFF", extendedRetainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_object_lock_legal_hold.legal_hold", "status", "OFF"),
+					resource.TestCheckResourceAttr("ibm_cos_object_lock_retention.retention", "retain_until_date", extendedRetainUntilDate),
+				),
+			},
+			{
+				Config:      testAccCheckIBMCosObjectLock_Legal_Hold_And_Retention(serviceName, bucketName, bucketRegion, bucketClass, "OFF", retainUntilDate),
+				ExpectError: regexp.MustCompile("cannot be shortened"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosObjectLock_Legal_Hold_And_Retention(cosServiceName string, bucketName string, region string, storageClass string, legalHoldStatus string, retainUntilDate string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		cross_region_location = "%s"
+		storage_class         = "%s"
+		object_versioning {
+			enable  = true
+		}
+		object_lock = true
+	}
+	resource "ibm_cos_bucket_object" "object" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.cross_region_location
+		key             = "object-lock.txt"
+		content         = "Object lock"
+	}
+	resource "ibm_cos_object_lock_legal_hold" "legal_hold" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.cross_region_location
+		key             = ibm_cos_bucket_object.object.key
+		version_id      = ibm_cos_bucket_object.object.version_id
+		status          = "%s"
+	}
+	resource "ibm_cos_object_lock_retention" "retention" {
+		bucket_crn        = ibm_cos_bucket.bucket.crn
+		bucket_location   = ibm_cos_bucket.bucket.cross_region_location
+		key               = ibm_cos_bucket_object.object.key
+		version_id        = ibm_cos_bucket_object.object.version_id
+		mode              = "COMPLIANCE"
+		retain_until_date = "%s"
+	}
+	`, cosServiceName, bucketName, region, storageClass, legalHoldStatus, retainUntilDate)
+}
@@ -0,0 +1,226 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSObjectLockRetention() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMCOSObjectLockRetentionCreate,
+		Read:          resourceIBMCOSObjectLockRetentionRead,
+		Update:        resourceIBMCOSObjectLockRetentionUpdate,
+		Delete:        resourceIBMCOSObjectLockRetentionDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceObjectLockRetentionValidate,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The key of the object the retention is set on.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The version of the object the retention is set on, the current version when not set.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+				Description:  "Retention modes apply different levels of protection to the objects.",
+			},
+			"retain_until_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldDate, newDate := parseDate(old), parseDate(new)
+					return oldDate != nil && newDate != nil && oldDate.Equal(*newDate)
+				},
+				Description: "An object cannot be deleted when the current time is earlier than the retainUntilDate. After this date, the object can be deleted.",
+			},
+		},
+	}
+}
+
+// resourceObjectLockRetentionValidate refuses the changes the API rejects on the retention of an object in
+// compliance mode, it can neither be shortened nor be changed to governance mode.
+func resourceObjectLockRetentionValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChanges("mode", "retain_until_date") {
+		return nil
+	}
+	oldMode, newMode := diff.GetChange("mode")
+	oldDate, newDate := diff.GetChange("retain_until_date")
+	return validateObjectLockRetentionChange(oldMode.(string), newMode.(string), oldDate.(string), newDate.(string))
+}
+
+// validateObjectLockRetentionChange returns an error when the retention of an object is changed from
+// oldMode and oldDate to newMode and newDate in a way the API rejects.
+func validateObjectLockRetentionChange(oldMode, newMode, oldDate, newDate string) error {
+	if oldMode != s3.ObjectLockRetentionModeCompliance {
+		return nil
+	}
+	if newMode != s3.ObjectLockRetentionModeCompliance {
+		return fmt.Errorf("the retention of an object in %s mode cannot be changed to %s mode", s3.ObjectLockRetentionModeCompliance, newMode)
+	}
+	oldRetainUntil := parseDate(oldDate)
+	newRetainUntil := parseDate(newDate)
+	if oldRetainUntil != nil && newRetainUntil != nil && newRetainUntil.Before(*oldRetainUntil) {
+		return fmt.Errorf("the retention of an object in %s mode cannot be shortened, retain_until_date must not be earlier than %s", s3.ObjectLockRetentionModeCompliance, oldDate)
+	}
+	return nil
+}
+
+func resourceIBMCOSObjectLockRetentionCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	objectKey := d.Get("key").(string)
+	versionID := d.Get("version_id").(string)
+
+	if err := putObjectRetention(d, meta); err != nil {
+		return err
+	}
+	d.SetId(getObjectLockObjectId(bucketCRN, bucketLocation, endpointType, objectKey, versionID))
+	return resourceIBMCOSObjectLockRetentionRead(d, meta)
+}
+
+func resourceIBMCOSObjectLockRetentionUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("mode", "retain_until_date") {
+		if err := putObjectRetention(d, meta); err != nil {
+			return err
+		}
+	}
+	return resourceIBMCOSObjectLockRetentionRead(d, meta)
+}
+
+func resourceIBMCOSObjectLockRetentionRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseObjectLockObjectId(d.Id(), "bucketCRN")
+	bucketName := parseObjectLockObjectId(d.Id(), "bucketName")
+	bucketLocation := parseObjectLockObjectId(d.Id(), "bucketLocation")
+	instanceCRN := parseObjectLockObjectId(d.Id(), "instanceCRN")
+	endpointType := parseObjectLockObjectId(d.Id(), "endpointType")
+	objectKey := parseObjectLockObjectId(d.Id(), "keyName")
+	versionID := parseObjectLockObjectId(d.Id(), "versionID")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getObjectRetentionInput := &s3.GetObjectRetentionInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}
+	if versionID != "" {
+		getObjectRetentionInput.VersionId = aws.String(versionID)
+	}
+	output, err := s3Client.GetObjectRetention(getObjectRetentionInput)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchKey") || strings.Contains(err.Error(), "NoSuchVersion") {
+			log.Printf("[WARN] COS bucket (%s) object (%s) not found, removing the retention from state", bucketName, objectKey)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the retention of the object %s in the COS bucket %s, %v", objectKey, bucketName, err)
+	}
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("endpoint_type", endpointType)
+	d.Set("key", objectKey)
+	d.Set("version_id", versionID)
+	if output.Retention != nil {
+		if output.Retention.Mode != nil {
+			d.Set("mode", output.Retention.Mode)
+		}
+		if output.Retention.RetainUntilDate != nil {
+			d.Set("retain_until_date", output.Retention.RetainUntilDate.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSObjectLockRetentionDelete(d *schema.ResourceData, meta interface{}) error {
+	// a retention cannot be removed before its date, the object stays protected until then
+	log.Printf("[WARN] the retention of the object %s is kept until %s, it is only removed from the state", d.Get("key").(string), d.Get("retain_until_date").(string))
+	return nil
+}
+
+func putObjectRetention(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	objectKey := d.Get("key").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	putObjectRetentionInput := &s3.PutObjectRetentionInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Retention: &s3.ObjectLockRetention{
+			Mode:            aws.String(d.Get("mode").(string)),
+			RetainUntilDate: parseDate(d.Get("retain_until_date").(string)),
+		},
+	}
+	if versionID := d.Get("version_id").(string); versionID != "" {
+		putObjectRetentionInput.VersionId = aws.String(versionID)
+	}
+	_, err = s3Client.PutObjectRetention(putObjectRetentionInput)
+	if err != nil {
+		return fmt.Errorf("failed to put the retention on the object %s in the COS bucket %s, %v", objectKey, bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package cos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateObjectLockRetentionChange(t *testing.T) {
+	testCases := []struct {
+		name             string
+		oldMode, newMode string
+		oldDate, newDate string
+		expectedError    string
+	}{
+		{
+			name:    "governance to compliance",
+			oldMode: "GOVERNANCE", newMode: "COMPLIANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2030-01-01T00:00:00Z",
+		},
+		{
+			name:    "governance shortened",
+			oldMode: "GOVERNANCE", newMode: "GOVERNANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2029-01-01T00:00:00Z",
+		},
+		{
+			name:    "compliance extended",
+			oldMode: "COMPLIANCE", newMode: "COMPLIANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2031-01-01T00:00:00Z",
+		},
+		{
+			name:    "compliance with the same date in another offset",
+			oldMode: "COMPLIANCE", newMode: "COMPLIANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2030-01-01T01:00:00+01:00",
+		},
+		{
+			name:    "compliance shortened",
+			oldMode: "COMPLIANCE", newMode: "COMPLIANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2029-01-01T00:00:00Z",
+			expectedError: "cannot be shortened",
+		},
+		{
+			name:    "compliance to governance",
+			oldMode: "COMPLIANCE", newMode: "GOVERNANCE",
+			oldDate: "2030-01-01T00:00:00Z", newDate: "2031-01-01T00:00:00Z",
+			expectedError: "cannot be changed to GOVERNANCE mode",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateObjectLockRetentionChange(tc.oldMode, tc.newMode, tc.oldDate, tc.newDate)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
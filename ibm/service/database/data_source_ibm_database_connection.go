@@ -65,8 +65,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -125,6 +126,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -179,14 +181,16 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
 						},
 						"environment": &schema.Schema{
 							Type:        schema.TypeMap,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "A map of environment variables for a CLI connection.",
 							Elem: &schema.Schema{
@@ -238,8 +242,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -298,6 +303,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -351,8 +357,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -411,6 +418,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -459,8 +467,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -519,6 +528,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -567,8 +577,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -627,6 +638,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -675,8 +687,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -735,6 +748,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -783,8 +797,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -843,6 +858,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -891,8 +907,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -951,6 +968,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1009,8 +1027,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -1069,6 +1088,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1117,8 +1137,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -1177,6 +1198,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1225,8 +1247,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -1285,6 +1308,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1333,8 +1357,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -1393,6 +1418,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1476,6 +1502,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -1514,8 +1541,9 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 							Description: "Type of connection being described.",
 						},
 						"composed": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
+							Type:      schema.TypeList,
+							Sensitive: true,
+							Computed:  true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -1574,6 +1602,7 @@ func DataSourceIBMDatabaseConnection() *schema.Resource {
 									},
 									"password": &schema.Schema{
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password part of credential.",
 									},
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+func DataSourceIBMDatabaseReplicationTopology() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDatabaseReplicationTopologyRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Deployment ID of the leader or of one of its read-only replicas.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The role of the deployment: leader when it has read-only replicas, replica when it replicates a leader, otherwise standalone.",
+			},
+			"leader_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the leader of the topology, the deployment itself when it is not a replica.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The leader and the read-only replicas of the topology, the leader first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the deployment.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the deployment, empty when it can not be read with the credentials of the provider.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the deployment in the topology: leader or replica.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the deployment.",
+						},
+						"leader_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the leader replicated by the deployment, empty for the leader.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDatabaseReplicationTopologyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	remotes, err := listDatabaseRemotes(context, cloudDatabasesClient, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role := "standalone"
+	leaderID := deploymentID
+	replicas := remotes.Replicas
+	if remotes.Leader != nil && *remotes.Leader != "" {
+		role = "replica"
+		leaderID = *remotes.Leader
+		leaderRemotes, err := listDatabaseRemotes(context, cloudDatabasesClient, leaderID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		replicas = leaderRemotes.Replicas
+	} else if len(replicas) > 0 {
+		role = "leader"
+	}
+
+	members := []map[string]interface{}{
+		dataSourceIBMDatabaseReplicationTopologyMember(context, cloudDatabasesClient, leaderID, "leader", ""),
+	}
+	for _, replica := range replicas {
+		members = append(members, dataSourceIBMDatabaseReplicationTopologyMember(context, cloudDatabasesClient, replica, "replica", leaderID))
+	}
+
+	d.SetId(deploymentID)
+	d.Set("role", role)
+	d.Set("leader_id", leaderID)
+	if err = d.Set("members", members); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting members: %s", err))
+	}
+
+	return nil
+}
+
+func listDatabaseRemotes(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID string) (*clouddatabasesv5.Remotes, error) {
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{}
+	listRemotesOptions.SetID(deploymentID)
+
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRemotesWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("ListRemotesWithContext on %s failed %s\n%s", deploymentID, err, response)
+	}
+	if remotes.Remotes == nil {
+		return &clouddatabasesv5.Remotes{}, nil
+	}
+	return remotes.Remotes, nil
+}
+
+// dataSourceIBMDatabaseReplicationTopologyMember describes a deployment of the topology, the region is
+// read from its CRN since the deployments of a topology can be in other regions than the provider.
+func dataSourceIBMDatabaseReplicationTopologyMember(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, id, role, leaderID string) map[string]interface{} {
+	member := map[string]interface{}{
+		"id":        id,
+		"role":      role,
+		"leader_id": leaderID,
+	}
+	if parts := strings.Split(id, ":"); len(parts) > 5 {
+		member["region"] = parts[5]
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: &id,
+	}
+	deploymentInfo, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetDeploymentInfoWithContext on %s failed %s\n%s", id, err, response)
+		return member
+	}
+	if deploymentInfo.Deployment != nil && deploymentInfo.Deployment.Name != nil {
+		member["name"] = *deploymentInfo.Deployment.Name
+	}
+	return member
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMDatabaseReplicationTopologyDataSourceBasic(t *testing.T) {
+
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReplicationTopologyDataSourceConfigBasic(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_database_replication_topology.leader", "role", "leader"),
+					resource.TestCheckResourceAttr("data.ibm_database_replication_topology.replica", "role", "replica"),
+					resource.TestCheckResourceAttrPair("data.ibm_database_replication_topology.replica", "leader_id", "ibm_database.db", "id"),
+					resource.TestCheckResourceAttr("data.ibm_database_replication_topology.replica", "members.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_database_replication_topology.replica", "members.0.role", "leader"),
+					resource.TestCheckResourceAttrPair("data.ibm_database_replication_topology.replica", "members.1.id", "ibm_database.db_replica", "id"),
+					resource.TestCheckResourceAttr("data.ibm_database_replication_topology.replica", "members.1.region", acc.Region()),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReplicationTopologyDataSourceConfigBasic(name string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig4(name) + `
+		data "ibm_database_replication_topology" "leader" {
+			deployment_id = ibm_database.db.id
+
+		depends_on = [
+			ibm_database.db_replica,
+		]
+		}
+
+		data "ibm_database_replication_topology" "replica" {
+			deployment_id = ibm_database.db_replica.id
+		}
+	`
+}
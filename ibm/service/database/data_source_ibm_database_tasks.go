@@ -6,7 +6,6 @@ package database
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -29,6 +28,17 @@ func DataSourceIBMDatabaseTasks() *schema.Resource {
 					"ibm_database_tasks",
 					"deployment_id"),
 			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the tasks with this status, for example `running` to find the in-flight tasks of the deployment.",
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_database_tasks", "status"),
+			},
+			"running": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a task of the deployment is running.",
+			},
 			"tasks": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -84,6 +94,14 @@ func DataSourceIBMDatabaseTasksValidator() *validate.ResourceValidator {
 			CloudDataType:              "cloud-database",
 			CloudDataRange:             []string{"resolved_to:id"}})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "status",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "completed, failed, running"})
+
 	iBMDatabaseTasksValidator := validate.ResourceValidator{ResourceName: "ibm_database_tasks", Schema: validateSchema}
 	return &iBMDatabaseTasksValidator
 }
@@ -103,31 +121,28 @@ func dataSourceIBMDatabaseTasksRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("ListDeploymentTasksWithContext failed %s\n%s", err, response))
 	}
 
-	// Use the provided filter argument and construct a new list with only the requested resource(s)
+	// A deployment without tasks returns an empty list, so that pipelines can check for in-flight
+	// maintenance without failing.
+	deploymentID := d.Get("deployment_id").(string)
+	status := d.Get("status").(string)
 	var matchTasks []clouddatabasesv5.Task
-	var deploymentID string
-	var suppliedFilter bool
-
-	if v, ok := d.GetOk("deployment_id"); ok {
-		deploymentID = v.(string)
-		suppliedFilter = true
-		for _, data := range tasks.Tasks {
-			if *data.DeploymentID == deploymentID {
-				matchTasks = append(matchTasks, data)
-			}
+	running := false
+	for _, data := range tasks.Tasks {
+		if data.DeploymentID != nil && *data.DeploymentID != deploymentID {
+			continue
+		}
+		if data.Status != nil && *data.Status == "running" {
+			running = true
+		}
+		if status == "" || (data.Status != nil && *data.Status == status) {
+			matchTasks = append(matchTasks, data)
 		}
-	} else {
-		matchTasks = tasks.Tasks
 	}
 	tasks.Tasks = matchTasks
+	d.SetId(deploymentID)
 
-	if suppliedFilter {
-		if len(tasks.Tasks) == 0 {
-			return diag.FromErr(fmt.Errorf("no Tasks found with deploymentID %s", deploymentID))
-		}
-		d.SetId(deploymentID)
-	} else {
-		d.SetId(DataSourceIBMDatabaseTasksID(d))
+	if err = d.Set("running", running); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting running %s", err))
 	}
 
 	tasks2 := []map[string]interface{}{}
@@ -147,11 +162,6 @@ func dataSourceIBMDatabaseTasksRead(context context.Context, d *schema.ResourceD
 	return nil
 }
 
-// DataSourceIBMDatabaseTasksID returns a reasonable ID for the list.
-func DataSourceIBMDatabaseTasksID(d *schema.ResourceData) string {
-	return time.Now().UTC().String()
-}
-
 func DataSourceIBMDatabaseTasksTaskToMap(model *clouddatabasesv5.Task) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	if model.ID != nil {
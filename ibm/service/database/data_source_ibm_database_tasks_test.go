@@ -24,6 +24,8 @@ func TestAccIBMDatabaseTasksDataSourceBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_database_tasks.database_tasks", "deployment_id"),
 					resource.TestCheckResourceAttrSet("data.ibm_database_tasks.database_tasks", "tasks.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_tasks.database_tasks", "running"),
+					resource.TestCheckResourceAttr("data.ibm_database_tasks.running_tasks", "status", "running"),
 				),
 			},
 		},
@@ -35,5 +37,10 @@ func testAccCheckIBMDatabaseTasksDataSourceConfigBasic(name string) string {
 		data "ibm_database_tasks" "database_tasks" {
 			deployment_id = "%[1]s"
 		}
+
+		data "ibm_database_tasks" "running_tasks" {
+			deployment_id = "%[1]s"
+			status        = "running"
+		}
 	`, acc.IcdDbDeploymentId)
 }
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+// databaseUpgradeDowntime describes the downtime expected from the upgrade methods of the deployables.
+var databaseUpgradeDowntime = map[string]string{
+	"in-place": "The members of the deployment are upgraded and restarted one at a time, the open connections are dropped during each restart.",
+	"restore":  "The deployment is restored to the new version from a backup, the data written after the backup is not restored.",
+}
+
+func DataSourceIBMDatabaseUpgradePreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDatabaseUpgradePreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Deployment ID.",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_database_upgrade_preview",
+					"deployment_id"),
+			},
+			"target_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The version to validate the upgrade to.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The database type of the deployment.",
+			},
+			"current_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current version of the deployment.",
+			},
+			"target_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the deployment can be upgraded to target_version.",
+			},
+			"upgrades": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions the deployment can be upgraded to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The target version of the upgrade.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the target version, e.g. `stable`, `beta` or `deprecated`.",
+						},
+						"is_preferred": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the target version is the preferred version of the database type.",
+						},
+						"method": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The upgrade method, `in-place` or `restore`.",
+						},
+						"expected_downtime": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The downtime expected from the upgrade method.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMDatabaseUpgradePreviewValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "deployment_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cloud-database",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMDatabaseUpgradePreviewValidator := validate.ResourceValidator{ResourceName: "ibm_database_upgrade_preview", Schema: validateSchema}
+	return &iBMDatabaseUpgradePreviewValidator
+}
+
+func dataSourceIBMDatabaseUpgradePreviewRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{}
+	getDeploymentInfoOptions.SetID(deploymentID)
+	deploymentInfo, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetDeploymentInfoWithContext failed %s\n%s", err, response))
+	}
+	deployment := deploymentInfo.Deployment
+	if deployment == nil || deployment.Version == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] The version of the deployment %s is unknown", deploymentID))
+	}
+
+	deployables, response, err := cloudDatabasesClient.ListDeployablesWithContext(context, &clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("ListDeployablesWithContext failed %s\n%s", err, response))
+	}
+
+	upgrades := dataSourceIBMDatabaseUpgradePreviewUpgrades(deployables.Deployables, deployment.Type, *deployment.Version)
+	targetVersion := d.Get("target_version").(string)
+	targetAllowed := false
+	for _, upgrade := range upgrades {
+		if upgrade["version"] == targetVersion {
+			targetAllowed = true
+		}
+	}
+
+	d.SetId(deploymentID)
+	if err = d.Set("type", deployment.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type %s", err))
+	}
+	if err = d.Set("current_version", deployment.Version); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting current_version %s", err))
+	}
+	if err = d.Set("target_allowed", targetAllowed); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target_allowed %s", err))
+	}
+	if err = d.Set("upgrades", upgrades); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting upgrades %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMDatabaseUpgradePreviewUpgrades returns the transitions from the current version of the
+// deployment, with the status of their target version.
+func dataSourceIBMDatabaseUpgradePreviewUpgrades(deployables []clouddatabasesv5.Deployables, deploymentType *string, currentVersion string) []map[string]interface{} {
+	targets := map[string]clouddatabasesv5.DeployablesVersionsItem{}
+	for _, deployable := range deployables {
+		if deploymentType != nil && (deployable.Type == nil || *deployable.Type != *deploymentType) {
+			continue
+		}
+		for _, version := range deployable.Versions {
+			if version.Version != nil {
+				targets[*version.Version] = version
+			}
+		}
+	}
+
+	upgrades := []map[string]interface{}{}
+	current, ok := targets[currentVersion]
+	if !ok {
+		return upgrades
+	}
+	for _, transition := range current.Transitions {
+		if transition.ToVersion == nil || (transition.FromVersion != nil && *transition.FromVersion != currentVersion) {
+			continue
+		}
+		upgrade := map[string]interface{}{
+			"version": *transition.ToVersion,
+			"method":  transition.Method,
+		}
+		if transition.Method != nil {
+			upgrade["expected_downtime"] = databaseUpgradeDowntime[*transition.Method]
+		}
+		if target, ok := targets[*transition.ToVersion]; ok {
+			upgrade["status"] = target.Status
+			upgrade["is_preferred"] = target.IsPreferred
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+	return upgrades
+}
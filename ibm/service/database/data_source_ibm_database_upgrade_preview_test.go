@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUpgradePreviewDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseUpgradePreviewDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_database_upgrade_preview.upgrade_preview", "type"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_upgrade_preview.upgrade_preview", "current_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_upgrade_preview.upgrade_preview", "upgrades.#"),
+					resource.TestCheckResourceAttr("data.ibm_database_upgrade_preview.upgrade_preview", "target_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUpgradePreviewDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_database_upgrade_preview" "upgrade_preview" {
+			deployment_id  = "%[1]s"
+			target_version = "1"
+		}
+	`, acc.IcdDbDeploymentId)
+}
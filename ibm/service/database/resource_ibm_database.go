@@ -19,6 +19,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -143,7 +144,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			validateGroupsDiff,
 			validateUsersDiff),
 
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
+				d.Set("skip_final_backup", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -233,7 +239,54 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					}
 					return json
 				},
-				Description: "The configuration in JSON format",
+				DiffSuppressFunc: suppressDatabaseConfigurationRemoval,
+				Description:      "The configuration in JSON format",
+			},
+			"redis_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The common Redis configuration keys of a databases-for-redis instance, merged with configuration",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"appendonly": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+							Description:  "Whether the append only file (AOF) persistence is enabled",
+						},
+						"maxmemory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The memory limit in bytes of the dataset",
+						},
+						"maxmemory_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(databaseRedisEvictionPolicies, false),
+							Description:  "The eviction policy applied when maxmemory is reached",
+						},
+						"maxmemory_samples": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of keys sampled by the LRU, LFU and TTL eviction policies",
+						},
+						"stop_writes_on_bgsave_error": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+							Description:  "Whether writes are refused when the last RDB snapshot failed",
+						},
+					},
+				},
 			},
 			"configuration_schema": {
 				Type:        schema.TypeString,
@@ -264,11 +317,31 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"deletion_protection": {
+				Description: "Whether the instance is locked in the resource controller, which prevents it from being deleted or updated until it is unlocked. The provider refuses to destroy the instance while it is set.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"skip_final_backup": {
+				Description: "Whether the on-demand backup that is taken before the instance is deleted is skipped. When set to false, the deletion waits for the backup to complete, and fails if it does not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"remote_leader_id": {
-				Description:      "The CRN of leader database",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: flex.ApplyOnce,
+				Description: "The CRN of leader database. Removing it promotes the read-only replica to a standalone deployment, changing it replaces the replica with a replica of the new leader.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// a leader can only be set when the deployment is created
+					return o == "" && n != "" && d.Id() != ""
+				},
+			},
+			"skip_initial_backup": {
+				Description: "Whether the initial backup of a read-only replica that is promoted by removing remote_leader_id is skipped. Skipping it makes the promotion faster, but the deployment has no backup until the next scheduled one.",
+				Type:        schema.TypeBool,
+				Optional:    true,
 			},
 			"key_protect_instance": {
 				Description: "The CRN of Key protect instance",
@@ -976,7 +1049,7 @@ func ResourceIBMICDValidator() *validate.ResourceValidator {
 			Identifier:                 "group_id",
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
-			AllowedValues:              "member, analytics, bi_connector, search",
+			AllowedValues:              "member, analytics, bi_connector, search, ml, master",
 			Required:                   true})
 
 	ibmICDResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database", Schema: validateSchema}
@@ -1107,6 +1180,48 @@ func getGroups(instanceID string, meta interface{}) (groups []clouddatabasesv5.G
 	return groupsResponse.Groups, nil
 }
 
+// getGroup returns the current scaling of a group of the deployment.
+func getGroup(instanceID string, groupID string, meta interface{}) (*Group, error) {
+	groupList, err := getGroups(instanceID, meta)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range normalizeGroups(groupList) {
+		if g.ID == groupID {
+			return &g, nil
+		}
+	}
+	return nil, fmt.Errorf("[ERROR] Group %s does not exist", groupID)
+}
+
+// setDatabaseScalingGroup applies the scaling of a group and waits for the scaling task to complete.
+func setDatabaseScalingGroup(instanceID string, groupID string, groupScaling *clouddatabasesv5.GroupScaling, d *schema.ResourceData, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	setDeploymentScalingGroupOptions := &clouddatabasesv5.SetDeploymentScalingGroupOptions{
+		ID:      &instanceID,
+		GroupID: &groupID,
+		Group:   groupScaling,
+	}
+
+	setDeploymentScalingGroupResponse, response, err := cloudDatabasesClient.SetDeploymentScalingGroup(setDeploymentScalingGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] SetDeploymentScalingGroup (%s) failed %s\n%s", groupID, err, response)
+	}
+
+	// API may return HTTP 204 No Content if no change made
+	if response.StatusCode == 202 {
+		_, err = waitForDatabaseTaskComplete(*setDeploymentScalingGroupResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for group (%s) scaling task to complete: %s", groupID, err)
+		}
+	}
+	return nil
+}
+
 type CountLimit struct {
 	Units           string
 	AllocationCount int
@@ -1133,13 +1248,52 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		return fmt.Errorf("[ERROR] logical_replication_slot is only supported for databases-for-postgresql")
 	}
 
+	// a read-only replica can not be re-pointed to another leader, it is replaced by a replica of the new leader
+	if diff.Id() != "" && diff.HasChange("remote_leader_id") {
+		oldLeader, newLeader := diff.GetChange("remote_leader_id")
+		if oldLeader.(string) != "" && newLeader.(string) != "" {
+			if err = diff.ForceNew("remote_leader_id"); err != nil {
+				return err
+			}
+		}
+	}
+
 	configJSON, configOk := diff.GetOk("configuration")
+	redisConfig := databaseRedisConfiguration(diff.GetRawConfig())
 
-	if configOk {
-		var rawConfig map[string]json.RawMessage
-		err = json.Unmarshal([]byte(configJSON.(string)), &rawConfig)
+	if len(redisConfig) > 0 && service != "databases-for-redis" {
+		return fmt.Errorf("[ERROR] redis_configuration is only supported for databases-for-redis")
+	}
+
+	if configOk || len(redisConfig) > 0 {
+		var userConfig map[string]interface{}
+		if configOk {
+			if err = json.Unmarshal([]byte(configJSON.(string)), &userConfig); err != nil {
+				return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+			}
+		}
+		for key, value := range redisConfig {
+			configValue, ok := userConfig[key]
+			if !ok {
+				continue
+			}
+			a, _ := json.Marshal(configValue)
+			b, _ := json.Marshal(value)
+			if string(a) != string(b) {
+				return fmt.Errorf("[ERROR] configuration sets %s to %v, which conflicts with the value %v of redis_configuration", key, configValue, value)
+			}
+		}
+		policy, ok := redisConfig["maxmemory-policy"]
+		if !ok {
+			policy, ok = userConfig["maxmemory-policy"]
+		}
+		if _, samplesOk := redisConfig["maxmemory-samples"]; samplesOk && ok && policy == "noeviction" {
+			return fmt.Errorf("[ERROR] maxmemory_samples has no effect with the noeviction maxmemory_policy")
+		}
+
+		rawConfig, err := databaseConfiguration(diff.Get("configuration").(string), redisConfig)
 		if err != nil {
-			return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+			return err
 		}
 
 		var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
@@ -1190,6 +1344,146 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 	return nil
 }
 
+// databaseRedisEvictionPolicies are the values of the maxmemory-policy Redis configuration key.
+var databaseRedisEvictionPolicies = []string{"noeviction", "allkeys-lru", "allkeys-lfu", "allkeys-random", "volatile-lru", "volatile-lfu", "volatile-random", "volatile-ttl"}
+
+// databaseRedisConfigurationKeys maps the arguments of the redis_configuration block to their Redis
+// configuration keys.
+var databaseRedisConfigurationKeys = map[string]string{
+	"appendonly":                  "appendonly",
+	"maxmemory":                   "maxmemory",
+	"maxmemory_policy":            "maxmemory-policy",
+	"maxmemory_samples":           "maxmemory-samples",
+	"stop_writes_on_bgsave_error": "stop-writes-on-bgsave-error",
+}
+
+// databaseRedisConfiguration returns the Redis configuration keys set in the redis_configuration block of
+// the raw configuration. The values read back into the state are ignored, so that only the keys set by
+// the user are sent to the configuration API.
+func databaseRedisConfiguration(rawConfig cty.Value) map[string]interface{} {
+	redisConfig := map[string]interface{}{}
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return redisConfig
+	}
+	block := rawConfig.GetAttr("redis_configuration")
+	if block.IsNull() || !block.IsKnown() || block.LengthInt() == 0 {
+		return redisConfig
+	}
+	values := block.Index(cty.NumberIntVal(0))
+	if values.IsNull() || !values.IsKnown() {
+		return redisConfig
+	}
+	for arg, key := range databaseRedisConfigurationKeys {
+		value := values.GetAttr(arg)
+		if value.IsNull() || !value.IsKnown() {
+			continue
+		}
+		if value.Type() == cty.Number {
+			i, _ := value.AsBigFloat().Int64()
+			redisConfig[key] = i
+		} else {
+			redisConfig[key] = value.AsString()
+		}
+	}
+	return redisConfig
+}
+
+// databaseConfiguration merges the keys of the redis_configuration block into the configuration JSON.
+func databaseConfiguration(configJSON string, redisConfig map[string]interface{}) (map[string]json.RawMessage, error) {
+	rawConfig := map[string]json.RawMessage{}
+	if configJSON != "" {
+		if err := json.Unmarshal([]byte(configJSON), &rawConfig); err != nil {
+			return nil, fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+		}
+	}
+	for key, value := range redisConfig {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		rawConfig[key] = b
+	}
+	return rawConfig, nil
+}
+
+// flattenDatabaseRedisConfiguration returns the redis_configuration block. The configuration API does not
+// return the current values, so the keys set by configuration or redis_configuration are kept and the
+// others are read from the defaults of the configuration schema.
+func flattenDatabaseRedisConfiguration(d *schema.ResourceData, configSchema []byte) []map[string]interface{} {
+	defaults := map[string]interface{}{}
+	var document map[string]interface{}
+	if err := json.Unmarshal(configSchema, &document); err == nil {
+		if nested, ok := document["schema"].(map[string]interface{}); ok {
+			document = nested
+		}
+		for key, property := range document {
+			if p, ok := property.(map[string]interface{}); ok {
+				if value, ok := p["default"]; ok {
+					defaults[key] = value
+				}
+			}
+		}
+	}
+	userConfig := map[string]interface{}{}
+	if configJSON, ok := d.GetOk("configuration"); ok {
+		json.Unmarshal([]byte(configJSON.(string)), &userConfig)
+	}
+
+	redisConfig := map[string]interface{}{}
+	for arg, key := range databaseRedisConfigurationKeys {
+		value, ok := userConfig[key]
+		if !ok {
+			value, ok = d.GetOk("redis_configuration.0." + arg)
+		}
+		if !ok {
+			value, ok = defaults[key]
+		}
+		if !ok || value == nil {
+			continue
+		}
+		switch arg {
+		case "maxmemory", "maxmemory_samples":
+			switch v := value.(type) {
+			case int:
+				redisConfig[arg] = v
+			case float64:
+				redisConfig[arg] = int(v)
+			case string:
+				if i, err := strconv.Atoi(v); err == nil {
+					redisConfig[arg] = i
+				}
+			}
+		default:
+			redisConfig[arg] = fmt.Sprint(value)
+		}
+	}
+	return []map[string]interface{}{redisConfig}
+}
+
+// suppressDatabaseConfigurationRemoval suppresses the removal of keys from the configuration JSON, as the
+// configuration API can not unset them.
+func suppressDatabaseConfigurationRemoval(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" {
+		return false
+	}
+	var oldConfig, newConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &oldConfig); err != nil {
+		return false
+	}
+	if new != "" {
+		if err := json.Unmarshal([]byte(new), &newConfig); err != nil {
+			return false
+		}
+	}
+	for key, value := range newConfig {
+		oldValue, ok := oldConfig[key]
+		if !ok || !reflect.DeepEqual(oldValue, value) {
+			return false
+		}
+	}
+	return true
+}
+
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
 func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
@@ -1562,11 +1856,11 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if config, ok := d.GetOk("configuration"); ok {
-		var rawConfig map[string]json.RawMessage
-		err = json.Unmarshal([]byte(config.(string)), &rawConfig)
+	redisConfig := databaseRedisConfiguration(d.GetRawConfig())
+	if _, ok := d.GetOk("configuration"); ok || len(redisConfig) > 0 {
+		rawConfig, err := databaseConfiguration(d.Get("configuration").(string), redisConfig)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err))
+			return diag.FromErr(err)
 		}
 
 		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
@@ -1633,6 +1927,13 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		err = setDatabaseInstanceLock(rsConClient, *instance.ID, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
@@ -1671,6 +1972,9 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	d.Set("tags", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
+	if instance.Locked != nil {
+		d.Set("deletion_protection", *instance.Locked)
+	}
 	d.Set("resource_group_id", *instance.ResourceGroupID)
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
@@ -1816,17 +2120,42 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		if err = d.Set("configuration_schema", string(s)); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting the database configuration schema: %s", err))
 		}
+
+		if serviceOff == "databases-for-redis" {
+			if err = d.Set("redis_configuration", flattenDatabaseRedisConfiguration(d, s)); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error setting redis_configuration: %s", err))
+			}
+		}
 	}
 	return nil
 }
 
-func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
+
+	// a locked instance can not be updated in the resource controller. The lock is restored when the
+	// update returns early with an error, so that a failed update never leaves the instance unprotected
+	locked := false
+	if oldProtection, _ := d.GetChange("deletion_protection"); oldProtection.(bool) {
+		err = setDatabaseInstanceLock(rsConClient, instanceID, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer func() {
+			if locked || !d.Get("deletion_protection").(bool) {
+				return
+			}
+			if err := setDatabaseInstanceLock(rsConClient, instanceID, true); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}()
+	}
+
 	updateReq := rc.UpdateResourceInstanceOptions{
 		ID: &instanceID,
 	}
@@ -1878,12 +2207,36 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	}
 	icdId := flex.EscapeUrlParm(instanceID)
 
-	if d.HasChange("configuration") {
-		if config, ok := d.GetOk("configuration"); ok {
-			var rawConfig map[string]json.RawMessage
-			err = json.Unmarshal([]byte(config.(string)), &rawConfig)
+	if d.HasChange("remote_leader_id") {
+		if oldLeader, newLeader := d.GetChange("remote_leader_id"); oldLeader.(string) != "" && newLeader.(string) == "" {
+			promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+				ID: &instanceID,
+				Promotion: map[string]interface{}{
+					"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+				},
+			}
+
+			promoteReadOnlyReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err))
+				return diag.FromErr(fmt.Errorf(
+					"[ERROR] Error promoting read-only replica %s of leader %s: %s\n%s", icdId, oldLeader, err, response))
+			}
+
+			taskID := *promoteReadOnlyReplicaResponse.Task.ID
+			_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf(
+					"[ERROR] Error waiting for database (%s) promotion task to complete: %s", icdId, err))
+			}
+		}
+	}
+
+	if d.HasChange("configuration") || d.HasChange("redis_configuration") {
+		redisConfig := databaseRedisConfiguration(d.GetRawConfig())
+		if _, ok := d.GetOk("configuration"); ok || len(redisConfig) > 0 {
+			rawConfig, err := databaseConfiguration(d.Get("configuration").(string), redisConfig)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
@@ -1937,7 +2290,6 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		currentGroups := normalizeGroups(groupsResponse)
 
 		for _, group := range groupChanges {
-			groupScaling := &clouddatabasesv5.GroupScaling{}
 			var currentGroup *Group
 			for _, g := range currentGroups {
 				if g.ID == group.ID {
@@ -1952,10 +2304,26 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			}
 			nodeCount := currentGroup.Members.Allocation
 
+			// the members are scaled on their own first, so that the resources of the group are then
+			// scaled for the members that are actually running
 			if group.Members != nil && group.Members.Allocation != currentGroup.Members.Allocation {
-				groupScaling.Members = &clouddatabasesv5.GroupScalingMembers{AllocationCount: core.Int64Ptr(int64(group.Members.Allocation))}
-				nodeCount = group.Members.Allocation
+				membersScaling := &clouddatabasesv5.GroupScaling{
+					Members: &clouddatabasesv5.GroupScalingMembers{AllocationCount: core.Int64Ptr(int64(group.Members.Allocation))},
+				}
+				err = setDatabaseScalingGroup(instanceID, group.ID, membersScaling, d, meta)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+
+				currentGroup, err = getGroup(instanceID, group.ID, meta)
+				if err != nil {
+					return diag.FromErr(fmt.Errorf(
+						"[ERROR] Error getting group (%s) after scaling its members: %s", group.ID, err))
+				}
+				nodeCount = currentGroup.Members.Allocation
 			}
+
+			groupScaling := &clouddatabasesv5.GroupScaling{}
 			if group.Memory != nil && group.Memory.Allocation*nodeCount != currentGroup.Memory.Allocation {
 				groupScaling.Memory = &clouddatabasesv5.GroupScalingMemory{AllocationMb: core.Int64Ptr(int64(group.Memory.Allocation * nodeCount))}
 			}
@@ -1969,29 +2337,21 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				groupScaling.HostFlavor = &clouddatabasesv5.GroupScalingHostFlavor{ID: core.StringPtr(group.HostFlavor.ID)}
 			}
 
-			if groupScaling.Members != nil || groupScaling.Memory != nil || groupScaling.Disk != nil || groupScaling.CPU != nil || groupScaling.HostFlavor != nil {
-				setDeploymentScalingGroupOptions := &clouddatabasesv5.SetDeploymentScalingGroupOptions{
-					ID:      &instanceID,
-					GroupID: &group.ID,
-					Group:   groupScaling,
-				}
-
-				setDeploymentScalingGroupResponse, response, err := cloudDatabasesClient.SetDeploymentScalingGroup(setDeploymentScalingGroupOptions)
-
+			if groupScaling.Memory != nil || groupScaling.Disk != nil || groupScaling.CPU != nil || groupScaling.HostFlavor != nil {
+				err = setDatabaseScalingGroup(instanceID, group.ID, groupScaling, d, meta)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] SetDeploymentScalingGroup (%s) failed %s\n%s", group.ID, err, response))
+					return diag.FromErr(err)
 				}
+			}
+		}
 
-				// API may return HTTP 204 No Content if no change made
-				if response.StatusCode == 202 {
-					taskIDLink := *setDeploymentScalingGroupResponse.Task.ID
-
-					_, err = waitForDatabaseTaskComplete(taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
-
-					if err != nil {
-						return diag.FromErr(err)
-					}
-				}
+		for _, groupID := range removedAddOnGroups(os, ns) {
+			membersScaling := &clouddatabasesv5.GroupScaling{
+				Members: &clouddatabasesv5.GroupScalingMembers{AllocationCount: core.Int64Ptr(0)},
+			}
+			err = setDatabaseScalingGroup(instanceID, groupID, membersScaling, d, meta)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -2224,6 +2584,15 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		// locked before the read, so that the read sees the lock
+		locked = true
+		err = setDatabaseInstanceLock(rsConClient, instanceID, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
@@ -2312,6 +2681,18 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	id := d.Id()
+
+	if d.Get("deletion_protection").(bool) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Database instance (%s) has deletion_protection enabled, set it to false and apply before destroying the instance", id))
+	}
+
+	if !d.Get("skip_final_backup").(bool) {
+		err = takeDatabaseFinalBackup(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	recursive := true
 	deleteReq := rc.DeleteResourceInstanceOptions{
 		Recursive: &recursive,
@@ -2340,6 +2721,55 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 
 	return nil
 }
+
+// setDatabaseInstanceLock locks or unlocks the instance in the resource controller. A locked instance
+// can not be deleted or updated.
+func setDatabaseInstanceLock(rsConClient *rc.ResourceControllerV2, instanceID string, lock bool) error {
+	if lock {
+		_, response, err := rsConClient.LockResourceInstance(&rc.LockResourceInstanceOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error locking database instance (%s): %s %s", instanceID, err, response)
+		}
+		return nil
+	}
+	_, response, err := rsConClient.UnlockResourceInstance(&rc.UnlockResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error unlocking database instance (%s): %s %s", instanceID, err, response)
+	}
+	return nil
+}
+
+// takeDatabaseFinalBackup starts an on-demand backup of the instance and waits for it to complete, so
+// that the data can be restored with backup_id after the instance is deleted.
+func takeDatabaseFinalBackup(d *schema.ResourceData, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	instanceID := d.Id()
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: &instanceID,
+	}
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackup(startOndemandBackupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error starting the final backup of database instance (%s): %s %s", instanceID, err, response)
+	}
+
+	if startOndemandBackupResponse.Task != nil && startOndemandBackupResponse.Task.ID != nil {
+		_, err = waitForDatabaseTaskComplete(*startOndemandBackupResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the final backup of database instance (%s) to complete, the instance is not deleted: %s", instanceID, err)
+		}
+	}
+	log.Printf("[INFO] Final backup of database instance (%s) completed", instanceID)
+	return nil
+}
+
 func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -2846,7 +3276,7 @@ func expandGroups(_groups []interface{}) []*Group {
 
 	// analytics must be created before bi_connector
 	sortPriority := map[string]int{
-		"members":      10,
+		"member":       10,
 		"analytics":    2,
 		"bi_connector": 1,
 	}
@@ -2858,6 +3288,57 @@ func expandGroups(_groups []interface{}) []*Group {
 	return groups
 }
 
+// addOnGroups are the groups that are disabled by scaling their members to 0 when they are removed
+// from the configuration, in the order in which they are disabled: bi_connector depends on analytics.
+var addOnGroups = []string{"bi_connector", "analytics"}
+
+// removedAddOnGroups returns the add-on groups configured in oldGroups that are no longer in newGroups.
+func removedAddOnGroups(oldGroups *schema.Set, newGroups *schema.Set) []string {
+	configured := make(map[string]bool)
+	for _, g := range newGroups.List() {
+		configured[g.(map[string]interface{})["group_id"].(string)] = true
+	}
+	previous := make(map[string]bool)
+	for _, g := range oldGroups.List() {
+		previous[g.(map[string]interface{})["group_id"].(string)] = true
+	}
+
+	var removed []string
+	for _, groupID := range addOnGroups {
+		if previous[groupID] && !configured[groupID] {
+			removed = append(removed, groupID)
+		}
+	}
+	return removed
+}
+
+// groupServicePlans lists the services and plans supporting the groups that only exist for some of
+// them, the groups are supported by all plans of a service without plans listed.
+var groupServicePlans = map[string]map[string][]string{
+	"analytics":    {"databases-for-mongodb": {"enterprise"}},
+	"bi_connector": {"databases-for-mongodb": {"enterprise"}},
+	"search":       {"databases-for-cassandra": nil},
+	"ml":           {"databases-for-elasticsearch": {"platinum"}},
+	"master":       {"databases-for-elasticsearch": {"platinum"}},
+}
+
+func validateGroupServicePlan(service string, plan string, groupIds []string) error {
+	for _, groupId := range groupIds {
+		servicePlans, ok := groupServicePlans[groupId]
+		if !ok {
+			continue
+		}
+		plans, ok := servicePlans[service]
+		if !ok {
+			return fmt.Errorf("%s group is not supported by %s", groupId, service)
+		}
+		if len(plans) > 0 && !flex.StringContains(plans, plan) {
+			return fmt.Errorf("%s group is supported by %s only with the %s plan", groupId, service, strings.Join(plans, ", "))
+		}
+	}
+	return nil
+}
+
 func validateGroupScaling(groupId string, resourceName string, value int, resource *GroupResource, nodeCount int) error {
 	if nodeCount == 0 {
 		nodeCount = 1
@@ -2879,6 +3360,16 @@ func validateGroupScaling(groupId string, resourceName string, value int, resour
 	return nil
 }
 
+// validateGroupMembersQuorum refuses to remove a majority of the members of a group at once, the
+// remaining members would not form a quorum to elect a leader while the removed members leave.
+func validateGroupMembersQuorum(groupId string, members int, currentMembers int) error {
+	quorum := currentMembers/2 + 1
+	if members < currentMembers && members < quorum {
+		return fmt.Errorf("%s group members can not be scaled down from %d to %d at once, at least %d members must remain to keep a quorum. Scale the members down in several applies", groupId, currentMembers, members, quorum)
+	}
+	return nil
+}
+
 func validateGroupHostFlavor(groupId string, resourceName string, group *Group) error {
 	if group.CPU != nil || group.Memory != nil {
 		return fmt.Errorf("%s must not be set with cpu and memory", resourceName)
@@ -2961,6 +3452,11 @@ func validateGroupsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 			}
 		}
 
+		err = validateGroupServicePlan(service, plan, groupIds)
+		if err != nil {
+			return err
+		}
+
 		// Get default or current group scaling values
 		for _, group := range tfGroups {
 			if group == nil {
@@ -2974,6 +3470,9 @@ func validateGroupsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 					break
 				}
 			}
+			if groupDefaults == nil {
+				return fmt.Errorf("%s group is not available for %s with the %s plan", groupId, service, plan)
+			}
 
 			// set current nodeCount
 			nodeCount := groupDefaults.Members.Allocation
@@ -2990,6 +3489,12 @@ func validateGroupsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 				if err != nil {
 					return err
 				}
+				if instanceID != "" {
+					err = validateGroupMembersQuorum(groupId, group.Members.Allocation, groupDefaults.Members.Allocation)
+					if err != nil {
+						return err
+					}
+				}
 			}
 
 			if group.Memory != nil {
@@ -134,6 +134,15 @@ func TestAccIBMMongoDBEnterpriseDatabaseInstanceGroupBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "tags.#", "1"),
 				),
 			},
+			{
+				Config: testAccCheckIBMDatabaseInstanceMongoDBEnterpriseGroupRemoved(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "groups.0.count", "3"),
+					resource.TestCheckResourceAttr(name, "groups.1.count", "0"),
+					resource.TestCheckResourceAttr(name, "groups.2.count", "0"),
+				),
+			},
 		},
 	})
 }
@@ -370,6 +379,41 @@ func testAccCheckIBMDatabaseInstanceMongoDBEnterpriseGroupBasic(databaseResource
 				`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstanceMongoDBEnterpriseGroupRemoved(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-mongodb"
+		plan                         = "enterprise"
+		location                     = "%[3]s"
+		adminpassword                = "password12345678"
+		tags                         = ["one:two"]
+
+		group {
+			group_id = "member"
+
+			host_flavor {
+				id = "b3c.4x16.encrypted"
+			}
+			disk {
+				allocation_mb = 20480
+			}
+		}
+
+		timeouts {
+			create = "4h"
+			update = "4h"
+			delete = "15m"
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region())
+}
+
 func testAccCheckIBMDatabaseInstanceMongoDBEnterpriseMinimal(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -241,6 +241,41 @@ func TestAccIBMDatabaseInstancePostgresImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresDeletionProtection(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresDeletionProtection(databaseResourceGroup, serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "skip_final_backup", "false"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseInstancePostgresDeletionProtection(databaseResourceGroup, serviceName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection enabled"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresDeletionProtection(databaseResourceGroup, serviceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	t.Parallel()
 	databaseResourceGroup := "default"
@@ -730,6 +765,25 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup string
 				`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstancePostgresDeletionProtection(databaseResourceGroup string, name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id   = data.ibm_resource_group.test_acc.id
+		name                = "%[2]s"
+		service             = "databases-for-postgresql"
+		plan                = "standard"
+		location            = "%[3]s"
+		deletion_protection = %[4]t
+		skip_final_backup   = false
+	}
+				`, databaseResourceGroup, name, acc.Region(), deletionProtection)
+}
+
 func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -124,6 +124,44 @@ func TestAccIBMDatabaseInstanceRedisImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstanceRedisTypedConfiguration(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	rnd := fmt.Sprintf("tf-redis-%d", acctest.RandIntRange(10, 100))
+	testName := rnd
+	name := "ibm_database." + testName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseInstanceRedisTypedConfiguration(databaseResourceGroup, testName, "noeviction", "maxmemory_samples = 5"),
+				ExpectError: regexp.MustCompile("maxmemory_samples has no effect"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstanceRedisTypedConfiguration(databaseResourceGroup, testName, "allkeys-lru", "maxmemory_samples = 5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "redis_configuration.0.appendonly", "no"),
+					resource.TestCheckResourceAttr(name, "redis_configuration.0.maxmemory_policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr(name, "redis_configuration.0.maxmemory_samples", "5"),
+					resource.TestCheckResourceAttrSet(name, "redis_configuration.0.stop_writes_on_bgsave_error"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstanceRedisTypedConfiguration(databaseResourceGroup, testName, "volatile-lfu", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "redis_configuration.0.maxmemory_policy", "volatile-lfu"),
+					resource.TestCheckResourceAttr(name, "redis_configuration.0.maxmemory_samples", "5"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMDatabaseInstanceRedisKP_Encrypt(t *testing.T) {
 	t.Parallel()
 	databaseResourceGroup := "default"
@@ -304,6 +342,28 @@ func testAccCheckIBMDatabaseInstanceRedisUserRole(databaseResourceGroup string,
 				`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstanceRedisTypedConfiguration(databaseResourceGroup string, name, policy, samples string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	  }
+
+	  resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-redis"
+		plan              = "standard"
+		location          = "%[3]s"
+		redis_configuration {
+			appendonly       = "no"
+			maxmemory_policy = "%[4]s"
+			%[5]s
+		}
+	  }
+				`, databaseResourceGroup, name, acc.Region(), policy, samples)
+}
+
 func testAccCheckIBMDatabaseInstanceRedisImport(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -4,6 +4,7 @@
 package database
 
 import (
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"gotest.tools/assert"
 	"testing"
@@ -193,3 +194,112 @@ func TestValidateRBACRole(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateGroupMembersQuorum(t *testing.T) {
+	testcases := []struct {
+		members        int
+		currentMembers int
+		expectedError  string
+	}{
+		{members: 5, currentMembers: 3, expectedError: ""},
+		{members: 3, currentMembers: 3, expectedError: ""},
+		{members: 3, currentMembers: 5, expectedError: ""},
+		{members: 4, currentMembers: 6, expectedError: ""},
+		{members: 3, currentMembers: 6, expectedError: "member group members can not be scaled down from 6 to 3 at once, at least 4 members must remain to keep a quorum. Scale the members down in several applies"},
+		{members: 1, currentMembers: 3, expectedError: "member group members can not be scaled down from 3 to 1 at once, at least 2 members must remain to keep a quorum. Scale the members down in several applies"},
+	}
+	for _, tc := range testcases {
+		err := validateGroupMembersQuorum("member", tc.members, tc.currentMembers)
+		if tc.expectedError == "" {
+			if err != nil {
+				t.Errorf("TestValidateGroupMembersQuorum: %d to %d unexpected error: %q", tc.currentMembers, tc.members, err.Error())
+			}
+		} else {
+			var errMsg string
+
+			if err != nil {
+				errMsg = err.Error()
+			}
+
+			assert.Equal(t, tc.expectedError, errMsg)
+		}
+	}
+}
+
+func TestValidateGroupServicePlan(t *testing.T) {
+	testcases := []struct {
+		service       string
+		plan          string
+		groupIds      []string
+		expectedError string
+	}{
+		{service: "databases-for-elasticsearch", plan: "platinum", groupIds: []string{"member", "ml", "master"}, expectedError: ""},
+		{service: "databases-for-elasticsearch", plan: "enterprise", groupIds: []string{"member", "ml"}, expectedError: "ml group is supported by databases-for-elasticsearch only with the platinum plan"},
+		{service: "databases-for-postgresql", plan: "standard", groupIds: []string{"member", "master"}, expectedError: "master group is not supported by databases-for-postgresql"},
+		{service: "databases-for-mongodb", plan: "enterprise", groupIds: []string{"member", "analytics", "bi_connector"}, expectedError: ""},
+		{service: "databases-for-cassandra", plan: "enterprise", groupIds: []string{"member", "search"}, expectedError: ""},
+	}
+	for _, tc := range testcases {
+		err := validateGroupServicePlan(tc.service, tc.plan, tc.groupIds)
+		if tc.expectedError == "" {
+			if err != nil {
+				t.Errorf("TestValidateGroupServicePlan: %s %s %v unexpected error: %q", tc.service, tc.plan, tc.groupIds, err.Error())
+			}
+		} else {
+			var errMsg string
+
+			if err != nil {
+				errMsg = err.Error()
+			}
+
+			assert.Equal(t, tc.expectedError, errMsg)
+		}
+	}
+}
+
+func TestDataSourceIBMDatabaseUpgradePreviewUpgrades(t *testing.T) {
+	deployables := []clouddatabasesv5.Deployables{
+		{
+			Type: core.StringPtr("postgresql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{
+					Version: core.StringPtr("13"),
+					Status:  core.StringPtr("deprecated"),
+					Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+						{FromVersion: core.StringPtr("13"), ToVersion: core.StringPtr("14"), Method: core.StringPtr("in-place")},
+						{FromVersion: core.StringPtr("13"), ToVersion: core.StringPtr("15"), Method: core.StringPtr("restore")},
+						{FromVersion: core.StringPtr("12"), ToVersion: core.StringPtr("16"), Method: core.StringPtr("restore")},
+						{FromVersion: core.StringPtr("13")},
+					},
+				},
+				{Version: core.StringPtr("14"), Status: core.StringPtr("stable"), IsPreferred: core.BoolPtr(false)},
+				{Version: core.StringPtr("15"), Status: core.StringPtr("stable"), IsPreferred: core.BoolPtr(true)},
+			},
+		},
+		{
+			Type: core.StringPtr("mysql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{
+					Version: core.StringPtr("13"),
+					Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+						{FromVersion: core.StringPtr("13"), ToVersion: core.StringPtr("99"), Method: core.StringPtr("in-place")},
+					},
+				},
+			},
+		},
+	}
+
+	upgrades := dataSourceIBMDatabaseUpgradePreviewUpgrades(deployables, core.StringPtr("postgresql"), "13")
+	assert.Equal(t, 2, len(upgrades))
+	assert.Equal(t, "14", upgrades[0]["version"])
+	assert.Equal(t, "in-place", *upgrades[0]["method"].(*string))
+	assert.Equal(t, databaseUpgradeDowntime["in-place"], upgrades[0]["expected_downtime"])
+	assert.Equal(t, "stable", *upgrades[0]["status"].(*string))
+	assert.Equal(t, false, *upgrades[0]["is_preferred"].(*bool))
+	assert.Equal(t, "15", upgrades[1]["version"])
+	assert.Equal(t, databaseUpgradeDowntime["restore"], upgrades[1]["expected_downtime"])
+	assert.Equal(t, true, *upgrades[1]["is_preferred"].(*bool))
+
+	assert.Equal(t, 0, len(dataSourceIBMDatabaseUpgradePreviewUpgrades(deployables, core.StringPtr("postgresql"), "16")))
+	assert.Equal(t, 1, len(dataSourceIBMDatabaseUpgradePreviewUpgrades(deployables, core.StringPtr("mysql"), "13")))
+}
@@ -68,6 +68,9 @@ const (
 	dlSakExpiryTime                 = "sak_expiry_time"
 	dlSpeedMbps                     = "speed_mbps"
 	dlMacSecConfigStatus            = "status"
+	dlMacsecSecured                 = "secured"
+	dlMacsecFailed                  = "failed"
+	dlMacsecRotating                = "rotating"
 	dlTags                          = "tags"
 	dlType                          = "type"
 	dlUpdatedAt                     = "updated_at"
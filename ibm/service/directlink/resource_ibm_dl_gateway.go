@@ -353,16 +353,18 @@ func ResourceIBMDLGateway() *schema.Resource {
 							Description: "Indicate whether MACsec protection should be active (true) or inactive (false) for this MACsec enabled gateway",
 						},
 						dlPrimaryCak: {
-							Type:        schema.TypeString,
-							Required:    true,
-							ForceNew:    false,
-							Description: "Desired primary connectivity association key. Keys for a MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     false,
+							ValidateFunc: validateDLMacsecCak,
+							Description:  "Desired primary connectivity association key. Keys for a MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
 						},
 						dlFallbackCak: {
-							Type:        schema.TypeString,
-							Optional:    true,
-							ForceNew:    false,
-							Description: "Fallback connectivity association key. Keys used for MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     false,
+							ValidateFunc: validateDLMacsecCak,
+							Description:  "Fallback connectivity association key. Keys used for MACsec configuration must have names with an even number of characters from [0-9a-fA-F]",
 						},
 						dlWindowSize: {
 							Type:        schema.TypeInt,
@@ -1336,6 +1338,62 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
+func isWaitForDirectLinkMacsecCakRotated(client *directlinkv1.DirectLinkV1, id, primaryCak string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the primary CAK of direct link (%s) to become active.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", dlMacsecRotating},
+		Target:     []string{dlMacsecSecured},
+		Refresh:    isDirectLinkMacsecRefreshFunc(client, id, primaryCak),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	instance, err := stateConf.WaitForState()
+	if err != nil {
+		return instance, fmt.Errorf("[ERROR] Error waiting for the primary CAK %s of direct link (%s) to become active, make sure the same key is configured on the customer router: %s", primaryCak, id, err)
+	}
+	return instance, nil
+}
+
+func isDirectLinkMacsecRefreshFunc(client *directlinkv1.DirectLinkV1, id, primaryCak string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &directlinkv1.GetGatewayOptions{
+			ID: &id,
+		}
+		instanceIntf, response, err := client.GetGateway(getOptions)
+		if (err != nil) || (instanceIntf == nil) {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+		}
+
+		instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
+		macsec := instance.MacsecConfig
+		if macsec == nil || macsec.Status == nil {
+			return instance, dlMacsecRotating, nil
+		}
+		if *macsec.Status == dlMacsecFailed {
+			return instance, *macsec.Status, fmt.Errorf("[ERROR] MACsec of direct link (%s) failed while rotating the primary CAK", id)
+		}
+		if *macsec.Status == dlMacsecSecured && macsec.ActiveCak != nil && macsec.ActiveCak.Crn != nil && *macsec.ActiveCak.Crn == primaryCak {
+			return instance, dlMacsecSecured, nil
+		}
+		return instance, dlMacsecRotating, nil
+	}
+}
+
+// validateDLMacsecCak validates that a connectivity association key is the CRN of a Hyper Protect
+// Crypto Services or Key Protect key.
+func validateDLMacsecCak(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) < 10 || parts[0] != "crn" || (parts[4] != "hs-crypto" && parts[4] != "kms") || parts[8] != "key" || parts[9] == "" {
+		errors = append(errors, fmt.Errorf("%q must be the CRN of a Hyper Protect Crypto Services or Key Protect key, got %q", k, value))
+	}
+	return
+}
+
 func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	directLink, err := directlinkClient(meta)
@@ -1593,6 +1651,8 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if dtype == "dedicated" {
+		// MACsec configuration must be patched on its own, other fields of the gateway cannot be
+		// updated in the same request.
 		if d.HasChange(dlMacSecConfig) && !d.IsNewResource() {
 			// Construct an instance of the GatewayMacsecConfigTemplate model
 			gatewayMacsecConfigTemplatePatchModel := new(directlinkv1.GatewayMacsecConfigPatchTemplate)
@@ -1626,10 +1686,24 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 					gatewayMacsecConfigTemplatePatchModel.WindowSize = &windowSizeint
 				}
 			}
-			gatewayPatchTemplateModel["macsec_config"] = gatewayMacsecConfigTemplatePatchModel
-		} else {
-			gatewayPatchTemplateModel["macsec_config"] = nil
+			macsecPatchOptions := directLink.NewUpdateGatewayOptions(ID, map[string]interface{}{"macsec_config": gatewayMacsecConfigTemplatePatchModel})
+			_, response, err := directLink.UpdateGateway(macsecPatchOptions)
+			if err != nil {
+				log.Printf("[DEBUG] Update Direct Link Gateway MACsec configuration err %s\n%s", err, response)
+				return err
+			}
+
+			// A rotated primary CAK only becomes active once both ends of the link use it, wait for
+			// the rotation when MACsec was securing the link before the change.
+			if d.HasChange("macsec_config.0.primary_cak") && d.Get("macsec_config.0.active").(bool) &&
+				instance.MacsecConfig != nil && instance.MacsecConfig.Status != nil && *instance.MacsecConfig.Status == dlMacsecSecured {
+				_, err = isWaitForDirectLinkMacsecCakRotated(directLink, ID, d.Get("macsec_config.0.primary_cak").(string), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return err
+				}
+			}
 		}
+		gatewayPatchTemplateModel["macsec_config"] = nil
 		if d.HasChange(dlVlan) {
 			if _, ok := d.GetOk(dlVlan); ok {
 				vlan := int64(d.Get(dlVlan).(int))
@@ -88,6 +88,40 @@ func TestAccIBMDLGatewayConnect_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMDLGatewayMacsec_basic(t *testing.T) {
+	var instance string
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+	custname := fmt.Sprintf("customer-name-%d", acctest.RandIntRange(10, 100))
+	carriername := fmt.Sprintf("carrier-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDLGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayMacsecConfig(gatewayname, custname, carriername, acc.DlMacsecPrimaryCak, acc.DlMacsecFallbackCak),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMDLGatewayExists("ibm_dl_gateway.test_dl_macsec_gateway", instance),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.active", "true"),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.primary_cak", acc.DlMacsecPrimaryCak),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.fallback_cak", acc.DlMacsecFallbackCak),
+					resource.TestCheckResourceAttrSet("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.status"),
+				),
+			},
+			{
+				// Rotate the primary and the fallback CAKs
+				Config: testAccCheckIBMDLGatewayMacsecConfig(gatewayname, custname, carriername, acc.DlMacsecFallbackCak, acc.DlMacsecPrimaryCak),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMDLGatewayExists("ibm_dl_gateway.test_dl_macsec_gateway", instance),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.primary_cak", acc.DlMacsecFallbackCak),
+					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_macsec_gateway", "macsec_config.0.fallback_cak", acc.DlMacsecPrimaryCak),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDLGatewayConfig(gatewayname, custname, carriername string) string {
 	return fmt.Sprintf(`
 	data "ibm_dl_routers" "test1" {
@@ -110,6 +144,32 @@ func testAccCheckIBMDLGatewayConfig(gatewayname, custname, carriername string) s
 	  `, gatewayname, custname, carriername)
 }
 
+func testAccCheckIBMDLGatewayMacsecConfig(gatewayname, custname, carriername, primaryCak, fallbackCak string) string {
+	return fmt.Sprintf(`
+	data "ibm_dl_routers" "test1" {
+		offering_type = "dedicated"
+		location_name = "dal10"
+	}
+	resource "ibm_dl_gateway" "test_dl_macsec_gateway" {
+		bgp_asn = 64999
+		global = true
+		metered = false
+		name = "%s"
+		speed_mbps = 10000
+		type = "dedicated"
+		cross_connect_router = data.ibm_dl_routers.test1.cross_connect_routers[0].router_name
+		location_name = data.ibm_dl_routers.test1.location_name
+		customer_name = "%s"
+		carrier_name = "%s"
+		macsec_config {
+			active = true
+			primary_cak = "%s"
+			fallback_cak = "%s"
+		}
+	}
+	`, gatewayname, custname, carriername, primaryCak, fallbackCak)
+}
+
 func testAccCheckIBMDLConnectGatewayConfig(gatewayname string, exprefix string, imprefix string) string {
 	return fmt.Sprintf(`
 	data "ibm_dl_ports" "ds_dlports" {
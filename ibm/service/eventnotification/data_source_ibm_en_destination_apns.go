@@ -83,6 +83,7 @@ func DataSourceIBMEnAPNSDestination() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "The Password for APNS Certificate in case of P12 certificate",
 									},
@@ -68,6 +68,7 @@ func DataSourceIBMEnCFDestination() *schema.Resource {
 									},
 									"api_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "APIKey with access of IBM Cloud Functions IAM Namespace",
 									},
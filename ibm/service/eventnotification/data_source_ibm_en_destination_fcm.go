@@ -73,6 +73,7 @@ func DataSourceIBMEnFCMDestination() *schema.Resource {
 									},
 									"private_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "The FCM private_key.",
 									},
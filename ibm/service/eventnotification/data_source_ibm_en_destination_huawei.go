@@ -73,6 +73,7 @@ func DataSourceIBMEnHuaweiDestination() *schema.Resource {
 									},
 									"client_secret": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "ClientSecret for the Huawei account oauth",
 									},
@@ -63,6 +63,7 @@ func DataSourceIBMEnMSTeamsDestination() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"url": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "URL of msteams",
 									},
@@ -68,6 +68,7 @@ func DataSourceIBMEnSafariDestination() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "The Password for APNS Certificate in case of P12 certificate",
 									},
@@ -68,6 +68,7 @@ func DataSourceIBMEnServiceNowDestination() *schema.Resource {
 									},
 									"client_secret": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "ClientSecret for the ServiceNow account oauth",
 									},
@@ -78,6 +79,7 @@ func DataSourceIBMEnServiceNowDestination() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password for ServiceNow account REST API.",
 									},
@@ -63,6 +63,7 @@ func DataSourceIBMEnSlackDestination() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"url": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Slack webhook url",
 									},
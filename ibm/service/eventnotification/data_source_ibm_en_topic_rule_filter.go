@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEnTopicRuleFilter() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEnTopicRuleFilterRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnTopicRuleFilter,
+				Description:  "The event type filter or notification filter of a topic rule.",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The sample events to evaluate the filter against, as JSON documents.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"matches": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Whether the filter matches each of the sample events, in the order of the events.",
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+			"match_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of sample events matched by the filter.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnTopicRuleFilterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter := d.Get("filter").(string)
+	node, err := parseEnTopicRuleFilter(filter)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the filter %q: %s", filter, err))
+	}
+
+	matches := []bool{}
+	matchCount := 0
+	for i, e := range d.Get("events").([]interface{}) {
+		var event interface{}
+		if err := json.Unmarshal([]byte(e.(string)), &event); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding the event at index %d: %s", i, err))
+		}
+		match := node.evaluate(event)
+		if match {
+			matchCount++
+		}
+		matches = append(matches, match)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(filter)))
+	if err = d.Set("matches", matches); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting matches: %s", err))
+	}
+	if err = d.Set("match_count", matchCount); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting match_count: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnTopicRuleFilterDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnTopicRuleFilterDataSourceConfig("$.notification_event_info.event_type == 'cert_manager' && $.notification.findings[0].severity != 'LOW'"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "id"),
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "match_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "matches.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "matches.0", "true"),
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "matches.1", "false"),
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "matches.2", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnTopicRuleFilterDataSourceConfig("$.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_en_topic_rule_filter.en_topic_rule_filter", "match_count", "3"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMEnTopicRuleFilterDataSourceConfig("$.notification_event_info.event_type = 'cert_manager'"),
+				ExpectError: regexp.MustCompile("is not a valid filter"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnTopicRuleFilterDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "ibm_en_topic_rule_filter" "en_topic_rule_filter" {
+		filter = "%s"
+		events = [
+			jsonencode({
+				notification_event_info = { event_type = "cert_manager" }
+				notification            = { findings = [{ severity = "HIGH" }] }
+			}),
+			jsonencode({
+				notification_event_info = { event_type = "cert_manager" }
+				notification            = { findings = [{ severity = "LOW" }] }
+			}),
+			jsonencode({
+				notification_event_info = { event_type = "secrets_manager" }
+			}),
+		]
+	}
+	`, filter)
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// pushDestinationSecretSchema is the schema of the Secrets Manager secret the credentials of a push
+// destination are read from.
+func pushDestinationSecretSchema(description string, conflictsWith []string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		MaxItems:      1,
+		Optional:      true,
+		ConflictsWith: conflictsWith,
+		Description:   description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the Secrets Manager instance.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The region of the Secrets Manager instance.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the secret.",
+				},
+				"endpoint_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "public",
+					ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+					Description:  "The endpoint type used to reach the Secrets Manager instance, public or private.",
+				},
+			},
+		},
+	}
+}
+
+// pushDestinationSecret is the current version of a Secrets Manager secret holding push credentials.
+type pushDestinationSecret struct {
+	VersionID string
+	// Payload is the payload of an arbitrary secret, Data the data of a key-value secret.
+	Payload string
+	Data    map[string]interface{}
+}
+
+// getPushDestinationSecret reads the current version of the secret configured in the given block.
+func getPushDestinationSecret(context context.Context, meta interface{}, secretList []interface{}) (*pushDestinationSecret, error) {
+	if len(secretList) == 0 || secretList[0] == nil {
+		return nil, nil
+	}
+	secretMap := secretList[0].(map[string]interface{})
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+	secretsManagerClient = secretsmanager.GetClientWithInstanceEndpoint(secretsManagerClient, secretMap["instance_id"].(string), secretMap["region"].(string), secretMap["endpoint_type"].(string))
+
+	secretID := secretMap["secret_id"].(string)
+	getSecretVersionOptions := &secretsmanagerv2.GetSecretVersionOptions{}
+	getSecretVersionOptions.SetSecretID(secretID)
+	getSecretVersionOptions.SetID("current")
+
+	versionIntf, response, err := secretsManagerClient.GetSecretVersionWithContext(context, getSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("[ERROR] Error getting the current version of secret %s: %s\n%s", secretID, err, response)
+	}
+
+	secret := &pushDestinationSecret{}
+	switch version := versionIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretVersion:
+		secret.VersionID = core.StringNilMapper(version.ID)
+		secret.Payload = core.StringNilMapper(version.Payload)
+	case *secretsmanagerv2.KVSecretVersion:
+		secret.VersionID = core.StringNilMapper(version.ID)
+		secret.Data = version.Data
+	default:
+		return nil, fmt.Errorf("[ERROR] Secret %s must be an arbitrary or a key-value secret", secretID)
+	}
+	return secret, nil
+}
+
+// pushDestinationSecretVersionDiff plans an update of the destination when the last refresh found a new
+// version of its secret, so that rotated credentials are pushed to the destination. The latest version
+// is read by the refresh, no call to Secrets Manager is made while planning.
+func pushDestinationSecretVersionDiff(secretKey string) schema.CustomizeDiffFunc {
+	return func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		if diff.HasChange(secretKey) {
+			return diff.SetNewComputed("secret_version_id")
+		}
+		latestVersionID := diff.Get("secret_latest_version_id").(string)
+		if pushDestinationSecretRotated(diff.Get("secret_version_id").(string), latestVersionID) {
+			log.Printf("[DEBUG] Secret %s of destination %s rotated to version %s", secretKey, diff.Id(), latestVersionID)
+			return diff.SetNew("secret_version_id", latestVersionID)
+		}
+		return nil
+	}
+}
+
+// pushDestinationSecretRotated reports whether the latest version of the secret differs from the version
+// the destination was last updated with.
+func pushDestinationSecretRotated(appliedVersionID, latestVersionID string) bool {
+	return latestVersionID != "" && latestVersionID != appliedVersionID
+}
+
+// setPushDestinationSecretLatestVersion records the current version of the secret of the destination in
+// secret_latest_version_id. A secret that can not be read is logged, so that the destination can still
+// be refreshed and destroyed.
+func setPushDestinationSecretLatestVersion(context context.Context, d *schema.ResourceData, meta interface{}, secretKey string) error {
+	secret, err := getPushDestinationSecret(context, meta, d.Get(secretKey).([]interface{}))
+	if err != nil {
+		log.Printf("[WARN] Unable to read the latest version of the %s of destination %s: %s", secretKey, d.Id(), err)
+		return nil
+	}
+	latestVersionID := ""
+	if secret != nil {
+		latestVersionID = secret.VersionID
+	}
+	if err = d.Set("secret_latest_version_id", latestVersionID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting secret_latest_version_id: %s", err)
+	}
+	return nil
+}
+
+// pushDestinationSecretLatestVersionSchema is the schema of the version of the secret found by the last refresh.
+func pushDestinationSecretLatestVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The current version of the secret, found by the last refresh.",
+	}
+}
+
+// apnsCertificateFromSecret returns the APNs certificate held in the payload of an arbitrary secret.
+// A p8 key is stored as is, a p12 certificate is binary and is stored base64 encoded.
+func apnsCertificateFromSecret(secret *pushDestinationSecret, certificateType string) ([]byte, error) {
+	if secret.Payload == "" {
+		return nil, fmt.Errorf("[ERROR] The APNs certificate must be stored in the payload of an arbitrary secret")
+	}
+	if certificateType == "p12" {
+		certificate, err := base64.StdEncoding.DecodeString(strings.TrimSpace(secret.Payload))
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] The p12 certificate must be stored base64 encoded: %s", err)
+		}
+		return certificate, nil
+	}
+	return []byte(secret.Payload), nil
+}
+
+// fcmCredentialsFromSecret returns the project ID, private key and client email of a Firebase service
+// account, stored either as the service account JSON key in an arbitrary secret or as a key-value secret.
+func fcmCredentialsFromSecret(secret *pushDestinationSecret) (map[string]string, error) {
+	data := secret.Data
+	if data == nil {
+		data = map[string]interface{}{}
+		if err := json.Unmarshal([]byte(secret.Payload), &data); err != nil {
+			return nil, fmt.Errorf("[ERROR] The FCM credentials must be a service account JSON key: %s", err)
+		}
+	}
+	credentials := map[string]string{}
+	for _, key := range []string{"project_id", "private_key", "client_email"} {
+		if value, ok := data[key].(string); ok && value != "" {
+			credentials[key] = value
+		}
+	}
+	if credentials["private_key"] == "" || credentials["client_email"] == "" {
+		return nil, fmt.Errorf("[ERROR] The FCM credentials secret must contain private_key and client_email")
+	}
+	return credentials, nil
+}
+
+// sendPushDestinationTestNotification sends a test notification through the destination, so that
+// invalid credentials are reported at apply instead of when the first notification is sent.
+func sendPushDestinationTestNotification(context context.Context, meta interface{}, instanceID, destinationID string) error {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	testDestinationOptions := &en.TestDestinationOptions{}
+	testDestinationOptions.SetInstanceID(instanceID)
+	testDestinationOptions.SetID(destinationID)
+	_, response, err := enClient.TestDestinationWithContext(context, testDestinationOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Test notification through destination %s failed, check its credentials: %s\n%s", destinationID, err, response)
+	}
+	return nil
+}
@@ -4,8 +4,10 @@
 package eventnotification
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
 
@@ -25,6 +27,7 @@ func ResourceIBMEnAPNSDestination() *schema.Resource {
 		UpdateContext: resourceIBMEnAPNSDestinationUpdate,
 		DeleteContext: resourceIBMEnAPNSDestinationDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: pushDestinationSecretVersionDiff("certificate_secret"),
 
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
@@ -59,9 +62,23 @@ func ResourceIBMEnAPNSDestination() *schema.Resource {
 				Description: "The Certificate Content Type to be set p8/p12.",
 			},
 			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"certificate", "certificate_secret"},
+				Description:  "The Certificate File.",
+			},
+			"certificate_secret": pushDestinationSecretSchema("The arbitrary Secrets Manager secret the certificate is read from. A p12 certificate must be stored base64 encoded. The destination is updated when a new version of the secret is created.", []string{"certificate"}),
+			"secret_version_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The Certificate File.",
+				Computed:    true,
+				Description: "The version of the certificate secret the destination was last updated with.",
+			},
+			"secret_latest_version_id": pushDestinationSecretLatestVersionSchema(),
+			"send_test_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a test notification is sent through the destination after it is created or updated, to validate its configuration.",
 			},
 			"config": {
 				Type:        schema.TypeList,
@@ -179,6 +196,18 @@ func resourceIBMEnAPNSDestinationCreate(context context.Context, d *schema.Resou
 		}()
 	}
 
+	secret, err := getPushDestinationSecret(context, meta, d.Get("certificate_secret").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret != nil {
+		certificate, err := apnsCertificateFromSecret(secret, certificatetype)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		options.SetCertificate(io.NopCloser(bytes.NewReader(certificate)))
+	}
+
 	if _, ok := d.GetOk("description"); ok {
 		options.SetDescription(d.Get("description").(string))
 	}
@@ -193,6 +222,15 @@ func resourceIBMEnAPNSDestinationCreate(context context.Context, d *schema.Resou
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
+	if secret != nil {
+		d.Set("secret_version_id", secret.VersionID)
+	}
+
+	if d.Get("send_test_notification").(bool) {
+		if err = sendPushDestinationTestNotification(context, meta, *options.InstanceID, *result.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceIBMEnAPNSDestinationRead(context, d, meta)
 }
@@ -265,6 +303,10 @@ func resourceIBMEnAPNSDestinationRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
 	}
 
+	if err = setPushDestinationSecretLatestVersion(context, d, meta, "certificate_secret"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -284,7 +326,7 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
-	if ok := d.HasChanges("name", "description", "collect_failed_events", "certificate", "config"); ok {
+	if ok := d.HasChanges("name", "description", "collect_failed_events", "certificate", "certificate_secret", "secret_version_id", "config"); ok {
 		options.SetName(d.Get("name").(string))
 
 		if _, ok := d.GetOk("description"); ok {
@@ -314,6 +356,18 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 			}()
 		}
 
+		secret, err := getPushDestinationSecret(context, meta, d.Get("certificate_secret").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if secret != nil {
+			certificate, err := apnsCertificateFromSecret(secret, certificatetype)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			options.SetCertificate(io.NopCloser(bytes.NewReader(certificate)))
+		}
+
 		if _, ok := d.GetOk("config"); ok {
 			config := APNSdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}), certificatetype)
 			options.SetConfig(&config)
@@ -322,11 +376,20 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
 		}
+		if secret != nil {
+			d.Set("secret_version_id", secret.VersionID)
+		} else {
+			d.Set("secret_version_id", "")
+		}
 
-		return resourceIBMEnAPNSDestinationRead(context, d, meta)
+		if d.Get("send_test_notification").(bool) {
+			if err = sendPushDestinationTestNotification(context, meta, parts[0], parts[1]); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
-	return nil
+	return resourceIBMEnAPNSDestinationRead(context, d, meta)
 }
 
 func resourceIBMEnAPNSDestinationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -71,6 +71,7 @@ func ResourceIBMEnCFDestination() *schema.Resource {
 									},
 									"api_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "APIKey with access of IBM Cloud Functions IAM Namespace",
 									},
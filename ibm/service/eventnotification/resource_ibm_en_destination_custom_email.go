@@ -6,11 +6,15 @@ package eventnotification
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
@@ -24,6 +28,11 @@ func ResourceIBMEnCustomEmailDestination() *schema.Resource {
 		DeleteContext: resourceIBMEnCustomEmailDestinationDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
 				Type:        schema.TypeString,
@@ -75,6 +84,19 @@ func ResourceIBMEnCustomEmailDestination() *schema.Resource {
 					},
 				},
 			},
+			"dns_verification": enCustomEmailDNSVerificationSchema(),
+			"spf": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The SPF verification record of the custom domain.",
+				Elem:        enCustomEmailSpfRecordSchema(),
+			},
+			"dkim": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DKIM verification record of the custom domain.",
+				Elem:        enCustomEmailDkimRecordSchema(),
+			},
 			"destination_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -129,7 +151,21 @@ func resourceIBMEnCustomEmailDestinationCreate(context context.Context, d *schem
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
 
-	return resourceIBMEnServiceNowDestinationRead(context, d, meta)
+	if _, ok := d.GetOk("dns_verification"); ok {
+		// the records created before a failure are recorded, so that they are removed with the destination
+		recordIDs, err := enCustomEmailCreateVerificationRecords(context, d, meta, *options.InstanceID, *result.ID)
+		if setErr := enCustomEmailSetVerificationRecordIDs(d, recordIDs); setErr != nil {
+			return diag.FromErr(setErr)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = enCustomEmailWaitForVerification(context, enClient, *options.InstanceID, *result.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMEnCustomEmailDestinationRead(context, d, meta)
 }
 
 func resourceIBMEnCustomEmailDestinationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -200,6 +236,15 @@ func resourceIBMEnCustomEmailDestinationRead(context context.Context, d *schema.
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
 	}
 
+	spf, dkim := enCustomEmailDestinationRecords(result)
+	if err = d.Set("spf", enCustomEmailFlattenSpfRecord(spf)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting spf: %s", err))
+	}
+
+	if err = d.Set("dkim", enCustomEmailFlattenDkimRecord(dkim)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting dkim: %s", err))
+	}
+
 	return nil
 }
 
@@ -219,6 +264,16 @@ func resourceIBMEnCustomEmailDestinationUpdate(context context.Context, d *schem
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
+	if d.HasChanges("config", "dns_verification") {
+		oldVerification, _ := d.GetChange("dns_verification")
+		if err = enCustomEmailDeleteVerificationRecords(meta, oldVerification.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = enCustomEmailSetVerificationRecordIDs(d, []string{}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if ok := d.HasChanges("name", "description", "collect_failed_events", "config"); ok {
 		options.SetName(d.Get("name").(string))
 
@@ -239,11 +294,24 @@ func resourceIBMEnCustomEmailDestinationUpdate(context context.Context, d *schem
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
 		}
+	}
 
-		return resourceIBMEnCustomEmailDestinationRead(context, d, meta)
+	if d.HasChanges("config", "dns_verification") {
+		if _, ok := d.GetOk("dns_verification"); ok {
+			recordIDs, err := enCustomEmailCreateVerificationRecords(context, d, meta, parts[0], parts[1])
+			if setErr := enCustomEmailSetVerificationRecordIDs(d, recordIDs); setErr != nil {
+				return diag.FromErr(setErr)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err = enCustomEmailWaitForVerification(context, enClient, parts[0], parts[1], d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
-	return nil
+	return resourceIBMEnCustomEmailDestinationRead(context, d, meta)
 }
 
 func resourceIBMEnCustomEmailDestinationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -262,6 +330,12 @@ func resourceIBMEnCustomEmailDestinationDelete(context context.Context, d *schem
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
+	if verification, ok := d.GetOk("dns_verification"); ok {
+		if err = enCustomEmailDeleteVerificationRecords(meta, verification.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	response, err := enClient.DeleteDestinationWithContext(context, options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -286,3 +360,310 @@ func CustomEmaildestinationConfigMapToDestinationConfig(configParams map[string]
 	destinationConfig.Params = params
 	return *destinationConfig
 }
+
+// enCustomEmailVerificationRecord is a TXT record that proves the ownership of the custom domain.
+type enCustomEmailVerificationRecord struct {
+	TxtName  *string
+	TxtValue *string
+}
+
+// enCustomEmailDNSVerificationSchema is the configuration of the zone in which the SPF and DKIM
+// verification records of a custom email domain are created.
+func enCustomEmailDNSVerificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Description: "Creates the SPF and DKIM verification records of the custom domain in a CIS or DNS Services zone and waits until the domain is verified.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cis_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"dns_verification.0.cis_id", "dns_verification.0.dns_instance_id"},
+					Description:  "The CRN of the CIS instance that hosts the zone of the custom domain.",
+				},
+				"dns_instance_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"dns_verification.0.cis_id", "dns_verification.0.dns_instance_id"},
+					Description:  "The ID of the DNS Services instance that hosts the zone of the custom domain.",
+				},
+				"zone_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the zone in which the verification records are created.",
+				},
+				"ttl": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     900,
+					Description: "The time to live of the verification records, in seconds.",
+				},
+				"record_ids": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The IDs of the verification records created in the zone.",
+				},
+			},
+		},
+	}
+}
+
+func enCustomEmailSpfRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"txt_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the SPF TXT record.",
+			},
+			"txt_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value of the SPF TXT record.",
+			},
+			"verification": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SPF verification status.",
+			},
+		},
+	}
+}
+
+func enCustomEmailDkimRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DKIM public key, the value of the DKIM TXT record.",
+			},
+			"selector": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DKIM selector, which names the DKIM TXT record.",
+			},
+			"verification": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DKIM verification status.",
+			},
+		},
+	}
+}
+
+// enCustomEmailDestinationRecords returns the SPF and DKIM attributes of a custom domain email destination.
+func enCustomEmailDestinationRecords(destination *en.Destination) (*en.SpfAttributes, *en.DkimAttributes) {
+	if destination == nil || destination.Config == nil {
+		return nil, nil
+	}
+	params, ok := destination.Config.Params.(*en.DestinationConfigOneOf)
+	if !ok {
+		return nil, nil
+	}
+	return params.Spf, params.Dkim
+}
+
+func enCustomEmailFlattenSpfRecord(spf *en.SpfAttributes) []map[string]interface{} {
+	if spf == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"txt_name":     flex.StringValue(spf.TxtName),
+			"txt_value":    flex.StringValue(spf.TxtValue),
+			"verification": flex.StringValue(spf.Verification),
+		},
+	}
+}
+
+func enCustomEmailFlattenDkimRecord(dkim *en.DkimAttributes) []map[string]interface{} {
+	if dkim == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"public_key":   flex.StringValue(dkim.PublicKey),
+			"selector":     flex.StringValue(dkim.Selector),
+			"verification": flex.StringValue(dkim.Verification),
+		},
+	}
+}
+
+// enCustomEmailDkimRecord returns the TXT record of the DKIM public key, named after the selector in the
+// _domainkey subdomain of the custom domain, unless the selector is already the full record name.
+func enCustomEmailDkimRecord(domain string, dkim *en.DkimAttributes) *enCustomEmailVerificationRecord {
+	if dkim == nil || dkim.Selector == nil || dkim.PublicKey == nil {
+		return nil
+	}
+	name := *dkim.Selector
+	if !strings.Contains(name, "._domainkey") {
+		name = fmt.Sprintf("%s._domainkey.%s", name, domain)
+	}
+	return &enCustomEmailVerificationRecord{TxtName: &name, TxtValue: dkim.PublicKey}
+}
+
+func enCustomEmailSetVerificationRecordIDs(d *schema.ResourceData, recordIDs []string) error {
+	verification, ok := d.GetOk("dns_verification")
+	if !ok {
+		return nil
+	}
+	config := verification.([]interface{})[0].(map[string]interface{})
+	config["record_ids"] = recordIDs
+	if err := d.Set("dns_verification", []interface{}{config}); err != nil {
+		return fmt.Errorf("[ERROR] Error setting dns_verification: %s", err)
+	}
+	return nil
+}
+
+// enCustomEmailCreateVerificationRecords creates the SPF and DKIM TXT records of the destination in the
+// CIS or DNS Services zone configured in dns_verification, and returns the IDs of the created records.
+func enCustomEmailCreateVerificationRecords(context context.Context, d *schema.ResourceData, meta interface{}, instanceID, destinationID string) ([]string, error) {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return nil, err
+	}
+
+	options := &en.GetDestinationOptions{}
+	options.SetInstanceID(instanceID)
+	options.SetID(destinationID)
+	destination, response, err := enClient.GetDestinationWithContext(context, options)
+	if err != nil {
+		return nil, fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response)
+	}
+
+	records := []*enCustomEmailVerificationRecord{nil, nil}
+	spf, dkim := enCustomEmailDestinationRecords(destination)
+	if spf != nil {
+		records[0] = &enCustomEmailVerificationRecord{TxtName: spf.TxtName, TxtValue: spf.TxtValue}
+	}
+	records[1] = enCustomEmailDkimRecord(d.Get("config.0.params.0.domain").(string), dkim)
+	return enCreateVerificationRecords(d, meta, "Destination "+destinationID, records)
+}
+
+// enCreateVerificationRecords creates the given TXT records in the CIS or DNS Services zone configured
+// in dns_verification, and returns the IDs of the created records.
+func enCreateVerificationRecords(d *schema.ResourceData, meta interface{}, owner string, records []*enCustomEmailVerificationRecord) ([]string, error) {
+	cisID := d.Get("dns_verification.0.cis_id").(string)
+	dnsInstanceID := d.Get("dns_verification.0.dns_instance_id").(string)
+	zoneID := d.Get("dns_verification.0.zone_id").(string)
+	ttl := int64(d.Get("dns_verification.0.ttl").(int))
+
+	recordIDs := []string{}
+	for _, record := range records {
+		if record == nil || record.TxtName == nil || record.TxtValue == nil {
+			return recordIDs, fmt.Errorf("[ERROR] %s has no verification records for its custom domain", owner)
+		}
+		if cisID != "" {
+			sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+			if err != nil {
+				return recordIDs, err
+			}
+			sess.Crn = core.StringPtr(cisID)
+			sess.ZoneIdentifier = core.StringPtr(zoneID)
+			opt := sess.NewCreateDnsRecordOptions()
+			opt.SetType("TXT")
+			opt.SetName(*record.TxtName)
+			opt.SetContent(*record.TxtValue)
+			opt.SetTTL(ttl)
+			result, response, err := sess.CreateDnsRecord(opt)
+			if err != nil {
+				return recordIDs, fmt.Errorf("[ERROR] Error creating verification record %s: %s\n%s", *record.TxtName, err, response)
+			}
+			recordIDs = append(recordIDs, *result.Result.ID)
+		} else {
+			sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+			if err != nil {
+				return recordIDs, err
+			}
+			opt := sess.NewCreateResourceRecordOptions(dnsInstanceID, zoneID)
+			opt.SetType("TXT")
+			opt.SetName(*record.TxtName)
+			opt.SetTTL(ttl)
+			rdata, err := sess.NewResourceRecordInputRdataRdataTxtRecord(*record.TxtValue)
+			if err != nil {
+				return recordIDs, err
+			}
+			opt.SetRdata(rdata)
+			result, response, err := sess.CreateResourceRecord(opt)
+			if err != nil {
+				return recordIDs, fmt.Errorf("[ERROR] Error creating verification record %s: %s\n%s", *record.TxtName, err, response)
+			}
+			recordIDs = append(recordIDs, *result.ID)
+		}
+	}
+
+	return recordIDs, nil
+}
+
+// enCustomEmailDeleteVerificationRecords removes the verification records that were created for the
+// dns_verification configuration. Records that are already gone are ignored.
+func enCustomEmailDeleteVerificationRecords(meta interface{}, verification []interface{}) error {
+	if len(verification) == 0 || verification[0] == nil {
+		return nil
+	}
+	config := verification[0].(map[string]interface{})
+	recordIDs := flex.ExpandStringList(config["record_ids"].([]interface{}))
+	cisID := config["cis_id"].(string)
+	dnsInstanceID := config["dns_instance_id"].(string)
+	zoneID := config["zone_id"].(string)
+
+	for _, recordID := range recordIDs {
+		if cisID != "" {
+			sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+			if err != nil {
+				return err
+			}
+			sess.Crn = core.StringPtr(cisID)
+			sess.ZoneIdentifier = core.StringPtr(zoneID)
+			_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(recordID))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error deleting verification record %s: %s\n%s", recordID, err, response)
+			}
+		} else {
+			sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+			if err != nil {
+				return err
+			}
+			response, err := sess.DeleteResourceRecord(sess.NewDeleteResourceRecordOptions(dnsInstanceID, zoneID, recordID))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error deleting verification record %s: %s\n%s", recordID, err, response)
+			}
+		}
+	}
+	return nil
+}
+
+// enCustomEmailWaitForVerification requests the SPF and DKIM verification of the custom domain until
+// both succeed. DNS propagation can take a while, so failed verifications are retried until the timeout.
+func enCustomEmailWaitForVerification(context context.Context, enClient *en.EventNotificationsV1, instanceID, destinationID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"verified"},
+		Refresh: func() (interface{}, string, error) {
+			for _, verificationType := range []string{"spf", "dkim"} {
+				options := enClient.NewUpdateVerifyDestinationOptions(instanceID, destinationID, verificationType)
+				result, response, err := enClient.UpdateVerifyDestinationWithContext(context, options)
+				if err != nil {
+					return nil, "", fmt.Errorf("UpdateVerifyDestinationWithContext failed %s\n%s", err, response)
+				}
+				status := strings.ToLower(flex.StringValue(result.Verification))
+				if status != "success" && status != "verified" {
+					log.Printf("[DEBUG] %s verification of destination %s is %s", verificationType, destinationID, status)
+					return result, "pending", nil
+				}
+			}
+			return destinationID, "verified", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the custom domain of destination %s to be verified: %s", destinationID, err)
+	}
+	return nil
+}
@@ -59,6 +59,30 @@ func TestAccIBMEnCustomEmailDestinationAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMEnCustomEmailDestinationDNSVerification(t *testing.T) {
+	var config en.Destination
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnCustomEmailDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnCustomEmailDestinationDNSVerificationConfig(instanceName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEnCustomEmailDestinationExists("ibm_en_destination_custom_email.en_destination_resource_1", config),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_email.en_destination_resource_1", "dns_verification.0.record_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_en_destination_custom_email.en_destination_resource_1", "spf.0.txt_name"),
+					resource.TestCheckResourceAttrSet("ibm_en_destination_custom_email.en_destination_resource_1", "dkim.0.selector"),
+					resource.TestCheckResourceAttrSet("ibm_en_destination_custom_email.en_destination_resource_1", "dkim.0.public_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMEnCustomEmailDestinationConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_destination_resource" {
@@ -147,3 +171,43 @@ func testAccCheckIBMEnCustomEmailDestinationDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMEnCustomEmailDestinationDNSVerificationConfig(instanceName, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%s"
+	}
+
+	data "ibm_cis" "cis" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%s"
+	}
+
+	data "ibm_cis_domain" "cis_domain" {
+		cis_id = data.ibm_cis.cis.id
+		domain = "%s"
+	}
+
+	resource "ibm_resource_instance" "en_destination_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_destination_custom_email" "en_destination_resource_1" {
+		instance_guid = ibm_resource_instance.en_destination_resource.guid
+		name          = "%s"
+		type          = "smtp_custom"
+		config {
+			params {
+				domain = data.ibm_cis_domain.cis_domain.domain
+			}
+		}
+		dns_verification {
+			cis_id  = data.ibm_cis_domain.cis_domain.cis_id
+			zone_id = data.ibm_cis_domain.cis_domain.domain_id
+		}
+	}
+	`, acc.CisResourceGroup, acc.CisInstance, acc.CisDomainStatic, instanceName, name)
+}
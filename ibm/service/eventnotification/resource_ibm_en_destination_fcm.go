@@ -23,6 +23,7 @@ func ResourceIBMEnFCMDestination() *schema.Resource {
 		UpdateContext: resourceIBMEnFCMDestinationUpdate,
 		DeleteContext: resourceIBMEnFCMDestinationDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: pushDestinationSecretVersionDiff("credentials_secret"),
 
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
@@ -76,13 +77,16 @@ func ResourceIBMEnFCMDestination() *schema.Resource {
 									},
 									"private_key": {
 										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The FCM private_key.",
+										Sensitive:   true,
+										Optional:    true,
+										Computed:    true,
+										Description: "The FCM private_key. Required unless credentials_secret is set.",
 									},
 									"client_email": {
 										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The FCM client_email.",
+										Optional:    true,
+										Computed:    true,
+										Description: "The FCM client_email. Required unless credentials_secret is set.",
 									},
 								},
 							},
@@ -90,6 +94,19 @@ func ResourceIBMEnFCMDestination() *schema.Resource {
 					},
 				},
 			},
+			"credentials_secret": pushDestinationSecretSchema("The Secrets Manager secret the FCM credentials are read from, either an arbitrary secret holding the service account JSON key or a key-value secret with the private_key and client_email keys. The destination is updated when a new version of the secret is created.", nil),
+			"secret_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the credentials secret the destination was last updated with.",
+			},
+			"secret_latest_version_id": pushDestinationSecretLatestVersionSchema(),
+			"send_test_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a test notification is sent through the destination after it is created or updated, to validate its configuration.",
+			},
 			"destination_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -132,8 +149,15 @@ func resourceIBMEnFCMDestinationCreate(context context.Context, d *schema.Resour
 	if _, ok := d.GetOk("description"); ok {
 		options.SetDescription(d.Get("description").(string))
 	}
+	secret, err := getPushDestinationSecret(context, meta, d.Get("credentials_secret").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if _, ok := d.GetOk("config"); ok {
-		config := FCMdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}), destinationtype)
+		config, err := fcmDestinationConfig(d, secret, destinationtype)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		options.SetConfig(&config)
 	}
 
@@ -143,6 +167,15 @@ func resourceIBMEnFCMDestinationCreate(context context.Context, d *schema.Resour
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
+	if secret != nil {
+		d.Set("secret_version_id", secret.VersionID)
+	}
+
+	if d.Get("send_test_notification").(bool) {
+		if err = sendPushDestinationTestNotification(context, meta, *options.InstanceID, *result.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceIBMEnFCMDestinationRead(context, d, meta)
 }
@@ -216,6 +249,10 @@ func resourceIBMEnFCMDestinationRead(context context.Context, d *schema.Resource
 
 	}
 
+	if err = setPushDestinationSecretLatestVersion(context, d, meta, "credentials_secret"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -235,7 +272,7 @@ func resourceIBMEnFCMDestinationUpdate(context context.Context, d *schema.Resour
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
-	if ok := d.HasChanges("name", "description", "collect_failed_events", "config"); ok {
+	if ok := d.HasChanges("name", "description", "collect_failed_events", "config", "credentials_secret", "secret_version_id"); ok {
 		options.SetName(d.Get("name").(string))
 
 		if _, ok := d.GetOk("description"); ok {
@@ -246,19 +283,58 @@ func resourceIBMEnFCMDestinationUpdate(context context.Context, d *schema.Resour
 			options.SetCollectFailedEvents(d.Get("collect_failed_events").(bool))
 		}
 		destinationtype := d.Get("type").(string)
+		secret, err := getPushDestinationSecret(context, meta, d.Get("credentials_secret").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		if _, ok := d.GetOk("config"); ok {
-			config := FCMdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}), destinationtype)
+			config, err := fcmDestinationConfig(d, secret, destinationtype)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 			options.SetConfig(&config)
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
 		}
+		if secret != nil {
+			d.Set("secret_version_id", secret.VersionID)
+		} else {
+			d.Set("secret_version_id", "")
+		}
 
-		return resourceIBMEnFCMDestinationRead(context, d, meta)
+		if d.Get("send_test_notification").(bool) {
+			if err = sendPushDestinationTestNotification(context, meta, parts[0], parts[1]); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
-	return nil
+	return resourceIBMEnFCMDestinationRead(context, d, meta)
+}
+
+// fcmDestinationConfig builds the destination configuration, with the credentials read from the
+// credentials secret when one is configured.
+func fcmDestinationConfig(d *schema.ResourceData, secret *pushDestinationSecret, destinationtype string) (en.DestinationConfig, error) {
+	configParams := d.Get("config.0.params.0").(map[string]interface{})
+	if secret != nil {
+		credentials, err := fcmCredentialsFromSecret(secret)
+		if err != nil {
+			return en.DestinationConfig{}, err
+		}
+		params := make(map[string]interface{}, len(configParams))
+		for key, value := range configParams {
+			params[key] = value
+		}
+		for key, value := range credentials {
+			params[key] = value
+		}
+		configParams = params
+	} else if configParams["private_key"] == "" || configParams["client_email"] == "" {
+		return en.DestinationConfig{}, fmt.Errorf("[ERROR] private_key and client_email are required unless credentials_secret is set")
+	}
+	return FCMdestinationConfigMapToDestinationConfig(configParams, destinationtype), nil
 }
 
 func resourceIBMEnFCMDestinationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
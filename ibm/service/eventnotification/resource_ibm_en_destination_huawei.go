@@ -76,6 +76,7 @@ func ResourceIBMEnHuaweiDestination() *schema.Resource {
 									},
 									"client_secret": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "ClientSecret for the Huawei account oauth.",
 									},
@@ -66,6 +66,7 @@ func ResourceIBMEnMSTeamsDestination() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"url": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "URL for msteams.",
 									},
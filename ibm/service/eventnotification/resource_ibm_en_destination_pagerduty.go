@@ -72,6 +72,7 @@ func ResourceIBMEnPagerDutyDestination() *schema.Resource {
 									},
 									"routing_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The website url",
 									},
@@ -66,6 +66,7 @@ func ResourceIBMEnSlackDestination() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"url": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "Slack webhook url.",
 									},
@@ -6,8 +6,12 @@ package eventnotification
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -25,6 +29,11 @@ func ResourceIBMEnSMTPConfiguration() *schema.Resource {
 		DeleteContext: resourceIBMEnSMTPConfigurationDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:         schema.TypeString,
@@ -56,6 +65,7 @@ func ResourceIBMEnSMTPConfiguration() *schema.Resource {
 				Optional:    true,
 				Description: "Domain Name.",
 			},
+			"dns_verification": enCustomEmailDNSVerificationSchema(),
 			"config": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -208,6 +218,12 @@ func resourceIBMEnSMTPConfigurationCreate(context context.Context, d *schema.Res
 
 	d.SetId(fmt.Sprintf("%s/%s", *createSMTPConfigurationOptions.InstanceID, *smtpCreateResponse.ID))
 
+	if _, ok := d.GetOk("dns_verification"); ok {
+		if err = enSMTPVerifyDomain(context, d, meta, *createSMTPConfigurationOptions.InstanceID, *smtpCreateResponse.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMEnSMTPConfigurationRead(context, d, meta)
 }
 
@@ -317,6 +333,21 @@ func resourceIBMEnSMTPConfigurationUpdate(context context.Context, d *schema.Res
 		}
 	}
 
+	if d.HasChange("dns_verification") {
+		oldVerification, _ := d.GetChange("dns_verification")
+		if err = enCustomEmailDeleteVerificationRecords(meta, oldVerification.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = enCustomEmailSetVerificationRecordIDs(d, []string{}); err != nil {
+			return diag.FromErr(err)
+		}
+		if _, ok := d.GetOk("dns_verification"); ok {
+			if err = enSMTPVerifyDomain(context, d, meta, parts[0], parts[1], d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceIBMEnSMTPConfigurationRead(context, d, meta)
 }
 
@@ -336,6 +367,12 @@ func resourceIBMEnSMTPConfigurationDelete(context context.Context, d *schema.Res
 	deleteSMTPConfigurationOptions.SetInstanceID(parts[0])
 	deleteSMTPConfigurationOptions.SetID(parts[1])
 
+	if verification, ok := d.GetOk("dns_verification"); ok {
+		if err = enCustomEmailDeleteVerificationRecords(meta, verification.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = eventNotificationsClient.DeleteSMTPConfigurationWithContext(context, deleteSMTPConfigurationOptions)
 	if err != nil {
 		return diag.FromErr(err)
@@ -407,3 +444,81 @@ func resourceIBMEnSMTPConfigurationSpfAttributesToMap(model *en.SpfAttributes) (
 	}
 	return modelMap, nil
 }
+
+// enSMTPVerifyDomain creates the SPF and DKIM records of the SMTP configuration in the zone configured in
+// dns_verification, and requests their verification until both succeed. DNS propagation can take a while,
+// so failed verifications are retried until the timeout.
+func enSMTPVerifyDomain(context context.Context, d *schema.ResourceData, meta interface{}, instanceID, smtpID string, timeout time.Duration) error {
+	eventNotificationsClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	getSMTPConfigurationOptions := &en.GetSMTPConfigurationOptions{}
+	getSMTPConfigurationOptions.SetInstanceID(instanceID)
+	getSMTPConfigurationOptions.SetID(smtpID)
+	smtpConfiguration, response, err := eventNotificationsClient.GetSMTPConfigurationWithContext(context, getSMTPConfigurationOptions)
+	if err != nil {
+		return fmt.Errorf("GetSMTPConfigurationWithContext failed %s\n%s", err, response)
+	}
+
+	records := map[string]*enCustomEmailVerificationRecord{}
+	if smtpConfiguration.Config != nil && smtpConfiguration.Config.Spf != nil {
+		records["spf"] = &enCustomEmailVerificationRecord{TxtName: smtpConfiguration.Config.Spf.TxtName, TxtValue: smtpConfiguration.Config.Spf.TxtValue}
+	}
+	if smtpConfiguration.Config != nil && smtpConfiguration.Config.Dkim != nil {
+		records["dkim"] = &enCustomEmailVerificationRecord{TxtName: smtpConfiguration.Config.Dkim.TxtName, TxtValue: smtpConfiguration.Config.Dkim.TxtValue}
+	}
+
+	recordIDs, err := enCreateVerificationRecords(d, meta, "SMTP configuration "+smtpID, []*enCustomEmailVerificationRecord{records["spf"], records["dkim"]})
+	if setErr := enCustomEmailSetVerificationRecordIDs(d, recordIDs); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		return err
+	}
+
+	statuses := map[string]string{}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"verified"},
+		Refresh: func() (interface{}, string, error) {
+			for _, verificationType := range []string{"spf", "dkim"} {
+				verifySMTPOptions := &en.UpdateVerifySMTPOptions{}
+				verifySMTPOptions.SetInstanceID(instanceID)
+				verifySMTPOptions.SetID(smtpID)
+				verifySMTPOptions.SetType(verificationType)
+				result, response, err := eventNotificationsClient.UpdateVerifySMTPWithContext(context, verifySMTPOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("UpdateVerifySMTPWithContext failed %s\n%s", err, response)
+				}
+				status := ""
+				for _, verification := range result.Status {
+					if strings.EqualFold(flex.StringValue(verification.Type), verificationType) {
+						status = strings.ToLower(flex.StringValue(verification.Verification))
+					}
+				}
+				statuses[verificationType] = status
+				if status != "success" && status != "verified" {
+					log.Printf("[DEBUG] %s verification of SMTP configuration %s is %s", verificationType, smtpID, status)
+					return result, "pending", nil
+				}
+			}
+			return smtpID, "verified", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(context); err != nil {
+		diagnostics := []string{}
+		for _, verificationType := range []string{"spf", "dkim"} {
+			if statuses[verificationType] == "success" || statuses[verificationType] == "verified" {
+				continue
+			}
+			diagnostics = append(diagnostics, fmt.Sprintf("%s verification is %q, check that the TXT record %s with the value %q resolves publicly", verificationType, statuses[verificationType], flex.StringValue(records[verificationType].TxtName), flex.StringValue(records[verificationType].TxtValue)))
+		}
+		return fmt.Errorf("[ERROR] Error waiting for the domain %s of SMTP configuration %s to be verified: %s. %s. The zone %s must be authoritative for the domain, increase the create or update timeout when DNS propagation is slow", d.Get("domain").(string), smtpID, err, strings.Join(diagnostics, "; "), d.Get("dns_verification.0.zone_id").(string))
+	}
+	return nil
+}
@@ -65,15 +65,17 @@ func ResourceIBMEnTopic() *schema.Resource {
 										Description: "Whether the rule is enabled or not.",
 									},
 									"event_type_filter": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Event type filter.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateEnTopicRuleFilter,
+										Description:  "Event type filter.",
 									},
 									"notification_filter": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Default:     "",
-										Description: "Notification filter.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "",
+										ValidateFunc: validateEnTopicRuleFilter,
+										Description:  "Notification filter.",
 									},
 								},
 							},
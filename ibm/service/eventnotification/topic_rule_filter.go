@@ -0,0 +1,421 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A topic rule filter is a boolean expression over the JSON payload of an event, conditions compare
+// a JSONPath with a literal and are combined with &&, || and parentheses, for example
+//
+//	$.notification_event_info.event_type == 'cert_manager' && $.notification.findings[0].severity != 'LOW'
+//
+// The wildcard $.* matches every event.
+//
+// The filters are parsed when the plan is built so that syntax errors are reported before the topic
+// is sent to Event Notifications, which only rejects or silently ignores the rule.
+
+type enFilterTokenKind int
+
+const (
+	enFilterTokenPath enFilterTokenKind = iota
+	enFilterTokenWildcard
+	enFilterTokenOperator
+	enFilterTokenString
+	enFilterTokenNumber
+	enFilterTokenLiteral
+	enFilterTokenAnd
+	enFilterTokenOr
+	enFilterTokenNot
+	enFilterTokenOpen
+	enFilterTokenClose
+)
+
+type enFilterToken struct {
+	kind  enFilterTokenKind
+	text  string
+	pos   int
+	value interface{}
+	path  []interface{}
+}
+
+// enFilterNode is a node of a parsed filter, it either combines child nodes or is a comparison.
+type enFilterNode struct {
+	op       string
+	children []*enFilterNode
+	path     []interface{}
+	value    interface{}
+}
+
+type enFilterParser struct {
+	tokens []enFilterToken
+	pos    int
+	length int
+}
+
+// parseEnTopicRuleFilter parses a topic rule filter and returns its syntax tree.
+func parseEnTopicRuleFilter(filter string) (*enFilterNode, error) {
+	tokens, err := tokenizeEnTopicRuleFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("the filter is empty")
+	}
+	p := &enFilterParser{tokens: tokens, length: len(filter)}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		t := p.tokens[p.pos]
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos+1)
+	}
+	return node, nil
+}
+
+func (p *enFilterParser) peek() *enFilterToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *enFilterParser) expected(what string) error {
+	if t := p.peek(); t != nil {
+		return fmt.Errorf("expected %s at position %d, found %q", what, t.pos+1, t.text)
+	}
+	return fmt.Errorf("expected %s at position %d, found the end of the filter", what, p.length+1)
+}
+
+func (p *enFilterParser) parseOr() (*enFilterNode, error) {
+	node, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t != nil && t.kind == enFilterTokenOr; t = p.peek() {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		node = &enFilterNode{op: "||", children: []*enFilterNode{node, right}}
+	}
+	return node, nil
+}
+
+func (p *enFilterParser) parseAnd() (*enFilterNode, error) {
+	node, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t != nil && t.kind == enFilterTokenAnd; t = p.peek() {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		node = &enFilterNode{op: "&&", children: []*enFilterNode{node, right}}
+	}
+	return node, nil
+}
+
+func (p *enFilterParser) parseUnary() (*enFilterNode, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, p.expected("a condition")
+	}
+	switch t.kind {
+	case enFilterTokenWildcard:
+		p.pos++
+		return &enFilterNode{op: "*"}, nil
+	case enFilterTokenNot:
+		p.pos++
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &enFilterNode{op: "!", children: []*enFilterNode{child}}, nil
+	case enFilterTokenOpen:
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); t == nil || t.kind != enFilterTokenClose {
+			return nil, p.expected("')'")
+		}
+		p.pos++
+		return node, nil
+	case enFilterTokenPath:
+		p.pos++
+		op := p.peek()
+		if op == nil || op.kind != enFilterTokenOperator {
+			return nil, p.expected("a comparison operator (==, !=, <, <=, >, >=)")
+		}
+		p.pos++
+		value := p.peek()
+		if value == nil || (value.kind != enFilterTokenString && value.kind != enFilterTokenNumber && value.kind != enFilterTokenLiteral) {
+			return nil, p.expected("a string, number, true, false or null")
+		}
+		p.pos++
+		return &enFilterNode{op: op.text, path: t.path, value: value.value}, nil
+	}
+	return nil, p.expected("a condition starting with '$'")
+}
+
+func tokenizeEnTopicRuleFilter(filter string) ([]enFilterToken, error) {
+	tokens := []enFilterToken{}
+	i := 0
+	for i < len(filter) {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenOpen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenClose, text: ")", pos: i})
+			i++
+		case strings.HasPrefix(filter[i:], "&&"):
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(filter[i:], "||"):
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenOr, text: "||", pos: i})
+			i += 2
+		case strings.HasPrefix(filter[i:], "=="), strings.HasPrefix(filter[i:], "!="),
+			strings.HasPrefix(filter[i:], "<="), strings.HasPrefix(filter[i:], ">="):
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenOperator, text: filter[i : i+2], pos: i})
+			i += 2
+		case c == '<' || c == '>':
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenOperator, text: string(c), pos: i})
+			i++
+		case c == '!':
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenNot, text: "!", pos: i})
+			i++
+		case c == '\'' || c == '"':
+			value, end, err := scanEnFilterString(filter, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenString, text: filter[i:end], pos: i, value: value})
+			i = end
+		case strings.HasPrefix(filter[i:], "$.*"):
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenWildcard, text: "$.*", pos: i})
+			i += 3
+		case c == '$':
+			path, end, err := scanEnFilterPath(filter, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenPath, text: filter[i:end], pos: i, path: path})
+			i = end
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(filter) && strings.ContainsRune("0123456789.eE+-", rune(filter[end])) {
+				end++
+			}
+			value, err := strconv.ParseFloat(filter[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", filter[i:end], i+1)
+			}
+			tokens = append(tokens, enFilterToken{kind: enFilterTokenNumber, text: filter[i:end], pos: i, value: value})
+			i = end
+		case isEnFilterIdentifierChar(c):
+			end := i
+			for end < len(filter) && isEnFilterIdentifierChar(filter[end]) {
+				end++
+			}
+			word := filter[i:end]
+			switch word {
+			case "true":
+				tokens = append(tokens, enFilterToken{kind: enFilterTokenLiteral, text: word, pos: i, value: true})
+			case "false":
+				tokens = append(tokens, enFilterToken{kind: enFilterTokenLiteral, text: word, pos: i, value: false})
+			case "null":
+				tokens = append(tokens, enFilterToken{kind: enFilterTokenLiteral, text: word, pos: i, value: nil})
+			default:
+				return nil, fmt.Errorf("unexpected %q at position %d, strings must be quoted and paths must start with '$'", word, i+1)
+			}
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+		}
+	}
+	return tokens, nil
+}
+
+func isEnFilterIdentifierChar(c byte) bool {
+	return c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// scanEnFilterString scans the quoted string starting at start and returns its value and the index
+// following the closing quote.
+func scanEnFilterString(filter string, start int) (string, int, error) {
+	quote := filter[start]
+	var value strings.Builder
+	for i := start + 1; i < len(filter); i++ {
+		switch filter[i] {
+		case '\\':
+			if i+1 < len(filter) {
+				i++
+				value.WriteByte(filter[i])
+			}
+		case quote:
+			return value.String(), i + 1, nil
+		default:
+			value.WriteByte(filter[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+// scanEnFilterPath scans the JSONPath starting at start, the returned segments are the names of the
+// object members (string) and the indexes of the array elements (int) to follow from the root.
+func scanEnFilterPath(filter string, start int) ([]interface{}, int, error) {
+	path := []interface{}{}
+	i := start + 1
+	for i < len(filter) {
+		switch filter[i] {
+		case '.':
+			end := i + 1
+			for end < len(filter) && isEnFilterIdentifierChar(filter[end]) {
+				end++
+			}
+			if end == i+1 {
+				return nil, 0, fmt.Errorf("expected a member name after '.' at position %d", i+1)
+			}
+			path = append(path, filter[i+1:end])
+			i = end
+		case '[':
+			end := strings.IndexByte(filter[i:], ']')
+			if end < 0 {
+				return nil, 0, fmt.Errorf("unterminated '[' at position %d", i+1)
+			}
+			segment := strings.TrimSpace(filter[i+1 : i+end])
+			if len(segment) >= 2 && (segment[0] == '\'' || segment[0] == '"') && segment[len(segment)-1] == segment[0] {
+				path = append(path, segment[1:len(segment)-1])
+			} else if index, err := strconv.Atoi(segment); err == nil && index >= 0 {
+				path = append(path, index)
+			} else {
+				return nil, 0, fmt.Errorf("invalid index %q at position %d, expected a non negative integer or a quoted member name", segment, i+1)
+			}
+			i += end + 1
+		default:
+			if len(path) == 0 {
+				return nil, 0, fmt.Errorf("expected '.' or '[' after '$' at position %d", start+1)
+			}
+			return path, i, nil
+		}
+	}
+	if len(path) == 0 {
+		return nil, 0, fmt.Errorf("expected '.' or '[' after '$' at position %d", start+1)
+	}
+	return path, i, nil
+}
+
+// evaluate reports whether the decoded JSON event matches the filter.
+func (n *enFilterNode) evaluate(event interface{}) bool {
+	switch n.op {
+	case "*":
+		return true
+	case "&&":
+		return n.children[0].evaluate(event) && n.children[1].evaluate(event)
+	case "||":
+		return n.children[0].evaluate(event) || n.children[1].evaluate(event)
+	case "!":
+		return !n.children[0].evaluate(event)
+	}
+
+	actual, ok := resolveEnFilterPath(event, n.path)
+	if !ok {
+		return false
+	}
+	switch expected := n.value.(type) {
+	case float64:
+		if number, ok := actual.(float64); ok {
+			return compareEnFilterValues(n.op, compareEnFilterNumbers(number, expected))
+		}
+	case string:
+		if str, ok := actual.(string); ok {
+			return compareEnFilterValues(n.op, strings.Compare(str, expected))
+		}
+	default:
+		if n.op == "==" {
+			return actual == expected
+		}
+		if n.op == "!=" {
+			return actual != expected
+		}
+		return false
+	}
+	return n.op == "!="
+}
+
+func compareEnFilterNumbers(a, b float64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareEnFilterValues(op string, cmp int) bool {
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func resolveEnFilterPath(value interface{}, path []interface{}) (interface{}, bool) {
+	for _, segment := range path {
+		switch s := segment.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if value, ok = object[s]; !ok {
+				return nil, false
+			}
+		case int:
+			array, ok := value.([]interface{})
+			if !ok || s >= len(array) {
+				return nil, false
+			}
+			value = array[s]
+		}
+	}
+	return value, true
+}
+
+// validateEnTopicRuleFilter validates the syntax of a topic rule filter, an empty filter is accepted
+// so that optional filters can be left unset.
+func validateEnTopicRuleFilter(v interface{}, k string) (ws []string, errors []error) {
+	filter := v.(string)
+	if strings.TrimSpace(filter) == "" {
+		return
+	}
+	if _, err := parseEnTopicRuleFilter(filter); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid filter: %s", k, err))
+	}
+	return
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/sarama"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -53,6 +55,21 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 		UpdateContext: resourceIBMEventStreamsTopicUpdate,
 		DeleteContext: resourceIBMEventStreamsTopicDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Id() == "" || !diff.HasChange("partitions") || !diff.NewValueKnown("partitions") {
+					return nil
+				}
+				old, new := diff.GetChange("partitions")
+				return validateTopicPartitionsChange(old.(int), new.(int))
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if !diff.NewValueKnown("config") {
+					return nil
+				}
+				return validateTopicConfig(diff.Get("config").(map[string]interface{}))
+			},
+		),
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": {
 				Type:        schema.TypeString,
@@ -76,10 +93,11 @@ func ResourceIBMEventStreamsTopic() *schema.Resource {
 				Required:    true,
 			},
 			"partitions": {
-				Type:        schema.TypeInt,
-				Description: "The number of partitions",
-				Optional:    true,
-				Default:     1,
+				Type:         schema.TypeInt,
+				Description:  "The number of partitions. Partitions can be added in place, but never removed",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"config": {
 				Type:        schema.TypeMap,
@@ -179,11 +197,19 @@ func resourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resourc
 			d.Set("resource_instance_id", instanceCRN)
 			d.Set("name", name)
 			d.Set("partitions", detail.NumPartitions)
-			if config := d.Get("config"); config != nil {
+			if config := d.Get("config").(map[string]interface{}); len(config) > 0 {
+				// ListTopics leaves out the configs at their default value, which would show a config set
+				// to its default as drift, so the configured keys are described explicitly
+				entries, err := adminClient.DescribeConfig(sarama.ConfigResource{Type: sarama.TopicResource, Name: name})
+				if err != nil {
+					log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead DescribeConfig err %s", err)
+					return diag.FromErr(err)
+				}
 				savedConfig := map[string]*string{}
-				for k := range config.(map[string]interface{}) {
-					if value, ok := detail.ConfigEntries[k]; ok {
-						savedConfig[k] = value
+				for _, entry := range entries {
+					if _, ok := config[entry.Name]; ok {
+						value := entry.Value
+						savedConfig[entry.Name] = &value
 					}
 				}
 				d.Set("config", topicDetail2Config(savedConfig))
@@ -313,6 +339,33 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	return adminClient, instanceCRN, nil
 }
 
+// validateTopicPartitionsChange rejects a partition decrease at plan time. Kafka can only add partitions, and
+// recreating the topic instead would delete its messages.
+func validateTopicPartitionsChange(oldPartitions, newPartitions int) error {
+	if oldPartitions > 0 && newPartitions < oldPartitions {
+		return fmt.Errorf("[ERROR] The partitions of a topic can not be decreased, from %d to %d. Create a new topic with fewer partitions instead", oldPartitions, newPartitions)
+	}
+	return nil
+}
+
+// validateTopicConfig rejects the config keys that can not be set on a topic, as they would never be read
+// back and show as a permanent diff.
+func validateTopicConfig(config map[string]interface{}) error {
+	for key, value := range config {
+		if flex.IndexOf(key, allowedTopicConfigs) == -1 {
+			return fmt.Errorf("[ERROR] Unsupported topic config %q, supported configs are: %s", key, strings.Join(allowedTopicConfigs, ", "))
+		}
+		if key == "cleanup.policy" {
+			for _, policy := range strings.Split(fmt.Sprint(value), ",") {
+				if policy = strings.TrimSpace(policy); policy != "delete" && policy != "compact" {
+					return fmt.Errorf("[ERROR] Invalid cleanup.policy %q, it must be delete, compact or compact,delete", value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
 	configs := map[string]*string{}
 	for key, value := range topicConfigEntries {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -118,6 +119,37 @@ func TestAccIBMEventStreamsTopicResourceWithExistingInstance(t *testing.T) {
 	})
 }
 
+func TestAccIBMEventStreamsTopicResourcePartitions(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsTopicWithExistingInstanceWithConfig(getTestInstanceName(stdKey), topicName, 1, "delete", 10485760, 3600000, 10485760),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsTopicExists("ibm_event_streams_topic.es_topic", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "partitions", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "config.cleanup.policy", "delete"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsTopicWithExistingInstanceWithConfig(getTestInstanceName(stdKey), topicName, 3, "compact,delete", 10485760, 7200000, 10485760),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsTopicExists("ibm_event_streams_topic.es_topic", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "partitions", "3"),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "config.cleanup.policy", "compact,delete"),
+					resource.TestCheckResourceAttr("ibm_event_streams_topic.es_topic", "config.retention.ms", "7200000"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMEventStreamsTopicWithExistingInstanceWithConfig(getTestInstanceName(stdKey), topicName, 2, "compact,delete", 10485760, 7200000, 10485760),
+				ExpectError: regexp.MustCompile("The partitions of a topic can not be decreased"),
+			},
+		},
+	})
+}
+
 func TestAccIBMEventStreamsTopicImport(t *testing.T) {
 	instanceName := fmt.Sprintf("terraform_support_%d", acctest.RandInt())
 	planID := "standard"
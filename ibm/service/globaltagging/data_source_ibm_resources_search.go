@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourcesSearchPageSize is the largest page size accepted by the Global Search API.
+const resourcesSearchPageSize = 1000
+
+func DataSourceIBMResourcesSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourcesSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The Lucene-formatted Global Search query string, for example `type:instance AND tags:\"env:prod\"`.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of resources returned. All the matching resources are returned when not set.",
+			},
+			"is_deleted": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "false",
+				ValidateFunc: validation.StringInSlice([]string{"true", "false", "any"}, false),
+				Description:  "Whether deleted resources are returned, `true`, `false` or `any`.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources matching the query.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The family of the resource, for example `resource_controller` or `is`.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource.",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags attached to the resource.",
+						},
+					},
+				},
+			},
+			"crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the resources matching the query.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourcesSearchRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting global search client settings: %s", err))
+	}
+
+	query := d.Get("query").(string)
+	limit := d.Get("limit").(int)
+	resources := []map[string]interface{}{}
+	crns := []string{}
+	cursor := ""
+	for {
+		pageSize := resourcesSearchPageSize
+		if limit > 0 && limit-len(resources) < pageSize {
+			pageSize = limit - len(resources)
+		}
+		options := &globalsearchv2.SearchOptions{}
+		options.SetQuery(query)
+		options.SetFields([]string{"name", "family", "type", "region", "resource_group_id", "tags"})
+		options.SetLimit(int64(pageSize))
+		options.SetIsDeleted(d.Get("is_deleted").(string))
+		if cursor != "" {
+			options.SetSearchCursor(cursor)
+		}
+
+		result, response, err := gsClient.SearchWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] SearchWithContext failed for query %s: %s\n%s", query, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error searching resources with query %s: %s\n%s", query, err, response))
+		}
+		for _, item := range result.Items {
+			resources = append(resources, flattenResourcesSearchItem(item))
+			if item.CRN != nil {
+				crns = append(crns, *item.CRN)
+			}
+		}
+
+		if len(result.Items) < pageSize || result.SearchCursor == nil || *result.SearchCursor == "" {
+			break
+		}
+		if limit > 0 && len(resources) >= limit {
+			break
+		}
+		cursor = *result.SearchCursor
+	}
+
+	sum := sha256.Sum256([]byte(query))
+	d.SetId(hex.EncodeToString(sum[:]))
+	if err = d.Set("resources", resources); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources: %s", err))
+	}
+	if err = d.Set("crns", crns); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crns: %s", err))
+	}
+	return nil
+}
+
+func flattenResourcesSearchItem(item globalsearchv2.ResultItem) map[string]interface{} {
+	resource := map[string]interface{}{
+		"tags": []string{},
+	}
+	if item.CRN != nil {
+		resource["crn"] = *item.CRN
+	}
+	for _, key := range []string{"name", "family", "type", "region", "resource_group_id"} {
+		if value, ok := item.GetProperty(key).(string); ok {
+			resource[key] = value
+		}
+	}
+	if tags, ok := item.GetProperty("tags").([]interface{}); ok {
+		tagList := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			tagList = append(tagList, fmt.Sprintf("%v", tag))
+		}
+		resource["tags"] = tagList
+	}
+	return resource
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcesSearchDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-search-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourcesSearchDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resources_search.search", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_resources_search.search", "resources.0.name", name),
+					resource.TestCheckResourceAttrPair("data.ibm_resources_search.search", "crns.0", "ibm_resource_group.group", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcesSearchDataSource(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_group" "group" {
+		name = "%s"
+		tags = ["search:%s"]
+	}
+
+	data "ibm_resources_search" "search" {
+		query = "tags:\"search:${ibm_resource_group.group.name}\""
+		limit = 10
+	}
+`, name, name)
+}
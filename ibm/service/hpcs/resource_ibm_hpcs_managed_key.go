@@ -26,6 +26,7 @@ func ResourceIbmManagedKey() *schema.Resource {
 		UpdateContext: ResourceIbmManagedKeyUpdate,
 		DeleteContext: ResourceIbmManagedKeyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIbmManagedKeyStateDiff,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
@@ -132,10 +133,11 @@ func ResourceIbmManagedKey() *schema.Resource {
 				},
 			},
 			"state": &schema.Schema{
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "The state of the key.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_hpcs_managed_key", "state"),
+				Description:  "The state of the key. Changing it activates, deactivates or destroys the key, walking through the intermediate states when needed.",
 			},
 			"size": &schema.Schema{
 				Type:        schema.TypeString,
@@ -337,6 +339,13 @@ func ResourceIbmManagedKeyValidator() *validate.ResourceValidator {
 			MinValueLength: 0,
 			MaxValueLength: 200,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "pre_activation, active, deactivated, destroyed",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_hpcs_managed_key", Schema: validateSchema}
@@ -393,6 +402,14 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, uko_vault, *managedKey.ID))
 
+	// Keys are created in the pre_activation state, move them to the configured state
+	if state, ok := d.GetOk("state"); ok && managedKey.State != nil && *managedKey.State != state.(string) {
+		_, err = resourceIbmManagedKeyChangeState(context, ukoClient, uko_vault, *managedKey.ID, response.Headers.Get("Etag"), *managedKey.State, state.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceIbmManagedKeyRead(context, d, meta)
 }
 
@@ -603,62 +620,123 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 	// Support for changing state
 	if d.HasChange("state") {
 		prevIntf, newIntf := d.GetChange("state")
-		prev := prevIntf.(string)
-		new := newIntf.(string)
-		if new == "deactivated" {
-			if prev == "active" {
-				deactivateManagedKeyOptions := &ukov4.DeactivateManagedKeyOptions{}
-
-				deactivateManagedKeyOptions.SetIfMatch(etag)
-				deactivateManagedKeyOptions.SetID(key_id)
-				deactivateManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state"))
-			}
-		} else if new == "destroyed" {
-			if prev == "deactivated" || prev == "pre_activation" {
-				destroyManagedKeyOptions := &ukov4.DestroyManagedKeyOptions{}
-
-				destroyManagedKeyOptions.SetIfMatch(etag)
-				destroyManagedKeyOptions.SetID(key_id)
-				destroyManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state"))
-			}
-		} else if new == "active" {
-			if prev == "deactivated" || prev == "pre_activation" {
-				activateManagedKeyOptions := &ukov4.ActivateManagedKeyOptions{}
-
-				activateManagedKeyOptions.SetIfMatch(etag)
-				activateManagedKeyOptions.SetID(key_id)
-				activateManagedKeyOptions.SetUKOVault(vault_id)
-
-				_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
-				if err != nil {
-					log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response))
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state"))
-			}
+		_, err = resourceIbmManagedKeyChangeState(context, ukoClient, vault_id, key_id, etag, prevIntf.(string), newIntf.(string))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	return ResourceIbmManagedKeyRead(context, d, meta)
 }
 
+// resourceIbmManagedKeyStateDiff rejects at plan time the states that the key cannot be moved to.
+// Keys are created in the pre_activation state, so they have to be active before being deactivated.
+func resourceIbmManagedKeyStateDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("state") {
+		return nil
+	}
+	state := diff.Get("state").(string)
+	if diff.Id() == "" {
+		if state == "deactivated" {
+			return fmt.Errorf("[ERROR] A managed key cannot be created in the deactivated state, create it as active and deactivate it afterwards")
+		}
+		return nil
+	}
+	if !diff.HasChange("state") {
+		return nil
+	}
+	prev, _ := diff.GetChange("state")
+	return resourceIbmManagedKeyStateTransition(prev.(string), state)
+}
+
+// resourceIbmManagedKeyStateTransition returns an error when a managed key in the prev state cannot
+// be moved to the state new. A destroyed key cannot leave that state.
+func resourceIbmManagedKeyStateTransition(prev, new string) error {
+	if prev == new {
+		return nil
+	}
+	if prev == "destroyed" {
+		return fmt.Errorf("[ERROR] A managed key in the destroyed state cannot be moved to the %s state", new)
+	}
+	if new == "pre_activation" {
+		return fmt.Errorf("[ERROR] A managed key cannot be moved back to the pre_activation state")
+	}
+	if new == "deactivated" && prev != "active" {
+		return fmt.Errorf("[ERROR] A managed key in the %s state cannot be deactivated, only active keys can", prev)
+	}
+	return nil
+}
+
+// resourceIbmManagedKeyChangeState moves a managed key from state prev to state new. A key that is
+// active is deactivated first when it has to be destroyed. It returns the etag of the key after the
+// last transition.
+func resourceIbmManagedKeyChangeState(context context.Context, ukoClient *ukov4.UkoV4, vaultID, keyID, etag, prev, new string) (string, error) {
+	if prev == "destroyed" {
+		return etag, fmt.Errorf("Changing managed key state failed: Cannot change the state of a destroyed key")
+	}
+	switch new {
+	case "deactivated":
+		if prev != "active" {
+			return etag, fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state")
+		}
+		deactivateManagedKeyOptions := &ukov4.DeactivateManagedKeyOptions{}
+
+		deactivateManagedKeyOptions.SetIfMatch(etag)
+		deactivateManagedKeyOptions.SetID(keyID)
+		deactivateManagedKeyOptions.SetUKOVault(vaultID)
+
+		_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
+			return etag, fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response)
+		}
+		return response.Headers.Get("Etag"), nil
+	case "destroyed":
+		if prev == "active" {
+			var err error
+			etag, err = resourceIbmManagedKeyChangeState(context, ukoClient, vaultID, keyID, etag, prev, "deactivated")
+			if err != nil {
+				return etag, err
+			}
+			prev = "deactivated"
+		}
+		if prev != "deactivated" && prev != "pre_activation" {
+			return etag, fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state")
+		}
+		destroyManagedKeyOptions := &ukov4.DestroyManagedKeyOptions{}
+
+		destroyManagedKeyOptions.SetIfMatch(etag)
+		destroyManagedKeyOptions.SetID(keyID)
+		destroyManagedKeyOptions.SetUKOVault(vaultID)
+
+		_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
+			return etag, fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response)
+		}
+		return response.Headers.Get("Etag"), nil
+	case "active":
+		if prev != "deactivated" && prev != "pre_activation" {
+			return etag, fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state")
+		}
+		activateManagedKeyOptions := &ukov4.ActivateManagedKeyOptions{}
+
+		activateManagedKeyOptions.SetIfMatch(etag)
+		activateManagedKeyOptions.SetID(keyID)
+		activateManagedKeyOptions.SetUKOVault(vaultID)
+
+		_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
+			return etag, fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response)
+		}
+		return response.Headers.Get("Etag"), nil
+	case "pre_activation":
+		return etag, fmt.Errorf("Changing managed key state failed: Cannot move key back to pre_activation state")
+	}
+	return etag, nil
+}
+
 func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ukoClient, err := meta.(conns.ClientSession).UkoV4()
 	if err != nil {
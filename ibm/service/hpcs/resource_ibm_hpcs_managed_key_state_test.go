@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceIbmManagedKeyStateTransition(t *testing.T) {
+	states := []string{"pre_activation", "active", "deactivated", "destroyed"}
+	allowed := map[string]map[string]bool{
+		"pre_activation": {"pre_activation": true, "active": true, "destroyed": true},
+		"active":         {"active": true, "deactivated": true, "destroyed": true},
+		"deactivated":    {"active": true, "deactivated": true, "destroyed": true},
+		"destroyed":      {"destroyed": true},
+	}
+	for _, prev := range states {
+		for _, new := range states {
+			err := resourceIbmManagedKeyStateTransition(prev, new)
+			if allowed[prev][new] {
+				assert.NoError(t, err, "%s to %s", prev, new)
+			} else {
+				assert.Error(t, err, "%s to %s", prev, new)
+			}
+		}
+	}
+}
+
+func TestResourceIbmManagedKeyStateDiff(t *testing.T) {
+	resource := ResourceIbmManagedKey()
+	config := func(state string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"instance_id":   "instance",
+			"region":        "us-south",
+			"uko_vault":     "vault",
+			"template_name": "template",
+			"vault":         []interface{}{map[string]interface{}{"id": "vault"}},
+			"label":         "key",
+			"state":         state,
+		})
+	}
+	stateOf := func(state string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "us-south/instance/vault/key",
+			Attributes: map[string]string{
+				"id":            "us-south/instance/vault/key",
+				"instance_id":   "instance",
+				"region":        "us-south",
+				"uko_vault":     "vault",
+				"template_name": "template",
+				"vault.#":       "1",
+				"vault.0.id":    "vault",
+				"label":         "key",
+				"state":         state,
+			},
+		}
+	}
+	ctx := context.Background()
+
+	_, err := resource.Diff(ctx, nil, config("deactivated"), nil)
+	assert.Error(t, err)
+
+	_, err = resource.Diff(ctx, nil, config("active"), nil)
+	assert.NoError(t, err)
+
+	_, err = resource.Diff(ctx, stateOf("destroyed"), config("active"), nil)
+	assert.Error(t, err)
+
+	_, err = resource.Diff(ctx, stateOf("active"), config("destroyed"), nil)
+	assert.NoError(t, err)
+}
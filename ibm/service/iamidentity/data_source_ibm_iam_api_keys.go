@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamApiKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamApiKeysRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the account. Defaults to the account of the provider.",
+			},
+			"iam_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IAM ID of the user or service ID owning the API keys. All the API keys of the account are listed when not set.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "serviceid"}, false),
+				Description:  "Type of the listed API keys, `user` or `serviceid`.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the listed API keys.",
+			},
+			"inactivity_duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      720,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours without authentication after which an API key is inactive. Ignored when activity_report is set.",
+			},
+			"activity_report": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reference of an inactivity report generated earlier, e.g. by ibm_iam_identity_inactivity_report. A new report is generated when not set.",
+			},
+			"inactive_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether only the inactive API keys are listed.",
+			},
+			"apikeys": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API keys of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of the API key.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the API key.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the API key.",
+						},
+						"crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud Resource Name of the API key.",
+						},
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID of the user or service ID owning the API key.",
+						},
+						"locked": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the API key is locked against deletion and update.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the API key was created.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID of the creator of the API key.",
+						},
+						"inactive": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the API key was not used to authenticate in the duration of the activity report.",
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when an inactive API key was last used to authenticate, empty when it was never used or is active.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamApiKeysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	start := ""
+	allrecs := []iamidentityv1.APIKey{}
+	var pg int64 = 100
+	for {
+		listAPIKeysOptions := &iamidentityv1.ListAPIKeysOptions{
+			AccountID: &accountID,
+			Pagesize:  &pg,
+		}
+		if iamID, ok := d.GetOk("iam_id"); ok {
+			listAPIKeysOptions.SetIamID(iamID.(string))
+		} else {
+			listAPIKeysOptions.SetScope("account")
+		}
+		if keyType, ok := d.GetOk("type"); ok {
+			listAPIKeysOptions.SetType(keyType.(string))
+		}
+		if start != "" {
+			listAPIKeysOptions.Pagetoken = &start
+		}
+
+		apiKeys, response, err := iamIdentityClient.ListAPIKeysWithContext(context, listAPIKeysOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("ListAPIKeysWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNextIAM(apiKeys.Next)
+		allrecs = append(allrecs, apiKeys.Apikeys...)
+		if start == "" {
+			break
+		}
+	}
+
+	reference, report, err := getIamIdentityInactivityReport(context, iamIdentityClient, accountID, d.Get("activity_report").(string), d.Get("inactivity_duration").(int), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	inactive := map[string]*string{}
+	for _, apikey := range report.Apikeys {
+		if apikey.ID != nil {
+			inactive[*apikey.ID] = apikey.LastAuthn
+		}
+	}
+
+	name := d.Get("name").(string)
+	inactiveOnly := d.Get("inactive_only").(bool)
+	apikeys := []map[string]interface{}{}
+	for _, apikey := range allrecs {
+		if name != "" && (apikey.Name == nil || *apikey.Name != name) {
+			continue
+		}
+		lastAuthn, isInactive := inactive[*apikey.ID]
+		if inactiveOnly && !isInactive {
+			continue
+		}
+		apikeyMap := map[string]interface{}{
+			"id":          apikey.ID,
+			"name":        apikey.Name,
+			"description": apikey.Description,
+			"crn":         apikey.CRN,
+			"iam_id":      apikey.IamID,
+			"locked":      apikey.Locked,
+			"created_by":  apikey.CreatedBy,
+			"inactive":    isInactive,
+			"last_authn":  lastAuthn,
+		}
+		if apikey.CreatedAt != nil {
+			apikeyMap["created_at"] = apikey.CreatedAt.String()
+		}
+		apikeys = append(apikeys, apikeyMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, reference))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("activity_report", reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting activity_report: %s", err))
+	}
+	if err = d.Set("apikeys", apikeys); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting apikeys %s", err))
+	}
+	return nil
+}
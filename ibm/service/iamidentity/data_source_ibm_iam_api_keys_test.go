@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIamApiKeysDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-apikey-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamApiKeysDataSourceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_api_keys.api_keys", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_api_keys.api_keys", "activity_report"),
+					resource.TestCheckResourceAttr("data.ibm_iam_api_keys.api_keys", "apikeys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_api_keys.api_keys", "apikeys.0.id", "ibm_iam_api_key.api_key", "apikey_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_api_keys.api_keys", "apikeys.0.inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamApiKeysDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_api_key" "api_key" {
+			name = "%s"
+		}
+
+		data "ibm_iam_api_keys" "api_keys" {
+			name = ibm_iam_api_key.api_key.name
+			inactivity_duration = 1
+		}
+	`, name)
+}
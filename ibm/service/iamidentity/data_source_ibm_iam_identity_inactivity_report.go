@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamIdentityInactivityReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamIdentityInactivityReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the account.",
+			},
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      720,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours without authentication after which an identity is reported as inactive. Ignored when reference is set.",
+			},
+			"reference": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reference of a report generated earlier. A new report is generated when not set.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAMid of the user who triggered the report.",
+			},
+			"report_duration": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Duration in hours for which the report is generated.",
+			},
+			"report_start_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start time of the report.",
+			},
+			"report_end_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End time of the report.",
+			},
+			"users": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users that did not authenticate in the duration of the report.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAMid of the user.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"username": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username of the user.",
+						},
+						"email": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email of the user.",
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the user last authenticated, empty when the user never authenticated.",
+						},
+					},
+				},
+			},
+			"apikeys": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API keys that were not used to authenticate in the duration of the report.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique id of the API key.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the API key.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the API key, `user` or `serviceid`.",
+						},
+						"owner_iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAMid of the user or ID of the service ID owning the API key.",
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the API key was last used to authenticate, empty when it was never used.",
+						},
+					},
+				},
+			},
+			"serviceids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The service IDs that did not authenticate in the duration of the report.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIBMIamIdentityInactivityReportEntitySchema("service ID"),
+				},
+			},
+			"profiles": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The trusted profiles that were not used to authenticate in the duration of the report.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIBMIamIdentityInactivityReportEntitySchema("trusted profile"),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamIdentityInactivityReportEntitySchema(entity string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Unique id of the %s.", entity),
+		},
+		"name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s.", entity),
+		},
+		"last_authn": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Time when the %s last authenticated, empty when it never authenticated.", entity),
+		},
+	}
+}
+
+func dataSourceIBMIamIdentityInactivityReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	reference, report, err := getIamIdentityInactivityReport(context, iamIdentityClient, accountID, d.Get("reference").(string), d.Get("duration").(int), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, reference))
+	if err = d.Set("reference", reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reference: %s", err))
+	}
+	if err = d.Set("created_by", report.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("report_duration", report.ReportDuration); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_duration: %s", err))
+	}
+	if err = d.Set("report_start_time", report.ReportStartTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_start_time: %s", err))
+	}
+	if err = d.Set("report_end_time", report.ReportEndTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_end_time: %s", err))
+	}
+
+	users := []map[string]interface{}{}
+	for _, user := range report.Users {
+		users = append(users, map[string]interface{}{
+			"iam_id":     user.IamID,
+			"name":       user.Name,
+			"username":   user.Username,
+			"email":      user.Email,
+			"last_authn": user.LastAuthn,
+		})
+	}
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting users %s", err))
+	}
+
+	apikeys := []map[string]interface{}{}
+	for _, apikey := range report.Apikeys {
+		apikeyMap := map[string]interface{}{
+			"id":         apikey.ID,
+			"name":       apikey.Name,
+			"type":       apikey.Type,
+			"last_authn": apikey.LastAuthn,
+		}
+		if apikey.User != nil {
+			apikeyMap["owner_iam_id"] = apikey.User.IamID
+		} else if apikey.Serviceid != nil {
+			apikeyMap["owner_iam_id"] = apikey.Serviceid.ID
+		}
+		apikeys = append(apikeys, apikeyMap)
+	}
+	if err = d.Set("apikeys", apikeys); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting apikeys %s", err))
+	}
+
+	if err = d.Set("serviceids", dataSourceIBMIamIdentityInactivityReportEntitiesToList(report.Serviceids)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting serviceids %s", err))
+	}
+	if err = d.Set("profiles", dataSourceIBMIamIdentityInactivityReportEntitiesToList(report.Profiles)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting profiles %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMIamIdentityInactivityReportEntitiesToList(entities []iamidentityv1.EntityActivity) []map[string]interface{} {
+	entityList := []map[string]interface{}{}
+	for _, entity := range entities {
+		entityList = append(entityList, map[string]interface{}{
+			"id":         entity.ID,
+			"name":       entity.Name,
+			"last_authn": entity.LastAuthn,
+		})
+	}
+	return entityList
+}
+
+// getIamIdentityInactivityReport returns the inactivity report of the account with the given reference, or
+// generates a report of the identities that did not authenticate in the last duration hours when reference
+// is empty.
+func getIamIdentityInactivityReport(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, accountID, reference string, duration int, timeout time.Duration) (string, *iamidentityv1.Report, error) {
+	if reference == "" {
+		createReportOptions := &iamidentityv1.CreateReportOptions{}
+		createReportOptions.SetAccountID(accountID)
+		createReportOptions.SetType("inactive")
+		createReportOptions.SetDuration(fmt.Sprintf("%d", duration))
+
+		reportReference, response, err := iamIdentityClient.CreateReportWithContext(context, createReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateReportWithContext failed %s\n%s", err, response)
+			return "", nil, fmt.Errorf("CreateReportWithContext failed %s\n%s", err, response)
+		}
+		reference = *reportReference.Reference
+	}
+
+	var report *iamidentityv1.Report
+	err := waitForIamIdentityReport(context, timeout, func() (*core.DetailedResponse, error) {
+		getReportOptions := &iamidentityv1.GetReportOptions{}
+		getReportOptions.SetAccountID(accountID)
+		getReportOptions.SetReference(reference)
+
+		var response *core.DetailedResponse
+		var err error
+		report, response, err = iamIdentityClient.GetReportWithContext(context, getReportOptions)
+		if err == nil && report == nil {
+			response.StatusCode = http.StatusNoContent
+		}
+		return response, err
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("GetReportWithContext failed for report %s: %s", reference, err)
+	}
+	return reference, report, nil
+}
+
+// waitForIamIdentityReport polls a report until it is generated. The report is not returned, with no
+// content or not found, while it is being generated.
+func waitForIamIdentityReport(context context.Context, timeout time.Duration, getReport func() (*core.DetailedResponse, error)) error {
+	return resource.RetryContext(context, timeout, func() *resource.RetryError {
+		response, err := getReport()
+		if response != nil && (response.StatusCode == http.StatusNoContent || response.StatusCode == http.StatusNotFound) {
+			log.Printf("[DEBUG] Report is being generated")
+			return resource.RetryableError(fmt.Errorf("report is being generated"))
+		}
+		if err != nil {
+			log.Printf("[DEBUG] Get report failed %s\n%s", err, response)
+			return resource.NonRetryableError(fmt.Errorf("%s\n%s", err, response))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamMfaReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamMfaReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the account.",
+			},
+			"reference": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reference of a report generated earlier. A new report is generated when not set.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAMid of the user who triggered the report.",
+			},
+			"report_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date time at which the report was generated.",
+			},
+			"users": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The MFA enrollment status of the users of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAMid of the user.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"username": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username of the user.",
+						},
+						"email": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email of the user.",
+						},
+						"effective_mfa_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currently effective MFA type, i.e. id_based_mfa or account_based_mfa.",
+						},
+						"id_based_mfa_complies": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The enrollment of the user complies to the effective ID based MFA requirement.",
+						},
+						"account_based_mfa_complies": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The enrollment of the user complies to the effective account based MFA requirement.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamMfaReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	reference := d.Get("reference").(string)
+	if reference == "" {
+		createMfaReportOptions := &iamidentityv1.CreateMfaReportOptions{}
+		createMfaReportOptions.SetAccountID(accountID)
+		createMfaReportOptions.SetType("mfa_status")
+
+		reportReference, response, err := iamIdentityClient.CreateMfaReportWithContext(context, createMfaReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateMfaReportWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateMfaReportWithContext failed %s\n%s", err, response))
+		}
+		reference = *reportReference.Reference
+	}
+
+	var report *iamidentityv1.ReportMfaEnrollmentStatus
+	err = waitForIamIdentityReport(context, d.Timeout(schema.TimeoutRead), func() (*core.DetailedResponse, error) {
+		getMfaReportOptions := &iamidentityv1.GetMfaReportOptions{}
+		getMfaReportOptions.SetAccountID(accountID)
+		getMfaReportOptions.SetReference(reference)
+
+		var response *core.DetailedResponse
+		report, response, err = iamIdentityClient.GetMfaReportWithContext(context, getMfaReportOptions)
+		if err == nil && report == nil {
+			response.StatusCode = http.StatusNoContent
+		}
+		return response, err
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetMfaReportWithContext failed for report %s: %s", reference, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, reference))
+	if err = d.Set("reference", reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reference: %s", err))
+	}
+	if err = d.Set("created_by", report.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("report_time", report.ReportTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_time: %s", err))
+	}
+
+	users := []map[string]interface{}{}
+	for _, user := range report.Users {
+		userMap := map[string]interface{}{
+			"iam_id":   user.IamID,
+			"name":     user.Name,
+			"username": user.Username,
+			"email":    user.Email,
+		}
+		if user.Enrollments != nil {
+			userMap["effective_mfa_type"] = user.Enrollments.EffectiveMfaType
+			if user.Enrollments.IDBasedMfa != nil {
+				userMap["id_based_mfa_complies"] = user.Enrollments.IDBasedMfa.Complies
+			}
+			if user.Enrollments.AccountBasedMfa != nil {
+				userMap["account_based_mfa_complies"] = user.Enrollments.AccountBasedMfa.Complies
+			}
+		}
+		users = append(users, userMap)
+	}
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting users %s", err))
+	}
+
+	return nil
+}
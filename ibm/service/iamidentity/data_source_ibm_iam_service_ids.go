@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamServiceIds() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamServiceIdsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the account. Defaults to the account of the provider.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the listed service IDs.",
+			},
+			"inactivity_duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      720,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours without authentication after which a service ID is inactive. Ignored when activity_report is set.",
+			},
+			"activity_report": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reference of an inactivity report generated earlier, e.g. by ibm_iam_identity_inactivity_report. A new report is generated when not set.",
+			},
+			"inactive_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether only the inactive service IDs are listed.",
+			},
+			"service_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The service IDs of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of the service ID.",
+						},
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID of the service ID.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the service ID.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the service ID.",
+						},
+						"crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud Resource Name of the service ID.",
+						},
+						"locked": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the service ID is locked against deletion and update.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the service ID was created.",
+						},
+						"inactive": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the service ID did not authenticate in the duration of the activity report.",
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when an inactive service ID last authenticated, empty when it never authenticated or is active.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamServiceIdsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	start := ""
+	allrecs := []iamidentityv1.ServiceID{}
+	var pg int64 = 100
+	for {
+		listServiceIDOptions := &iamidentityv1.ListServiceIdsOptions{
+			AccountID: &accountID,
+			Pagesize:  &pg,
+		}
+		if name, ok := d.GetOk("name"); ok {
+			listServiceIDOptions.SetName(name.(string))
+		}
+		if start != "" {
+			listServiceIDOptions.Pagetoken = &start
+		}
+
+		serviceIDs, response, err := iamIdentityClient.ListServiceIdsWithContext(context, listServiceIDOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("ListServiceIdsWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNextIAM(serviceIDs.Next)
+		allrecs = append(allrecs, serviceIDs.Serviceids...)
+		if start == "" {
+			break
+		}
+	}
+
+	reference, report, err := getIamIdentityInactivityReport(context, iamIdentityClient, accountID, d.Get("activity_report").(string), d.Get("inactivity_duration").(int), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	inactive := map[string]*string{}
+	for _, serviceID := range report.Serviceids {
+		if serviceID.ID != nil {
+			inactive[*serviceID.ID] = serviceID.LastAuthn
+		}
+	}
+
+	inactiveOnly := d.Get("inactive_only").(bool)
+	serviceIDList := []map[string]interface{}{}
+	for _, serviceID := range allrecs {
+		lastAuthn, isInactive := inactive[*serviceID.ID]
+		if inactiveOnly && !isInactive {
+			continue
+		}
+		serviceIDMap := map[string]interface{}{
+			"id":          serviceID.ID,
+			"iam_id":      serviceID.IamID,
+			"name":        serviceID.Name,
+			"description": serviceID.Description,
+			"crn":         serviceID.CRN,
+			"locked":      serviceID.Locked,
+			"inactive":    isInactive,
+			"last_authn":  lastAuthn,
+		}
+		if serviceID.CreatedAt != nil {
+			serviceIDMap["created_at"] = serviceID.CreatedAt.String()
+		}
+		serviceIDList = append(serviceIDList, serviceIDMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, reference))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("activity_report", reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting activity_report: %s", err))
+	}
+	if err = d.Set("service_ids", serviceIDList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_ids %s", err))
+	}
+	return nil
+}
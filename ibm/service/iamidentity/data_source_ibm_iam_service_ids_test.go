@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIamServiceIdsDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-serviceid-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamServiceIdsDataSourceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_service_ids.service_ids", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_service_ids.service_ids", "activity_report"),
+					resource.TestCheckResourceAttr("data.ibm_iam_service_ids.service_ids", "service_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_service_ids.service_ids", "service_ids.0.id", "ibm_iam_service_id.service_id", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_service_ids.service_ids", "service_ids.0.inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamServiceIdsDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%s"
+		}
+
+		data "ibm_iam_service_ids" "service_ids" {
+			name = ibm_iam_service_id.service_id.name
+			inactivity_duration = 1
+		}
+	`, name)
+}
@@ -10,9 +10,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
@@ -40,6 +42,148 @@ func ResourceIBMIAMTrustedProfile() *schema.Resource {
 				Optional:    true,
 				Description: "The optional description of the trusted profile. The 'description' property is only available if a description was provided during creation of trusted profile.",
 			},
+			"claim_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The claim rules of the trusted profile. When set, the claim rules are managed authoritatively and claim rules that are not listed are deleted. Do not use together with ibm_iam_trusted_profile_claim_rule for the same trusted profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of this claim rule.",
+						},
+						iamClaimRuleType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Type of the claim rule, either 'Profile-SAML' or 'Profile-CR'.",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Profile-SAML", "Profile-CR"}),
+						},
+						"conditions": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Conditions of this claim rule.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The claim to evaluate against.",
+									},
+									iamClaimRuleOperator: {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The operation to perform on the claim. valid values are EQUALS, NOT_EQUALS, EQUALS_IGNORE_CASE, NOT_EQUALS_IGNORE_CASE, CONTAINS, IN.",
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"EQUALS", "NOT_EQUALS", "EQUALS_IGNORE_CASE", "NOT_EQUALS_IGNORE_CASE", "CONTAINS", "IN"}),
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The stringified JSON value that the claim is compared to using the operator.",
+									},
+								},
+							},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the claim rule.",
+						},
+						"realm_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The realm name of the Idp this claim rule applies to. This field is required only if the type is specified as 'Profile-SAML'.",
+						},
+						"cr_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The compute resource type the rule applies to, required only if type is specified as 'Profile-CR'. Valid values are VSI, IKS_SA, ROKS_SA.",
+						},
+						"expiration": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Session expiration in seconds, only required if type is 'Profile-SAML'.",
+						},
+					},
+				},
+			},
+			"link": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMIamTrustedProfileLinkHash,
+				Description: "The links of the trusted profile to compute resources. When set, the links are managed authoritatively and links that are not listed are deleted. Do not use together with ibm_iam_trusted_profile_link for the same trusted profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"link_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of this link.",
+						},
+						"cr_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The compute resource type. Valid values are VSI, IKS_SA, ROKS_SA.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the compute resource.",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The compute resource namespace, only required if cr_type is IKS_SA or ROKS_SA.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the compute resource, only required if cr_type is IKS_SA or ROKS_SA.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Optional name of the link.",
+						},
+					},
+				},
+			},
+			"identity": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMIamTrustedProfileIdentityHash,
+				Description: "The identities that can assume the trusted profile. When set, the identities are managed authoritatively and identities that are not listed are removed. Do not use together with ibm_iam_trusted_profile_identity for the same trusted profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID of the identity.",
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Identifier of the identity that can assume the trusted profiles. This can be a user identifier (IAM id), serviceid or crn.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_iam_trusted_profile_identity", "type"),
+							Description:  "Type of the identity.",
+						},
+						"accounts": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Only valid for the type user. Accounts from which a user can assume the trusted profile, for example the accounts of a federated user.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the identity that can assume the trusted profile.",
+						},
+					},
+				},
+			},
 			"profile_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -163,6 +307,22 @@ func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.Resou
 
 	d.SetId(*trustedProfile.ID)
 
+	if _, ok := d.GetOk("claim_rule"); ok {
+		if err = resourceIBMIamTrustedProfileUpdateClaimRules(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk("link"); ok {
+		if err = resourceIBMIamTrustedProfileUpdateLinks(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk("identity"); ok {
+		if err = resourceIBMIamTrustedProfileUpdateIdentities(context, d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMIamTrustedProfileRead(context, d, meta)
 }
 
@@ -237,6 +397,24 @@ func resourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting history: %s", err))
 	}
 
+	// the claim rules, links and identities are only read when they are managed inline, so that they
+	// do not conflict with the standalone resources
+	if _, ok := d.GetOk("claim_rule"); ok {
+		if err = resourceIBMIamTrustedProfileReadClaimRules(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk("link"); ok {
+		if err = resourceIBMIamTrustedProfileReadLinks(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk("identity"); ok {
+		if err = resourceIBMIamTrustedProfileReadIdentities(context, d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -299,19 +477,37 @@ func resourceIBMIamTrustedProfileUpdate(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	updateProfileOptions := &iamidentityv1.UpdateProfileOptions{}
+	if d.HasChange("name") || d.HasChange("description") {
+		updateProfileOptions := &iamidentityv1.UpdateProfileOptions{}
 
-	updateProfileOptions.SetIfMatch("*")
-	updateProfileOptions.SetProfileID(d.Id())
-	updateProfileOptions.SetName(d.Get("name").(string))
-	if _, ok := d.GetOk("description"); ok {
-		updateProfileOptions.SetDescription(d.Get("description").(string))
+		updateProfileOptions.SetIfMatch("*")
+		updateProfileOptions.SetProfileID(d.Id())
+		updateProfileOptions.SetName(d.Get("name").(string))
+		if _, ok := d.GetOk("description"); ok {
+			updateProfileOptions.SetDescription(d.Get("description").(string))
+		}
+
+		_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
+		}
 	}
 
-	_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
-	if err != nil {
-		log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
+	if d.HasChange("claim_rule") {
+		if err = resourceIBMIamTrustedProfileUpdateClaimRules(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("link") {
+		if err = resourceIBMIamTrustedProfileUpdateLinks(d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("identity") {
+		if err = resourceIBMIamTrustedProfileUpdateIdentities(context, d, iamIdentityClient); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMIamTrustedProfileRead(context, d, meta)
@@ -337,3 +533,303 @@ func resourceIBMIamTrustedProfileDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+func resourceIBMIamTrustedProfileLinkHash(v interface{}) int {
+	link := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s/%s/%s/%s/%s", link["cr_type"], link["crn"], link["namespace"], link["resource_name"], link["name"]))
+}
+
+func resourceIBMIamTrustedProfileIdentityHash(v interface{}) int {
+	identity := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s/%s", identity["type"], identity["identifier"]))
+}
+
+func resourceIBMIamTrustedProfileMapToClaimRuleConditions(conditionsList []interface{}) []iamidentityv1.ProfileClaimRuleConditions {
+	conditions := []iamidentityv1.ProfileClaimRuleConditions{}
+	for _, e := range conditionsList {
+		conditions = append(conditions, resourceIBMIamTrustedProfileClaimRuleMapToProfileClaimRuleConditions(e.(map[string]interface{})))
+	}
+	return conditions
+}
+
+// resourceIBMIamTrustedProfileUpdateClaimRules matches the configured claim rules with the claim rules in
+// the state by position. Changed claim rules are updated in place, new ones are created and the claim
+// rules beyond the end of the configured list are deleted.
+func resourceIBMIamTrustedProfileUpdateClaimRules(d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	oldRules, newRules := d.GetChange("claim_rule")
+	oldList := oldRules.([]interface{})
+	newList := newRules.([]interface{})
+
+	for i, r := range newList {
+		rule := r.(map[string]interface{})
+		ruleID := ""
+		if i < len(oldList) && oldList[i] != nil {
+			ruleID = oldList[i].(map[string]interface{})["rule_id"].(string)
+		}
+
+		if ruleID == "" {
+			createClaimRuleOptions := &iamidentityv1.CreateClaimRuleOptions{}
+			createClaimRuleOptions.SetProfileID(d.Id())
+			createClaimRuleOptions.SetType(rule["type"].(string))
+			createClaimRuleOptions.SetConditions(resourceIBMIamTrustedProfileMapToClaimRuleConditions(rule["conditions"].([]interface{})))
+			if name := rule["name"].(string); name != "" {
+				createClaimRuleOptions.SetName(name)
+			}
+			if realmName := rule["realm_name"].(string); realmName != "" {
+				createClaimRuleOptions.SetRealmName(realmName)
+			}
+			if crType := rule["cr_type"].(string); crType != "" {
+				createClaimRuleOptions.SetCrType(crType)
+			}
+			if expiration := rule["expiration"].(int); expiration != 0 {
+				createClaimRuleOptions.SetExpiration(int64(expiration))
+			}
+			_, response, err := iamIdentityClient.CreateClaimRule(createClaimRuleOptions)
+			if err != nil {
+				log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
+				return fmt.Errorf("CreateClaimRule failed %s\n%s", err, response)
+			}
+			continue
+		}
+
+		if !d.HasChange(fmt.Sprintf("claim_rule.%d", i)) {
+			continue
+		}
+		updateClaimRuleOptions := &iamidentityv1.UpdateClaimRuleOptions{}
+		updateClaimRuleOptions.SetIfMatch("*")
+		updateClaimRuleOptions.SetProfileID(d.Id())
+		updateClaimRuleOptions.SetRuleID(ruleID)
+		updateClaimRuleOptions.SetType(rule["type"].(string))
+		updateClaimRuleOptions.SetConditions(resourceIBMIamTrustedProfileMapToClaimRuleConditions(rule["conditions"].([]interface{})))
+		if name := rule["name"].(string); name != "" {
+			updateClaimRuleOptions.SetName(name)
+		}
+		if realmName := rule["realm_name"].(string); realmName != "" {
+			updateClaimRuleOptions.SetRealmName(realmName)
+		}
+		if crType := rule["cr_type"].(string); crType != "" {
+			updateClaimRuleOptions.SetCrType(crType)
+		}
+		if expiration := rule["expiration"].(int); expiration != 0 {
+			updateClaimRuleOptions.SetExpiration(int64(expiration))
+		}
+		_, response, err := iamIdentityClient.UpdateClaimRule(updateClaimRuleOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
+			return fmt.Errorf("UpdateClaimRule failed %s\n%s", err, response)
+		}
+	}
+
+	for i := len(newList); i < len(oldList); i++ {
+		ruleID := oldList[i].(map[string]interface{})["rule_id"].(string)
+		deleteClaimRuleOptions := &iamidentityv1.DeleteClaimRuleOptions{}
+		deleteClaimRuleOptions.SetProfileID(d.Id())
+		deleteClaimRuleOptions.SetRuleID(ruleID)
+		response, err := iamIdentityClient.DeleteClaimRule(deleteClaimRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
+			return fmt.Errorf("DeleteClaimRule failed %s\n%s", err, response)
+		}
+	}
+	return nil
+}
+
+// resourceIBMIamTrustedProfileReadClaimRules keeps the order of the claim rules in the state and appends
+// the claim rules that were created out of band, so that they show up as a difference.
+func resourceIBMIamTrustedProfileReadClaimRules(d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	listClaimRulesOptions := &iamidentityv1.ListClaimRulesOptions{}
+	listClaimRulesOptions.SetProfileID(d.Id())
+	profileClaimRuleList, response, err := iamIdentityClient.ListClaimRules(listClaimRulesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListClaimRules failed %s\n%s", err, response)
+		return fmt.Errorf("ListClaimRules failed %s\n%s", err, response)
+	}
+
+	rulesByID := map[string]iamidentityv1.ProfileClaimRule{}
+	ruleIDs := []string{}
+	for _, rule := range d.Get("claim_rule").([]interface{}) {
+		if rule == nil {
+			continue
+		}
+		if ruleID := rule.(map[string]interface{})["rule_id"].(string); ruleID != "" {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+	}
+	for _, rule := range profileClaimRuleList.Rules {
+		rulesByID[*rule.ID] = rule
+		if !flex.StringContains(ruleIDs, *rule.ID) {
+			ruleIDs = append(ruleIDs, *rule.ID)
+		}
+	}
+
+	claimRules := []map[string]interface{}{}
+	for _, ruleID := range ruleIDs {
+		rule, ok := rulesByID[ruleID]
+		if !ok {
+			continue
+		}
+		conditions := []map[string]interface{}{}
+		for _, conditionsItem := range rule.Conditions {
+			conditions = append(conditions, resourceIBMIamTrustedProfileClaimRuleProfileClaimRuleConditionsToMap(conditionsItem))
+		}
+		claimRules = append(claimRules, map[string]interface{}{
+			"rule_id":    flex.StringValue(rule.ID),
+			"type":       flex.StringValue(rule.Type),
+			"conditions": conditions,
+			"name":       flex.StringValue(rule.Name),
+			"realm_name": flex.StringValue(rule.RealmName),
+			"cr_type":    flex.StringValue(rule.CrType),
+			"expiration": flex.IntValue(rule.Expiration),
+		})
+	}
+	if err = d.Set("claim_rule", claimRules); err != nil {
+		return fmt.Errorf("[ERROR] Error setting claim_rule: %s", err)
+	}
+	return nil
+}
+
+// resourceIBMIamTrustedProfileUpdateLinks deletes the links that are no longer configured before it
+// creates the new ones, as links cannot be updated.
+func resourceIBMIamTrustedProfileUpdateLinks(d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	oldLinks, newLinks := d.GetChange("link")
+	oldSet := oldLinks.(*schema.Set)
+	newSet := newLinks.(*schema.Set)
+
+	for _, l := range oldSet.Difference(newSet).List() {
+		linkID := l.(map[string]interface{})["link_id"].(string)
+		if linkID == "" {
+			continue
+		}
+		deleteLinkOptions := &iamidentityv1.DeleteLinkOptions{}
+		deleteLinkOptions.SetProfileID(d.Id())
+		deleteLinkOptions.SetLinkID(linkID)
+		response, err := iamIdentityClient.DeleteLink(deleteLinkOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
+			return fmt.Errorf("DeleteLink failed %s\n%s", err, response)
+		}
+	}
+
+	for _, l := range newSet.Difference(oldSet).List() {
+		link := l.(map[string]interface{})
+		createLinkOptions := &iamidentityv1.CreateLinkOptions{}
+		createLinkOptions.SetProfileID(d.Id())
+		createLinkOptions.SetCrType(link["cr_type"].(string))
+		linkRequest := &iamidentityv1.CreateProfileLinkRequestLink{
+			CRN: core.StringPtr(link["crn"].(string)),
+		}
+		if namespace := link["namespace"].(string); namespace != "" {
+			linkRequest.Namespace = core.StringPtr(namespace)
+		}
+		if resourceName := link["resource_name"].(string); resourceName != "" {
+			linkRequest.Name = core.StringPtr(resourceName)
+		}
+		createLinkOptions.SetLink(linkRequest)
+		if name := link["name"].(string); name != "" {
+			createLinkOptions.SetName(name)
+		}
+		_, response, err := iamIdentityClient.CreateLink(createLinkOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
+			return fmt.Errorf("CreateLink failed %s\n%s", err, response)
+		}
+	}
+	return nil
+}
+
+func resourceIBMIamTrustedProfileReadLinks(d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	listLinksOptions := &iamidentityv1.ListLinksOptions{}
+	listLinksOptions.SetProfileID(d.Id())
+	profileLinkList, response, err := iamIdentityClient.ListLinks(listLinksOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListLinks failed %s\n%s", err, response)
+		return fmt.Errorf("ListLinks failed %s\n%s", err, response)
+	}
+
+	links := []interface{}{}
+	for _, profileLink := range profileLinkList.Links {
+		link := map[string]interface{}{
+			"link_id":       flex.StringValue(profileLink.ID),
+			"cr_type":       flex.StringValue(profileLink.CrType),
+			"name":          flex.StringValue(profileLink.Name),
+			"crn":           "",
+			"namespace":     "",
+			"resource_name": "",
+		}
+		if profileLink.Link != nil {
+			link["crn"] = flex.StringValue(profileLink.Link.CRN)
+			link["namespace"] = flex.StringValue(profileLink.Link.Namespace)
+			link["resource_name"] = flex.StringValue(profileLink.Link.Name)
+		}
+		links = append(links, link)
+	}
+	if err = d.Set("link", schema.NewSet(resourceIBMIamTrustedProfileLinkHash, links)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting link: %s", err)
+	}
+	return nil
+}
+
+// resourceIBMIamTrustedProfileUpdateIdentities replaces all the identities of the trusted profile with
+// the configured ones in a single request.
+func resourceIBMIamTrustedProfileUpdateIdentities(context context.Context, d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	getProfileIdentitiesOptions := &iamidentityv1.GetProfileIdentitiesOptions{}
+	getProfileIdentitiesOptions.SetProfileID(d.Id())
+	profileIdentitiesResponse, response, err := iamIdentityClient.GetProfileIdentitiesWithContext(context, getProfileIdentitiesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetProfileIdentitiesWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("GetProfileIdentitiesWithContext failed %s\n%s", err, response)
+	}
+
+	identities := []iamidentityv1.ProfileIdentityRequest{}
+	for _, i := range d.Get("identity").(*schema.Set).List() {
+		identity := i.(map[string]interface{})
+		identityRequest := iamidentityv1.ProfileIdentityRequest{
+			Identifier: core.StringPtr(identity["identifier"].(string)),
+			Type:       core.StringPtr(identity["type"].(string)),
+		}
+		if accounts := identity["accounts"].([]interface{}); len(accounts) > 0 {
+			identityRequest.Accounts = flex.ExpandStringList(accounts)
+		}
+		if description := identity["description"].(string); description != "" {
+			identityRequest.Description = core.StringPtr(description)
+		}
+		identities = append(identities, identityRequest)
+	}
+
+	setProfileIdentitiesOptions := &iamidentityv1.SetProfileIdentitiesOptions{}
+	setProfileIdentitiesOptions.SetProfileID(d.Id())
+	setProfileIdentitiesOptions.SetIfMatch(flex.StringValue(profileIdentitiesResponse.EntityTag))
+	setProfileIdentitiesOptions.SetIdentities(identities)
+	_, response, err = iamIdentityClient.SetProfileIdentitiesWithContext(context, setProfileIdentitiesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] SetProfileIdentitiesWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("SetProfileIdentitiesWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIBMIamTrustedProfileReadIdentities(context context.Context, d *schema.ResourceData, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	getProfileIdentitiesOptions := &iamidentityv1.GetProfileIdentitiesOptions{}
+	getProfileIdentitiesOptions.SetProfileID(d.Id())
+	profileIdentitiesResponse, response, err := iamIdentityClient.GetProfileIdentitiesWithContext(context, getProfileIdentitiesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetProfileIdentitiesWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("GetProfileIdentitiesWithContext failed %s\n%s", err, response)
+	}
+
+	identities := []interface{}{}
+	for _, profileIdentity := range profileIdentitiesResponse.Identities {
+		identity := map[string]interface{}{
+			"iam_id":      flex.StringValue(profileIdentity.IamID),
+			"identifier":  flex.StringValue(profileIdentity.Identifier),
+			"type":        flex.StringValue(profileIdentity.Type),
+			"accounts":    flex.FlattenStringList(profileIdentity.Accounts),
+			"description": flex.StringValue(profileIdentity.Description),
+		}
+		identities = append(identities, identity)
+	}
+	if err = d.Set("identity", schema.NewSet(resourceIBMIamTrustedProfileIdentityHash, identities)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting identity: %s", err)
+	}
+	return nil
+}
@@ -80,6 +80,37 @@ func TestAccIBMIAMTrustedProfileAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMTrustedProfileInline(t *testing.T) {
+	var conf iamidentityv1.TrustedProfile
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIamTrustedProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamTrustedProfileConfigInline(name, "cloud-docs-dev", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIamTrustedProfileExists("ibm_iam_trusted_profile.iam_trusted_profile", conf),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "claim_rule.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "claim_rule.0.conditions.0.value", "\"cloud-docs-dev\""),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile.iam_trusted_profile", "claim_rule.0.rule_id"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "identity.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIamTrustedProfileConfigInline(name, "cloud-docs-prod", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "claim_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "claim_rule.0.conditions.0.value", "\"cloud-docs-prod\""),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.iam_trusted_profile", "identity.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIamTrustedProfileConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -152,3 +183,39 @@ func testAccCheckIBMIamTrustedProfileDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMIamTrustedProfileConfigInline(name string, value string, secondRule bool) string {
+	secondClaimRule := ""
+	if secondRule {
+		secondClaimRule = `
+			claim_rule {
+				type    = "Profile-CR"
+				cr_type = "VSI"
+				conditions {
+					claim    = "name"
+					operator = "EQUALS"
+					value    = "\"tf-vsi\""
+				}
+			}`
+	}
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "iam_trusted_profile" {
+			name = "%s"
+			claim_rule {
+				type    = "Profile-CR"
+				cr_type = "IKS_SA"
+				conditions {
+					claim    = "namespace"
+					operator = "EQUALS"
+					value    = "\"%s\""
+				}
+			}
+			%s
+			identity {
+				identifier  = "%s"
+				type        = "serviceid"
+				description = "tf identity"
+			}
+		}
+	`, name, value, secondClaimRule, acc.IAMServiceId)
+}
@@ -93,6 +93,31 @@ func DataSourceIBMContainerClusterConfig() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"exec_credential": {
+				Description: "If set to true the downloaded config authenticates with a token helper of the provider, which gets a new token when the current one expires, instead of a static token. The helper uses the API key and the IAM endpoint of the provider configuration",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"exec_command": {
+				Description: "The command of the token helper, to use in the exec block of the Kubernetes provider. Defaults to the path of the provider binary",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"exec_args": {
+				Description: "The arguments of the token helper, to use in the exec block of the Kubernetes provider",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exec_env": {
+				Description: "The environment of the token helper, to use in the exec block of the Kubernetes provider",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"config_file_path": {
 				Description: "The absolute path to the kubernetes config yml file ",
 				Type:        schema.TypeString,
@@ -252,6 +277,21 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.Get("exec_credential").(bool) {
+		command, args, env, err := execCredentialCommand(d.Get("exec_command").(string), meta)
+		if err != nil {
+			return err
+		}
+		if download {
+			if err = writeExecCredentialKubeconfig(d.Get("config_file_path").(string), command, args, env); err != nil {
+				return err
+			}
+		}
+		d.Set("exec_command", command)
+		d.Set("exec_args", args)
+		d.Set("exec_env", env)
+	}
+
 	d.SetId(name)
 	d.Set("config_dir", configDir)
 	return nil
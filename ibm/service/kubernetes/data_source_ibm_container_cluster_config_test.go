@@ -59,6 +59,27 @@ func TestAccIBMContainer_ClusterConfigDataSourceVpcBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainer_ClusterConfigDataSourceExecCredential(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterDataSourceExecCredentialConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "config_file_path"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "exec_command"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "exec_args.0", "kube-token"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainer_ClusterConfigCalicoDataSourceBasic(t *testing.T) {
 	homeDir, err := homedir.Dir()
 	if err != nil {
@@ -122,6 +143,27 @@ data "ibm_container_cluster_config" "testacc_ds_cluster" {
 }`, clustername, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID)
 }
 
+func testAccCheckIBMContainerClusterDataSourceExecCredentialConfig(clustername string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_cluster" {
+		name              = "%[1]s"
+		vpc_id            = "%[2]s"
+		flavor            = "bx2.4x16"
+		worker_count      = 1
+		resource_group_id = "%[3]s"
+		zones {
+			subnet_id = "%[4]s"
+			name      = "us-south-1"
+		}
+		wait_till = "Normal"
+	}
+
+data "ibm_container_cluster_config" "testacc_ds_cluster" {
+  cluster_name_id = ibm_container_vpc_cluster.testacc_cluster.id
+  exec_credential = true
+}`, clustername, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID)
+}
+
 func testAccCheckIBMContainerClusterCalicoConfigDataSource(clustername string) string {
 	return fmt.Sprintf(`
 resource "ibm_container_cluster" "testacc_cluster" {
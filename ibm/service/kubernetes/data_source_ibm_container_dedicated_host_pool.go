@@ -66,6 +66,40 @@ func DataSourceIBMContainerDedicatedHostPool() *schema.Resource {
 								},
 							},
 						},
+						"consumed": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resources of the zone that are consumed by workers",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"memory_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"vcpu": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"available": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resources of the zone that are still available for workers",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"memory_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"vcpu": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"host_count": {
 							Type:     schema.TypeInt,
 							Computed: true,
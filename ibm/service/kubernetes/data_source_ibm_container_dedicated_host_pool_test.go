@@ -33,6 +33,27 @@ func TestAccIBMContainerDedicatedHostPoolDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerDedicatedHostPoolDataSource_capacity(t *testing.T) {
+	name := fmt.Sprintf("tf-dedicated-host-pool-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerDedicatedHostPoolDataSourceCapacityConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_container_dedicated_host_pool.test_dhostpool_2", "host_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_container_dedicated_host_pool.test_dhostpool_2", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_container_dedicated_host_pool.test_dhostpool_2", "zones.0.consumed.0.vcpu", "0"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_container_dedicated_host_pool.test_dhostpool_2", "zones.0.available.0.vcpu",
+						"data.ibm_container_dedicated_host_pool.test_dhostpool_2", "zones.0.capacity.0.vcpu"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerDedicatedHostPoolDataSourceConfig(name string) string {
 	return testAccCheckIBMContainerDedicatedHostPoolBasic(name) + `
 	data "ibm_container_dedicated_host_pool" "test_dhostpool_2" {
@@ -40,3 +61,11 @@ func testAccCheckIBMContainerDedicatedHostPoolDataSourceConfig(name string) stri
 	}
 `
 }
+
+func testAccCheckIBMContainerDedicatedHostPoolDataSourceCapacityConfig(name string) string {
+	return testAccCheckIBMContainerDedicatedHostBasic(name) + `
+	data "ibm_container_dedicated_host_pool" "test_dhostpool_2" {
+	    host_pool_id = ibm_container_dedicated_host.test_dhost.host_pool_id
+	}
+`
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// ExecCredentialCommand is the first argument of the provider binary that runs the token helper of the
+	// exec credential kubeconfigs, instead of serving the provider.
+	ExecCredentialCommand = "kube-token"
+
+	execCredentialAPIVersion = "client.authentication.k8s.io/v1beta1"
+	execCredentialIAMURL     = "https://iam.cloud.ibm.com"
+)
+
+// execCredential is the ExecCredential object printed by the token helper for kubectl and the Kubernetes
+// client libraries.
+type execCredential struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Status     execCredentialStatus `json:"status"`
+}
+
+type execCredentialStatus struct {
+	Token               string `json:"token"`
+	ExpirationTimestamp string `json:"expirationTimestamp,omitempty"`
+}
+
+// RunExecCredentialHelper exchanges the API key of the IC_API_KEY or IBMCLOUD_API_KEY environment variable
+// for an IAM ID token accepted by the Kubernetes API of the clusters, and prints it as an ExecCredential.
+// The client runs the helper again when the token expires, so that the credentials never go stale.
+func RunExecCredentialHelper(args []string) int {
+	return runExecCredentialHelper(args, os.Stdout, os.Stderr)
+}
+
+func runExecCredentialHelper(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet(ExecCredentialCommand, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	iamEndpoint := flags.String("iam-endpoint", "", "The IAM endpoint, https://iam.cloud.ibm.com by default")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	credential, err := execCredentialToken(*iamEndpoint)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error getting the IAM token of the cluster: %s\n", err)
+		return 1
+	}
+	if err = json.NewEncoder(stdout).Encode(credential); err != nil {
+		fmt.Fprintf(stderr, "Error writing the credential: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+func execCredentialToken(iamEndpoint string) (*execCredential, error) {
+	apiKey := os.Getenv("IC_API_KEY")
+	if apiKey == "" {
+		apiKey = os.Getenv("IBMCLOUD_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("the IC_API_KEY or IBMCLOUD_API_KEY environment variable must be set")
+	}
+	if iamEndpoint == "" {
+		iamEndpoint = os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
+	}
+	if iamEndpoint == "" {
+		iamEndpoint = execCredentialIAMURL
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ibm:params:oauth:grant-type:apikey")
+	form.Set("apikey", apiKey)
+	form.Set("response_type", "cloud_iam id_token")
+	request, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(iamEndpoint, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	// the kube client of IAM issues the ID tokens trusted by the clusters
+	request.SetBasicAuth("kube", "kube")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("IAM returned %s: %s", response.Status, body)
+	}
+
+	var token struct {
+		IDToken    string `json:"id_token"`
+		Expiration int64  `json:"expiration"`
+	}
+	if err = json.Unmarshal(body, &token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("IAM returned no ID token")
+	}
+	credential := &execCredential{
+		APIVersion: execCredentialAPIVersion,
+		Kind:       "ExecCredential",
+		Status: execCredentialStatus{
+			Token: token.IDToken,
+		},
+	}
+	if token.Expiration > 0 {
+		credential.Status.ExpirationTimestamp = time.Unix(token.Expiration, 0).UTC().Format(time.RFC3339)
+	}
+	return credential, nil
+}
+
+// execCredentialCommand returns the command, the arguments and the environment running the token helper for
+// the provider configuration of meta. The helper gets the IAM endpoint of the provider configuration, which
+// honors its visibility and endpoints file, and its API key. The command defaults to the provider binary.
+func execCredentialCommand(command string, meta interface{}) (string, []string, map[string]string, error) {
+	if command == "" {
+		executable, err := os.Executable()
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("[ERROR] Error getting the path of the provider binary: %s", err)
+		}
+		command = executable
+	}
+
+	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	args := []string{ExecCredentialCommand, "--iam-endpoint", iamClient.Service.GetServiceURL()}
+
+	env := map[string]string{}
+	bmxSess, err := meta.(conns.ClientSession).BluemixSession()
+	if err == nil && bmxSess.Config.BluemixAPIKey != "" {
+		env["IC_API_KEY"] = bmxSess.Config.BluemixAPIKey
+	}
+	return command, args, env, nil
+}
+
+// writeExecCredentialKubeconfig replaces the static token and the OIDC auth provider of the users of the
+// kubeconfig at path with the token helper of the provider.
+func writeExecCredentialKubeconfig(path, command string, args []string, env map[string]string) error {
+	config, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the cluster config %s: %s", path, err)
+	}
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	execEnv := make([]clientcmdapi.ExecEnvVar, 0, len(names))
+	for _, name := range names {
+		execEnv = append(execEnv, clientcmdapi.ExecEnvVar{Name: name, Value: env[name]})
+	}
+	for _, authInfo := range config.AuthInfos {
+		// admin configs authenticate with a client certificate, which does not expire mid-apply
+		if authInfo.ClientCertificate != "" || len(authInfo.ClientCertificateData) > 0 {
+			continue
+		}
+		authInfo.Token = ""
+		authInfo.TokenFile = ""
+		authInfo.AuthProvider = nil
+		authInfo.Exec = &clientcmdapi.ExecConfig{
+			APIVersion:      execCredentialAPIVersion,
+			Command:         command,
+			Args:            args,
+			Env:             execEnv,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+	}
+	if err = clientcmd.WriteToFile(*config, path); err != nil {
+		return fmt.Errorf("[ERROR] Error writing the cluster config %s: %s", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestRunExecCredentialHelper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		assert.Equal(t, "/identity/token", r.URL.Path)
+		assert.Equal(t, "kube", user)
+		assert.Equal(t, "kube", password)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("apikey"))
+		w.Write([]byte(`{"id_token": "token", "expiration": 1700000000}`))
+	}))
+	defer server.Close()
+	t.Setenv("IC_API_KEY", "secret")
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, runExecCredentialHelper([]string{"--iam-endpoint", server.URL}, &stdout, &stderr), stderr.String())
+	var credential execCredential
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &credential))
+	assert.Equal(t, execCredentialAPIVersion, credential.APIVersion)
+	assert.Equal(t, "ExecCredential", credential.Kind)
+	assert.Equal(t, "token", credential.Status.Token)
+	assert.Equal(t, "2023-11-14T22:13:20Z", credential.Status.ExpirationTimestamp)
+
+	t.Setenv("IC_API_KEY", "")
+	t.Setenv("IBMCLOUD_API_KEY", "")
+	stdout.Reset()
+	assert.Equal(t, 1, runExecCredentialHelper([]string{"--iam-endpoint", server.URL}, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+}
+
+func TestWriteExecCredentialKubeconfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	config := clientcmdapi.NewConfig()
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "static"}
+	config.AuthInfos["admin"] = &clientcmdapi.AuthInfo{ClientCertificate: "admin.pem", ClientKey: "admin-key.pem"}
+	assert.NoError(t, clientcmd.WriteToFile(*config, path))
+
+	args := []string{ExecCredentialCommand, "--iam-endpoint", "https://iam.cloud.ibm.com"}
+	assert.NoError(t, writeExecCredentialKubeconfig(path, "terraform-provider-ibm", args, map[string]string{"IC_API_KEY": "secret"}))
+
+	config, err := clientcmd.LoadFromFile(path)
+	assert.NoError(t, err)
+	user := config.AuthInfos["user"]
+	assert.Empty(t, user.Token)
+	if assert.NotNil(t, user.Exec) {
+		assert.Equal(t, "terraform-provider-ibm", user.Exec.Command)
+		assert.Equal(t, args, user.Exec.Args)
+		assert.Equal(t, []clientcmdapi.ExecEnvVar{{Name: "IC_API_KEY", Value: "secret"}}, user.Exec.Env)
+	}
+	admin := config.AuthInfos["admin"]
+	assert.Nil(t, admin.Exec)
+	assert.Equal(t, "admin.pem", admin.ClientCertificate)
+}
@@ -0,0 +1,440 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// clusterAutoscalerConfigMap is the ConfigMap of the cluster-autoscaler add-on, in the kube-system namespace
+	clusterAutoscalerConfigMap = "iks-ca-configmap"
+	clusterAutoscalerNamespace = "kube-system"
+	clusterAutoscalerPoolsKey  = "workerPoolsConfig.json"
+)
+
+// clusterAutoscalerParameters maps the scale-down arguments to their key in the ConfigMap of the add-on.
+var clusterAutoscalerParameters = map[string]string{
+	"scale_down_enabled":               "scaleDownEnabled",
+	"scale_down_unneeded_time":         "scaleDownUnneededTime",
+	"scale_down_delay_after_add":       "scaleDownDelayAfterAdd",
+	"scale_down_delay_after_delete":    "scaleDownDelayAfterDelete",
+	"scale_down_utilization_threshold": "scaleDownUtilizationThreshold",
+	"expander":                         "expander",
+}
+
+// clusterAutoscalerPool is one worker pool of the workerPoolsConfig.json key of the ConfigMap.
+type clusterAutoscalerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func ResourceIBMContainerClusterAutoscaler() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerClusterAutoscalerCreate,
+		Read:     resourceIBMContainerClusterAutoscalerRead,
+		Update:   resourceIBMContainerClusterAutoscalerUpdate,
+		Delete:   resourceIBMContainerClusterAutoscalerDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster Name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_cluster_autoscaler",
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the resource group.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "link", "vpe"}, false),
+				Description:  "The type of the cluster service endpoint used to reach the add-on configuration: private, link or vpe. The public service endpoint is used by default",
+			},
+			"worker_pool": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The worker pools scaled by the cluster autoscaler",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the worker pool",
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The minimum number of worker nodes per zone of the worker pool",
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of worker nodes per zone of the worker pool",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the worker pool is scaled by the cluster autoscaler",
+						},
+					},
+				},
+			},
+			"scale_down_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the cluster autoscaler removes worker nodes",
+			},
+			"scale_down_unneeded_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long a worker node must be unneeded before it is removed, for example 10m",
+			},
+			"scale_down_delay_after_add": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long after a worker node is added the scale down evaluation resumes, for example 10m",
+			},
+			"scale_down_delay_after_delete": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long after a worker node is removed the scale down evaluation resumes, for example 10s",
+			},
+			"scale_down_utilization_threshold": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The utilization of the requested resources of a worker node below which it is considered for removal, for example 0.5",
+			},
+			"expander": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"random", "most-pods", "least-waste", "priority"}, false),
+				Description:  "How the worker pool to scale up is selected: random, most-pods, least-waste or priority",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerClusterAutoscalerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerClusterAutoscalerValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_autoscaler", Schema: validateSchema}
+	return &iBMContainerClusterAutoscalerValidator
+}
+
+func resourceIBMContainerClusterAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	d.SetId(cluster)
+	if err := resourceIBMContainerClusterAutoscalerApply(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
+	clientset, err := clusterAutoscalerKubeClient(d, meta, d.Id())
+	if err != nil {
+		return err
+	}
+	configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[WARN] The cluster-autoscaler add-on of cluster %s is not installed, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the cluster-autoscaler configuration of cluster %s: %s", d.Id(), err)
+	}
+
+	pools := []clusterAutoscalerPool{}
+	if poolsConfig, ok := configMap.Data[clusterAutoscalerPoolsKey]; ok && poolsConfig != "" {
+		if err = json.Unmarshal([]byte(poolsConfig), &pools); err != nil {
+			return fmt.Errorf("[ERROR] Error decoding %s of the cluster-autoscaler configuration: %s", clusterAutoscalerPoolsKey, err)
+		}
+	}
+
+	// only the worker pools managed by the resource are tracked, or the enabled ones on import
+	managed := map[string]bool{}
+	for _, p := range d.Get("worker_pool").(*schema.Set).List() {
+		managed[p.(map[string]interface{})["name"].(string)] = true
+	}
+	workerPools := []map[string]interface{}{}
+	for _, pool := range pools {
+		if managed[pool.Name] || (len(managed) == 0 && pool.Enabled) {
+			workerPools = append(workerPools, map[string]interface{}{
+				"name":     pool.Name,
+				"min_size": pool.MinSize,
+				"max_size": pool.MaxSize,
+				"enabled":  pool.Enabled,
+			})
+		}
+	}
+
+	d.Set("cluster", d.Id())
+	if err = d.Set("worker_pool", workerPools); err != nil {
+		return fmt.Errorf("[ERROR] Error setting worker_pool: %s", err)
+	}
+	for arg, key := range clusterAutoscalerParameters {
+		value, ok := configMap.Data[key]
+		if !ok {
+			continue
+		}
+		if arg == "scale_down_enabled" {
+			d.Set(arg, value == "true")
+		} else {
+			d.Set(arg, value)
+		}
+	}
+	return nil
+}
+
+func resourceIBMContainerClusterAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceIBMContainerClusterAutoscalerApply(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+// resourceIBMContainerClusterAutoscalerDelete disables the autoscaling of the managed worker pools, the
+// add-on and its scale-down parameters are left in place.
+func resourceIBMContainerClusterAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
+	clientset, err := clusterAutoscalerKubeClient(d, meta, d.Id())
+	if err != nil {
+		return err
+	}
+	disabled := map[string]clusterAutoscalerPool{}
+	for _, p := range d.Get("worker_pool").(*schema.Set).List() {
+		pool := expandClusterAutoscalerPool(p.(map[string]interface{}))
+		pool.Enabled = false
+		disabled[pool.Name] = pool
+	}
+	err = updateClusterAutoscalerConfigMap(clientset, disabled, map[string]string{}, nil)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("[ERROR] Error disabling the cluster-autoscaler of cluster %s: %s", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMContainerClusterAutoscalerApply(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	cluster := d.Id()
+	pools := map[string]clusterAutoscalerPool{}
+	for _, p := range d.Get("worker_pool").(*schema.Set).List() {
+		pool := expandClusterAutoscalerPool(p.(map[string]interface{}))
+		if pool.MinSize > pool.MaxSize {
+			return fmt.Errorf("[ERROR] min_size %d of worker pool %s is greater than its max_size %d", pool.MinSize, pool.Name, pool.MaxSize)
+		}
+		pools[pool.Name] = pool
+	}
+	if err := checkClusterAutoscalerWorkerPools(d, meta, cluster, pools); err != nil {
+		return err
+	}
+
+	// the parameters that are not configured keep the value set by the add-on
+	parameters := map[string]string{}
+	rawConfig := d.GetRawConfig()
+	for arg, key := range clusterAutoscalerParameters {
+		if v := rawConfig.GetAttr(arg); v.IsNull() || !v.IsKnown() || !d.HasChange(arg) {
+			continue
+		}
+		parameters[key] = fmt.Sprintf("%v", d.Get(arg))
+	}
+
+	// worker pools removed from the configuration are no longer scaled
+	removed := []string{}
+	if d.HasChange("worker_pool") {
+		o, _ := d.GetChange("worker_pool")
+		for _, p := range o.(*schema.Set).List() {
+			name := p.(map[string]interface{})["name"].(string)
+			if _, ok := pools[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	clientset, err := clusterAutoscalerKubeClient(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	// the ConfigMap is created by the add-on shortly after it is enabled
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := updateClusterAutoscalerConfigMap(clientset, pools, parameters, removed)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return resource.RetryableError(fmt.Errorf("[ERROR] The cluster-autoscaler add-on of cluster %s is not installed, enable it with ibm_container_addons: %s", cluster, err))
+			}
+			if apierrors.IsConflict(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error updating the cluster-autoscaler configuration of cluster %s: %s", cluster, err))
+		}
+		return nil
+	})
+}
+
+// updateClusterAutoscalerConfigMap merges the worker pools and the parameters into the ConfigMap of the
+// add-on, the worker pools that are not managed by the resource are kept unchanged.
+func updateClusterAutoscalerConfigMap(clientset *kubernetes.Clientset, pools map[string]clusterAutoscalerPool, parameters map[string]string, removed []string) error {
+	configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	existing := []clusterAutoscalerPool{}
+	if poolsConfig, ok := configMap.Data[clusterAutoscalerPoolsKey]; ok && poolsConfig != "" {
+		if err = json.Unmarshal([]byte(poolsConfig), &existing); err != nil {
+			return fmt.Errorf("decoding %s: %s", clusterAutoscalerPoolsKey, err)
+		}
+	}
+	merged := map[string]clusterAutoscalerPool{}
+	for _, pool := range existing {
+		merged[pool.Name] = pool
+	}
+	for _, name := range removed {
+		if pool, ok := merged[name]; ok {
+			pool.Enabled = false
+			merged[name] = pool
+		}
+	}
+	for name, pool := range pools {
+		merged[name] = pool
+	}
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	updated := make([]clusterAutoscalerPool, 0, len(names))
+	for _, name := range names {
+		updated = append(updated, merged[name])
+	}
+	poolsConfig, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[clusterAutoscalerPoolsKey] = string(poolsConfig)
+	for key, value := range parameters {
+		configMap.Data[key] = value
+	}
+	_, err = clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+func expandClusterAutoscalerPool(p map[string]interface{}) clusterAutoscalerPool {
+	return clusterAutoscalerPool{
+		Name:    p["name"].(string),
+		MinSize: p["min_size"].(int),
+		MaxSize: p["max_size"].(int),
+		Enabled: p["enabled"].(bool),
+	}
+}
+
+func checkClusterAutoscalerWorkerPools(d *schema.ResourceData, meta interface{}, cluster string, pools map[string]clusterAutoscalerPool) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	workerPools, err := csClient.WorkerPools().ListWorkerPools(cluster, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pools of cluster %s: %s", cluster, err)
+	}
+	existing := map[string]bool{}
+	for _, workerPool := range workerPools {
+		existing[workerPool.PoolName] = true
+	}
+	for name := range pools {
+		if !existing[name] {
+			return fmt.Errorf("[ERROR] Worker pool %s does not exist in cluster %s", name, cluster)
+		}
+	}
+	return nil
+}
+
+// clusterAutoscalerKubeClient returns a client of the cluster built from its admin configuration, which is
+// downloaded from the IKS API to a temporary directory.
+func clusterAutoscalerKubeClient(d *schema.ResourceData, meta interface{}, cluster string) (*kubernetes.Clientset, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	configDir, err := os.MkdirTemp("", "ibm-cluster-autoscaler-")
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the directory of the cluster config: %s", err)
+	}
+	defer os.RemoveAll(configDir)
+
+	clusterKeyDetails, err := csClient.Clusters().GetClusterConfigDetail(cluster, configDir, true, targetEnv, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", cluster, err)
+	}
+	config, err := clientcmd.BuildConfigFromFlags("", clusterKeyDetails.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig of cluster %s: %s", cluster, err)
+	}
+	return kubernetes.NewForConfig(config)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterAutoscaler_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-ca-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(name, 1, 2, "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "scale_down_unneeded_time", "10m"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(name, 1, 3, "20m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "scale_down_unneeded_time", "20m"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_cluster_autoscaler.autoscaler",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterAutoscalerBasic(name string, minSize, maxSize int, unneededTime string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_addons" "addons" {
+		cluster = ibm_container_vpc_cluster.cluster.id
+		addons {
+			name = "cluster-autoscaler"
+		}
+	}
+	resource "ibm_container_cluster_autoscaler" "autoscaler" {
+		cluster                  = ibm_container_addons.addons.cluster
+		scale_down_unneeded_time = "%[4]s"
+		worker_pool {
+			name     = "default"
+			min_size = %[2]d
+			max_size = %[3]d
+		}
+	}`, name, minSize, maxSize, unneededTime)
+}
@@ -96,6 +96,42 @@ func ResourceIBMContainerDedicatedHostPool() *schema.Resource {
 								},
 							},
 						},
+						"consumed": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resources of the zone that are consumed by workers",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"memory_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"vcpu": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"available": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resources of the zone that are still available for workers",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"memory_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"vcpu": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"host_count": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -153,14 +189,12 @@ func resourceIBMContainerDedicatedHostPoolCreate(ctx context.Context, d *schema.
 
 	d.SetId(res.ID)
 
-	dhp, err := waitForDedicatedHostPoolAvailable(ctx, dedicatedHostPoolAPI, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = waitForDedicatedHostPoolAvailable(ctx, dedicatedHostPoolAPI, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
 		return diag.Errorf("[ERROR] waitForDedicatedHostPoolAvailable failed: %v", err)
 	}
 
-	setDedicatedHostPoolFields(dhp.(v2.GetDedicatedHostPoolResponse), d)
-
-	return nil
+	return resourceIBMContainerDedicatedHostPoolRead(ctx, d, meta)
 }
 
 func resourceIBMContainerDedicatedHostPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -190,12 +224,18 @@ func getIBMContainerDedicatedHostPool(hostPoolID string, d *schema.ResourceData,
 		return err
 	}
 
-	setDedicatedHostPoolFields(dedicatedHostPool, d)
+	// the consumed capacity is only reported per host
+	dedicatedHosts, err := client.DedicatedHost().ListDedicatedHosts(hostPoolID, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	setDedicatedHostPoolFields(dedicatedHostPool, dedicatedHosts, d)
 
 	return nil
 }
 
-func setDedicatedHostPoolFields(dedicatedHostPool v2.GetDedicatedHostPoolResponse, d *schema.ResourceData) {
+func setDedicatedHostPoolFields(dedicatedHostPool v2.GetDedicatedHostPoolResponse, dedicatedHosts []v2.GetDedicatedHostResponse, d *schema.ResourceData) {
 	d.Set("name", dedicatedHostPool.Name)
 	d.Set("metro", dedicatedHostPool.Metro)
 	d.Set("flavor_class", dedicatedHostPool.FlavorClass)
@@ -204,11 +244,27 @@ func setDedicatedHostPoolFields(dedicatedHostPool v2.GetDedicatedHostPoolRespons
 
 	zones := make([]map[string]interface{}, len(dedicatedHostPool.Zones))
 	for i, zone := range dedicatedHostPool.Zones {
+		consumed := zone.Capacity
+		consumed.MemoryBytes, consumed.VCPU = 0, 0
+		for _, dedicatedHost := range dedicatedHosts {
+			if dedicatedHost.Zone == zone.Zone {
+				consumed.MemoryBytes += dedicatedHost.Resources.Consumed.MemoryBytes
+				consumed.VCPU += dedicatedHost.Resources.Consumed.VCPU
+			}
+		}
 		zones[i] = map[string]interface{}{
 			"capacity": []interface{}{map[string]interface{}{
 				"memory_bytes": zone.Capacity.MemoryBytes,
 				"vcpu":         zone.Capacity.VCPU,
 			}},
+			"consumed": []interface{}{map[string]interface{}{
+				"memory_bytes": consumed.MemoryBytes,
+				"vcpu":         consumed.VCPU,
+			}},
+			"available": []interface{}{map[string]interface{}{
+				"memory_bytes": zone.Capacity.MemoryBytes - consumed.MemoryBytes,
+				"vcpu":         zone.Capacity.VCPU - consumed.VCPU,
+			}},
 			"host_count": zone.HostCount,
 			"zone":       zone.Zone,
 		}
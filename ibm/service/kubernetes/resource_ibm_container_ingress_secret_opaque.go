@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,7 +14,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
@@ -24,6 +27,9 @@ func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
 		Delete:   resourceIBMContainerIngressSecretOpaqueDelete,
 		Exists:   resourceIBMContainerIngressSecretOpaqueExists,
 		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMContainerIngressSecretOpaqueRegenerateDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -81,6 +87,13 @@ func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
 				Optional:    true,
 				Description: "Updates secret from secrets manager if value is changed (increment each usage)",
 			},
+			"regenerate_before_expiry_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the certificate expires from which the secret is regenerated from secrets manager by the next apply, so that certificates rotated in secrets manager reach the cluster. 0 disables the automatic regeneration",
+			},
 			"last_updated_timestamp": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -366,8 +379,9 @@ func resourceIBMContainerIngressSecretOpaqueUpdate(d *schema.ResourceData, meta
 				return err
 			}
 		}
-	} else if d.HasChange("update_secret") {
-		// user wants to force an upstream secret update from secrets manager onto kube cluster w/out changing crn
+	} else if d.HasChange("update_secret") || d.HasChange("last_updated_timestamp") {
+		// user wants to force an upstream secret update from secrets manager onto kube cluster w/out changing crn,
+		// or the certificate is about to expire and regenerate_before_expiry_days is set
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
 			return err
@@ -404,3 +418,24 @@ func resourceIBMContainerIngressSecretOpaqueExists(d *schema.ResourceData, meta
 
 	return ingressSecretConfig.Name == secretName && ingressSecretConfig.Namespace == secretNamespace && ingressSecretConfig.Status != "deleted", nil
 }
+
+// resourceIBMContainerIngressSecretOpaqueRegenerateDiff plans a regeneration of the secret from secrets
+// manager when the secret of any field expires within regenerate_before_expiry_days.
+func resourceIBMContainerIngressSecretOpaqueRegenerateDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange("fields") {
+		return nil
+	}
+	days := diff.Get("regenerate_before_expiry_days").(int)
+	lastUpdated := diff.Get("last_updated_timestamp").(string)
+	for _, field := range diff.Get("fields").(*schema.Set).List() {
+		expiresOn, _ := field.(map[string]interface{})["expires_on"].(string)
+		regenerate, err := ingressSecretRegenerateDue(expiresOn, lastUpdated, days, time.Now())
+		if err != nil {
+			return err
+		}
+		if regenerate {
+			return diff.SetNewComputed("last_updated_timestamp")
+		}
+	}
+	return nil
+}
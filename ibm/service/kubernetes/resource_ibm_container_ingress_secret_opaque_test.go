@@ -71,7 +71,7 @@ func TestAccIBMContainerIngressSecretOpaque_Basic(t *testing.T) {
 				ResourceName:            "ibm_container_ingress_secret_opaque.secret",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret"},
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret", "regenerate_before_expiry_days"},
 			},
 		},
 	})
@@ -188,7 +188,7 @@ func TestAccIBMContainerIngressSecretOpaque_ForceUpdate(t *testing.T) {
 				ResourceName:            "ibm_container_ingress_secret_opaque.secret",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret"},
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret", "regenerate_before_expiry_days"},
 			},
 		},
 	})
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressSecretRegenerateDue(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	// expires in 10 days, so a 30 days window started 20 days ago
+	expiresOn := "2024-06-30T12:00:00Z"
+
+	testcases := []struct {
+		name        string
+		expiresOn   string
+		lastUpdated string
+		days        int
+		expected    bool
+		expectError bool
+	}{
+		{name: "disabled", expiresOn: expiresOn, lastUpdated: "2024-01-01T00:00:00Z", days: 0},
+		{name: "no expiration date", lastUpdated: "2024-01-01T00:00:00Z", days: 30},
+		{name: "outside the window", expiresOn: expiresOn, lastUpdated: "2024-01-01T00:00:00Z", days: 5},
+		{name: "inside the window", expiresOn: expiresOn, lastUpdated: "2024-01-01T00:00:00Z", days: 30, expected: true},
+		{name: "inside the window never updated", expiresOn: expiresOn, days: 30, expected: true},
+		{name: "already regenerated in the window", expiresOn: expiresOn, lastUpdated: "2024-06-15T08:30:00.123Z", days: 30},
+		{name: "invalid expiration date", expiresOn: "30/06/2024", lastUpdated: "2024-01-01T00:00:00Z", days: 30, expectError: true},
+		{name: "invalid last updated timestamp", expiresOn: expiresOn, lastUpdated: "yesterday", days: 30, expectError: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			regenerate, err := ingressSecretRegenerateDue(tc.expiresOn, tc.lastUpdated, tc.days, now)
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, regenerate)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
@@ -23,6 +26,9 @@ func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
 		Delete:   resourceIBMContainerIngressSecretTLSDelete,
 		Exists:   resourceIBMContainerIngressSecretTLSExists,
 		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMContainerIngressSecretTLSRegenerateDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -100,6 +106,13 @@ func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
 				Optional:    true,
 				Description: "Updates secret from secrets manager if value is changed (increment each usage)",
 			},
+			"regenerate_before_expiry_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the certificate expires from which the secret is regenerated from secrets manager by the next apply, so that certificates rotated in secrets manager reach the cluster. 0 disables the automatic regeneration",
+			},
 		},
 	}
 }
@@ -254,8 +267,9 @@ func resourceIBMContainerIngressSecretTLSUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return err
 		}
-	} else if d.HasChange("update_secret") {
-		// user wants to force an upstream secret update from secrets manager onto kube cluster w/out changing crn
+	} else if d.HasChange("update_secret") || d.HasChange("last_updated_timestamp") {
+		// user wants to force an upstream secret update from secrets manager onto kube cluster w/out changing crn,
+		// or the certificate is about to expire and regenerate_before_expiry_days is set
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
 			return err
@@ -293,3 +307,42 @@ func resourceIBMContainerIngressSecretTLSExists(d *schema.ResourceData, meta int
 
 	return ingressSecretConfig.Name == secretName && ingressSecretConfig.Namespace == secretNamespace && ingressSecretConfig.Status != "deleted", nil
 }
+
+// resourceIBMContainerIngressSecretTLSRegenerateDiff plans a regeneration of the secret from secrets manager
+// when the certificate expires within regenerate_before_expiry_days.
+func resourceIBMContainerIngressSecretTLSRegenerateDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	regenerate, err := ingressSecretRegenerateDue(diff.Get("expires_on").(string), diff.Get("last_updated_timestamp").(string), diff.Get("regenerate_before_expiry_days").(int), time.Now())
+	if err != nil || !regenerate {
+		return err
+	}
+	return diff.SetNewComputed("last_updated_timestamp")
+}
+
+// ingressSecretRegenerateDue reports whether the secret has to be regenerated, which is the case once the
+// expiration date is less than the given number of days away and the secret was not regenerated since.
+// A secret is regenerated once per renewal window, so that a certificate that is not yet rotated in
+// secrets manager does not cause a diff on every plan.
+func ingressSecretRegenerateDue(expiresOn, lastUpdated string, days int, now time.Time) (bool, error) {
+	if days <= 0 || expiresOn == "" {
+		return false, nil
+	}
+	expires, err := time.Parse(time.RFC3339, expiresOn)
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error parsing the expiration date %s of the ingress secret: %s", expiresOn, err)
+	}
+	renewFrom := expires.Add(-time.Duration(days) * 24 * time.Hour)
+	if now.Before(renewFrom) {
+		return false, nil
+	}
+	if lastUpdated == "" {
+		return true, nil
+	}
+	updated, err := time.Parse(time.RFC3339, lastUpdated)
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error parsing the last updated timestamp %s of the ingress secret: %s", lastUpdated, err)
+	}
+	return updated.Before(renewFrom), nil
+}
@@ -65,7 +65,7 @@ func TestAccIBMContainerIngressSecretTLS_Basic(t *testing.T) {
 				ResourceName:            "ibm_container_ingress_secret_tls.secret",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret"},
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret", "regenerate_before_expiry_days"},
 			},
 		},
 	})
@@ -170,7 +170,7 @@ func TestAccIBMContainerIngressSecretTLS_BasicForceUpdate(t *testing.T) {
 				ResourceName:            "ibm_container_ingress_secret_tls.secret",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret"},
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret", "regenerate_before_expiry_days"},
 			},
 		},
 	})
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -111,10 +112,22 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 							Default:     false,
 							Description: "Forces terraform to wait till the changes take effect, not marking the cluster complete till",
 						},
+						"rotation_version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Increase this value to re-apply the KMS configuration in place, so that the master picks up the latest version of the root key",
+						},
 					},
 				},
 			},
 
+			"kms_active_key_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the root key currently used to encrypt the cluster secrets",
+			},
+
 			"zones": {
 				Type:             schema.TypeSet,
 				Required:         true,
@@ -723,6 +736,16 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if d.HasChange("kms_config") {
+		oldKms, _ := d.GetChange("kms_config")
+		isRotation := len(oldKms.([]interface{})) > 0
+		var masterBefore v2.LifeCycleInfo
+		if isRotation {
+			cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+			}
+			masterBefore = cluster.Lifecycle
+		}
 		kmsConfig := v2.KmsEnableReq{}
 		kmsConfig.Cluster = clusterID
 		targetEnv := v2.ClusterHeader{}
@@ -764,9 +787,20 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 					"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
 				return err
 			}
-			if waitForApply {
+			if isRotation {
+				// The master is already KMS enabled, wait for it to be redeployed with the new key binding
+				_, err = waitForVpcClusterMasterKMSRotation(d, meta, masterBefore)
+				if err != nil {
+					return fmt.Errorf("[ERROR] Error waiting for KMS rotation of cluster (%s) to complete: %s", d.Id(), err)
+				}
+			} else if waitForApply {
 				waitForVpcClusterMasterKMSApply(d, meta)
 			}
+			keyCRN, err := getVpcClusterKMSKeyCRN(meta, kmsConfig.Kms, kmsConfig.Crk)
+			if err != nil {
+				return err
+			}
+			d.Set("kms_active_key_crn", keyCRN)
 		}
 	}
 
@@ -1011,6 +1045,11 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 		d.Set("disable_public_service_endpoint", true)
 	}
 	d.Set("image_security_enforcement", cls.ImageSecurityEnabled)
+	// The cluster only reports whether KMS is enabled, the active key is recorded when it is applied
+	if !cls.Features.KeyProtectEnabled && cls.Lifecycle.MasterState == masterDeployed {
+		d.Set("kms_config", nil)
+		d.Set("kms_active_key_crn", "")
+	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, cls.CRN)
 	if err != nil {
@@ -1313,6 +1352,88 @@ func waitForVpcClusterMasterKMSApply(d *schema.ResourceData, meta interface{}) (
 	return createStateConf.WaitForState()
 }
 
+// waitForVpcClusterMasterKMSRotation waits for the master to be redeployed after the
+// KMS configuration of a KMS enabled cluster is re-applied. The master is still deployed
+// right after the request, so it first waits for the master to leave the lifecycle
+// masterBefore read before the request, then for the master to be deployed again.
+func waitForVpcClusterMasterKMSRotation(d *schema.ResourceData, meta interface{}, masterBefore v2.LifeCycleInfo) (interface{}, error) {
+	log.Printf("[DEBUG] Wait for KMS rotation to apply to master")
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	clusterID := d.Id()
+	startStateConf := &resource.StateChangeConf{
+		Pending: []string{masterDeployed},
+		Target:  []string{deployRequested},
+		Refresh: func() (interface{}, string, error) {
+			clusterInfo, clusterInfoErr := csClient.Clusters().GetCluster(clusterID, targetEnv)
+			if clusterInfoErr != nil {
+				return clusterInfo, masterDeployed, clusterInfoErr
+			}
+
+			lifecycle := clusterInfo.Lifecycle
+			if lifecycle.MasterState != masterDeployed ||
+				lifecycle.MasterStatus != masterBefore.MasterStatus ||
+				lifecycle.MasterStatusModifiedDate != masterBefore.MasterStatusModifiedDate {
+				log.Printf("[DEBUG] KMS rotation started on master, master status: %s", lifecycle.MasterStatus)
+				return clusterInfo, deployRequested, nil
+			}
+			log.Printf("[DEBUG] Waiting for KMS rotation to start on master")
+			return clusterInfo, masterDeployed, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err = startStateConf.WaitForState(); err != nil {
+		return nil, err
+	}
+
+	rotationStateConf := &resource.StateChangeConf{
+		Pending: []string{deployRequested, deployInProgress},
+		Target:  []string{ready},
+		Refresh: func() (interface{}, string, error) {
+			clusterInfo, clusterInfoErr := csClient.Clusters().GetCluster(clusterID, targetEnv)
+			if clusterInfoErr != nil {
+				return clusterInfo, deployInProgress, clusterInfoErr
+			}
+
+			if clusterInfo.Lifecycle.MasterStatus == ready &&
+				clusterInfo.Lifecycle.MasterState == masterDeployed {
+				log.Printf("[DEBUG] KMS rotation applied to master")
+				return clusterInfo, ready, nil
+			}
+			log.Printf("[DEBUG] Waiting for KMS rotation to apply to master, master status: %s", clusterInfo.Lifecycle.MasterStatus)
+			return clusterInfo, deployInProgress, nil
+		},
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return rotationStateConf.WaitForState()
+}
+
+// getVpcClusterKMSKeyCRN returns the CRN of the root key crkID in the KMS instance instanceID.
+func getVpcClusterKMSKeyCRN(meta interface{}, instanceID, crkID string) (string, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return "", err
+	}
+	instance, response, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving KMS instance %s: %s with resp code: %s", instanceID, err, response)
+	}
+	return strings.TrimSuffix(*instance.CRN, "::") + ":key:" + crkID, nil
+}
+
 func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
@@ -836,6 +836,41 @@ func testAccCheckIBMContainerVpcClusterBaseEnvvar(name string) string {
 	return config
 }
 
+func TestAccIBMContainerVpcClusterKMSRotationEnvvar(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterKMSRotationEnvvar(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kms_config.0.rotation_version", "0"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_vpc_cluster.cluster", "kms_active_key_crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterKMSRotationEnvvar(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kms_config.0.rotation_version", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "master_status", "Ready"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_vpc_cluster.cluster", "kms_active_key_crn"),
+				),
+			},
+		},
+	})
+}
+
 // You need to set up env vars:
 // export IBM_CLUSTER_VPC_ID
 // export IBM_CLUSTER_VPC_SUBNET_ID
@@ -865,3 +900,33 @@ func testAccCheckIBMContainerVpcClusterKMSEnvvar(name string) string {
 	fmt.Println(config)
 	return config
 }
+
+// You need to set up env vars:
+// export IBM_CLUSTER_VPC_ID
+// export IBM_CLUSTER_VPC_SUBNET_ID
+// export IBM_CLUSTER_VPC_RESOURCE_GROUP_ID
+// export IBM_KMS_INSTANCE_ID
+// export IBM_CRK_ID
+func testAccCheckIBMContainerVpcClusterKMSRotationEnvvar(name string, rotationVersion int) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = "%[2]s"
+		flavor            = "bx2.4x16"
+		worker_count      = 1
+		resource_group_id = "%[3]s"
+		zones {
+			subnet_id = "%[4]s"
+			name      = "us-south-1"
+		}
+		wait_till = "normal"
+		kms_config {
+			instance_id      = "%[5]s"
+			crk_id           = "%[6]s"
+			private_endpoint = false
+			wait_for_apply   = true
+			rotation_version = %[7]d
+		}
+	}
+	`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, acc.KmsInstanceID, acc.CrkID, rotationVersion)
+}
@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// The monitoring API expresses alert timespans in microseconds.
+const microsecondsPerSecond = 1000000
+
+// Alert is the alert model of the monitoring API.
+type Alert struct {
+	ID                     *int64                 `json:"id,omitempty"`
+	Version                *int64                 `json:"version,omitempty"`
+	Type                   string                 `json:"type"`
+	Name                   string                 `json:"name"`
+	Description            string                 `json:"description,omitempty"`
+	Enabled                bool                   `json:"enabled"`
+	Severity               int64                  `json:"severity"`
+	Timespan               int64                  `json:"timespan"`
+	Condition              string                 `json:"condition"`
+	Filter                 string                 `json:"filter,omitempty"`
+	SegmentBy              []string               `json:"segmentBy,omitempty"`
+	SegmentCondition       *AlertSegmentCondition `json:"segmentCondition,omitempty"`
+	NotificationChannelIds []int64                `json:"notificationChannelIds,omitempty"`
+}
+
+// AlertSegmentCondition controls whether an alert fires when any or all segments match.
+type AlertSegmentCondition struct {
+	Type string `json:"type"`
+}
+
+type alertEnvelope struct {
+	Alert *Alert `json:"alert"`
+}
+
+func ResourceIBMObAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMObAlertCreate,
+		ReadContext:   resourceIBMObAlertRead,
+		UpdateContext: resourceIBMObAlertUpdate,
+		DeleteContext: resourceIBMObAlertDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_ob_alert", "name"),
+				Description:  "The name of the alert.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the alert.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the alert is enabled.",
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validate.InvokeValidator("ibm_ob_alert", "severity"),
+				Description:  "The severity of the alert, from 0 (emergency) to 7 (debug).",
+			},
+			"condition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The metric condition that triggers the alert, for example `avg(avg(cpu.used.percent)) > 80`.",
+			},
+			"timespan": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validate.InvokeValidator("ibm_ob_alert", "timespan"),
+				Description:  "The time in seconds that the condition must be true before the alert fires.",
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The scope filter applied to the metric, for example `kube_cluster_name = \"prod\"`.",
+			},
+			"segment_by": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels used to segment the alert.",
+			},
+			"segment_condition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ANY",
+				ValidateFunc: validate.InvokeValidator("ibm_ob_alert", "segment_condition"),
+				Description:  "Whether the alert fires when any or all of the segments match the condition.",
+			},
+			"notification_channel_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the notification channels notified when the alert fires.",
+			},
+			"alert_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the alert.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the alert, used for optimistic locking.",
+			},
+		},
+	}
+}
+
+func ResourceIBMObAlertValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             1,
+			MaxValueLength:             255,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "severity",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "7",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "timespan",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "60",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "segment_condition",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ANY, ALL",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_ob_alert", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMObAlertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, err := getMonitoringInstanceRegion(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_alert", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get("instance_id").(string)
+	client, err := GetMonitoringClient(meta, instanceID, region, getMonitoringInstanceEndpointType(d, meta))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_alert", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	body := &alertEnvelope{
+		Alert: resourceIBMObAlertFromData(d),
+	}
+	result := &alertEnvelope{}
+	_, err = client.Request(context, core.POST, "/api/alerts", nil, body, result)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateAlert failed: %s", err.Error()), "ibm_ob_alert", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if result.Alert == nil || result.Alert.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateAlert returned no alert"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", region, instanceID, *result.Alert.ID))
+
+	return resourceIBMObAlertRead(context, d, meta)
+}
+
+func resourceIBMObAlertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, region, instanceID, alertID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_alert", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	result := &alertEnvelope{}
+	response, err := client.Request(context, core.GET, "/api/alerts/{id}", map[string]string{"id": alertID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetAlert failed: %s", err.Error()), "ibm_ob_alert", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	alert := result.Alert
+	if alert == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("instance_id", instanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("name", alert.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", alert.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("enabled", alert.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+	if err = d.Set("severity", alert.Severity); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting severity: %s", err))
+	}
+	if err = d.Set("condition", alert.Condition); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting condition: %s", err))
+	}
+	if err = d.Set("timespan", alert.Timespan/microsecondsPerSecond); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting timespan: %s", err))
+	}
+	if err = d.Set("filter", alert.Filter); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting filter: %s", err))
+	}
+	if err = d.Set("segment_by", alert.SegmentBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting segment_by: %s", err))
+	}
+	if alert.SegmentCondition != nil {
+		if err = d.Set("segment_condition", alert.SegmentCondition.Type); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting segment_condition: %s", err))
+		}
+	}
+	channelIDs := make([]int, 0, len(alert.NotificationChannelIds))
+	for _, id := range alert.NotificationChannelIds {
+		channelIDs = append(channelIDs, int(id))
+	}
+	if err = d.Set("notification_channel_ids", channelIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting notification_channel_ids: %s", err))
+	}
+	if err = d.Set("alert_id", flex.IntValue(alert.ID)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting alert_id: %s", err))
+	}
+	if err = d.Set("version", flex.IntValue(alert.Version)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMObAlertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _, alertID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_alert", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if d.HasChange("name") ||
+		d.HasChange("description") ||
+		d.HasChange("enabled") ||
+		d.HasChange("severity") ||
+		d.HasChange("condition") ||
+		d.HasChange("timespan") ||
+		d.HasChange("filter") ||
+		d.HasChange("segment_by") ||
+		d.HasChange("segment_condition") ||
+		d.HasChange("notification_channel_ids") {
+
+		alert := resourceIBMObAlertFromData(d)
+		id, err := strconv.ParseInt(alertID, 10, 64)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid alert ID %s: %s", alertID, err))
+		}
+		alert.ID = &id
+		alert.Version = core.Int64Ptr(int64(d.Get("version").(int)))
+
+		body := &alertEnvelope{
+			Alert: alert,
+		}
+		_, err = client.Request(context, core.PUT, "/api/alerts/{id}", map[string]string{"id": alertID}, body, nil)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateAlert failed: %s", err.Error()), "ibm_ob_alert", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMObAlertRead(context, d, meta)
+}
+
+func resourceIBMObAlertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _, alertID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_alert", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	response, err := client.Request(context, core.DELETE, "/api/alerts/{id}", map[string]string{"id": alertID}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteAlert failed: %s", err.Error()), "ibm_ob_alert", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMObAlertFromData(d *schema.ResourceData) *Alert {
+	alert := &Alert{
+		Type:        "MANUAL",
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Severity:    int64(d.Get("severity").(int)),
+		Timespan:    int64(d.Get("timespan").(int)) * microsecondsPerSecond,
+		Condition:   d.Get("condition").(string),
+		Filter:      d.Get("filter").(string),
+		SegmentCondition: &AlertSegmentCondition{
+			Type: d.Get("segment_condition").(string),
+		},
+	}
+	if segmentBy, ok := d.GetOk("segment_by"); ok {
+		alert.SegmentBy = flex.ExpandStringList(segmentBy.([]interface{}))
+	}
+	if channelIDs, ok := d.GetOk("notification_channel_ids"); ok {
+		for _, id := range channelIDs.(*schema.Set).List() {
+			alert.NotificationChannelIds = append(alert.NotificationChannelIds, int64(id.(int)))
+		}
+	}
+	return alert
+}
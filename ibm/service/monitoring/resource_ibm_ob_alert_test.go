@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/monitoring"
+)
+
+func TestAccIBMObAlertBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-alert-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-alert-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckMonitoring(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMObAlertDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMObAlertConfig(name, 80, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMObAlertExists("ibm_ob_alert.alert"),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "name", name),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "severity", "4"),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "timespan", "600"),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "notification_channel_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_ob_alert.alert", "alert_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMObAlertConfig(nameUpdate, 90, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "severity", "2"),
+					resource.TestCheckResourceAttr("ibm_ob_alert.alert", "condition", "avg(avg(cpu.used.percent)) > 90"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_ob_alert.alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObAlertConfig(name string, threshold, severity int) string {
+	return fmt.Sprintf(`
+	resource "ibm_ob_notification_channel" "channel" {
+		instance_id      = "%[1]s"
+		region           = "%[2]s"
+		name             = "%[3]s-channel"
+		type             = "EMAIL"
+		email_recipients = ["ops@example.com"]
+	}
+
+	resource "ibm_ob_alert" "alert" {
+		instance_id              = "%[1]s"
+		region                   = "%[2]s"
+		name                     = "%[3]s"
+		description              = "CPU usage is high"
+		severity                 = %[5]d
+		condition                = "avg(avg(cpu.used.percent)) > %[4]d"
+		timespan                 = 600
+		segment_by               = ["host.hostName"]
+		notification_channel_ids = [ibm_ob_notification_channel.channel.channel_id]
+	}
+	`, acc.MonitoringInstanceID, acc.MonitoringInstanceRegion, name, threshold, severity)
+}
+
+func testAccCheckIBMObAlertExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := monitoring.GetMonitoringClient(acc.TestAccProvider.Meta(), parts[1], parts[0], "public")
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Request(context.Background(), core.GET, "/api/alerts/{id}", map[string]string{"id": parts[2]}, nil, nil)
+		return err
+	}
+}
+
+func testAccCheckIBMObAlertDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_ob_alert" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := monitoring.GetMonitoringClient(acc.TestAccProvider.Meta(), parts[1], parts[0], "public")
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Request(context.Background(), core.GET, "/api/alerts/{id}", map[string]string{"id": parts[2]}, nil, nil)
+		if err == nil {
+			return fmt.Errorf("ibm_ob_alert still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for ibm_ob_alert (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// NotificationChannel is the notification channel model of the monitoring API.
+type NotificationChannel struct {
+	ID      *int64                      `json:"id,omitempty"`
+	Version *int64                      `json:"version,omitempty"`
+	Type    string                      `json:"type"`
+	Name    string                      `json:"name"`
+	Enabled bool                        `json:"enabled"`
+	Options *NotificationChannelOptions `json:"options,omitempty"`
+}
+
+// NotificationChannelOptions holds the type specific settings of a notification channel.
+type NotificationChannelOptions struct {
+	EmailRecipients      []string `json:"emailRecipients,omitempty"`
+	URL                  string   `json:"url,omitempty"`
+	Channel              string   `json:"channel,omitempty"`
+	NotifyOnOk           bool     `json:"notifyOnOk"`
+	NotifyOnResolve      bool     `json:"notifyOnResolve"`
+	SendTestNotification bool     `json:"sendTestNotification,omitempty"`
+}
+
+type notificationChannelEnvelope struct {
+	NotificationChannel *NotificationChannel `json:"notificationChannel"`
+}
+
+func ResourceIBMObNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMObNotificationChannelCreate,
+		ReadContext:   resourceIBMObNotificationChannelRead,
+		UpdateContext: resourceIBMObNotificationChannelUpdate,
+		DeleteContext: resourceIBMObNotificationChannelDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_ob_notification_channel", "name"),
+				Description:  "The name of the notification channel.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_ob_notification_channel", "type"),
+				Description:  "The type of the notification channel.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the notification channel is enabled.",
+			},
+			"email_recipients": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The email addresses notified by an EMAIL channel.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The URL notified by a WEBHOOK, SLACK or MS_TEAMS channel.",
+			},
+			"channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Slack channel notified by a SLACK channel.",
+			},
+			"notify_on_ok": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Notify the channel when an alert returns to the OK state.",
+			},
+			"notify_on_resolve": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Notify the channel when an alert is resolved manually.",
+			},
+			"send_test_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a test notification when the channel is created or updated.",
+			},
+			"channel_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the notification channel.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the notification channel, used for optimistic locking.",
+			},
+		},
+	}
+}
+
+func ResourceIBMObNotificationChannelValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             1,
+			MaxValueLength:             255,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "EMAIL, SLACK, PAGER_DUTY, WEBHOOK, OPSGENIE, VICTOROPS, MS_TEAMS, IBM_EVENT_NOTIFICATIONS",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_ob_notification_channel", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMObNotificationChannelCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, err := getMonitoringInstanceRegion(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_notification_channel", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get("instance_id").(string)
+	client, err := GetMonitoringClient(meta, instanceID, region, getMonitoringInstanceEndpointType(d, meta))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_notification_channel", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	body := &notificationChannelEnvelope{
+		NotificationChannel: resourceIBMObNotificationChannelFromData(d),
+	}
+	result := &notificationChannelEnvelope{}
+	_, err = client.Request(context, core.POST, "/api/notificationChannels", nil, body, result)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateNotificationChannel failed: %s", err.Error()), "ibm_ob_notification_channel", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if result.NotificationChannel == nil || result.NotificationChannel.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateNotificationChannel returned no notification channel"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", region, instanceID, *result.NotificationChannel.ID))
+
+	return resourceIBMObNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMObNotificationChannelRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, region, instanceID, channelID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_notification_channel", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	result := &notificationChannelEnvelope{}
+	response, err := client.Request(context, core.GET, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetNotificationChannel failed: %s", err.Error()), "ibm_ob_notification_channel", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	channel := result.NotificationChannel
+	if channel == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("instance_id", instanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("name", channel.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("type", channel.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("enabled", channel.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+	if channel.Options != nil {
+		if err = d.Set("email_recipients", channel.Options.EmailRecipients); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting email_recipients: %s", err))
+		}
+		if channel.Options.URL != "" {
+			if err = d.Set("url", channel.Options.URL); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting url: %s", err))
+			}
+		}
+		if err = d.Set("channel", channel.Options.Channel); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting channel: %s", err))
+		}
+		if err = d.Set("notify_on_ok", channel.Options.NotifyOnOk); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting notify_on_ok: %s", err))
+		}
+		if err = d.Set("notify_on_resolve", channel.Options.NotifyOnResolve); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting notify_on_resolve: %s", err))
+		}
+	}
+	if err = d.Set("channel_id", flex.IntValue(channel.ID)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting channel_id: %s", err))
+	}
+	if err = d.Set("version", flex.IntValue(channel.Version)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMObNotificationChannelUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _, channelID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_notification_channel", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if d.HasChange("name") ||
+		d.HasChange("enabled") ||
+		d.HasChange("email_recipients") ||
+		d.HasChange("url") ||
+		d.HasChange("channel") ||
+		d.HasChange("notify_on_ok") ||
+		d.HasChange("notify_on_resolve") ||
+		d.HasChange("send_test_notification") {
+
+		channel := resourceIBMObNotificationChannelFromData(d)
+		id, err := strconv.ParseInt(channelID, 10, 64)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid notification channel ID %s: %s", channelID, err))
+		}
+		channel.ID = &id
+		channel.Version = core.Int64Ptr(int64(d.Get("version").(int)))
+
+		body := &notificationChannelEnvelope{
+			NotificationChannel: channel,
+		}
+		_, err = client.Request(context, core.PUT, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, body, nil)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateNotificationChannel failed: %s", err.Error()), "ibm_ob_notification_channel", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMObNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMObNotificationChannelDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _, channelID, err := monitoringClientFromID(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_ob_notification_channel", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	response, err := client.Request(context, core.DELETE, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteNotificationChannel failed: %s", err.Error()), "ibm_ob_notification_channel", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMObNotificationChannelFromData(d *schema.ResourceData) *NotificationChannel {
+	options := &NotificationChannelOptions{
+		URL:                  d.Get("url").(string),
+		Channel:              d.Get("channel").(string),
+		NotifyOnOk:           d.Get("notify_on_ok").(bool),
+		NotifyOnResolve:      d.Get("notify_on_resolve").(bool),
+		SendTestNotification: d.Get("send_test_notification").(bool),
+	}
+	if recipients, ok := d.GetOk("email_recipients"); ok {
+		options.EmailRecipients = flex.ExpandStringList(recipients.(*schema.Set).List())
+	}
+
+	return &NotificationChannel{
+		Type:    d.Get("type").(string),
+		Name:    d.Get("name").(string),
+		Enabled: d.Get("enabled").(bool),
+		Options: options,
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/monitoring"
+)
+
+func TestAccIBMObNotificationChannelBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-channel-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-channel-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckMonitoring(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMObNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMObNotificationChannelConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMObNotificationChannelExists("ibm_ob_notification_channel.channel"),
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "name", name),
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "type", "EMAIL"),
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "email_recipients.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_ob_notification_channel.channel", "channel_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMObNotificationChannelConfig(nameUpdate, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_ob_notification_channel.channel", "enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_ob_notification_channel.channel",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_notification"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObNotificationChannelConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_ob_notification_channel" "channel" {
+		instance_id      = "%s"
+		region           = "%s"
+		name             = "%s"
+		type             = "EMAIL"
+		enabled          = %t
+		email_recipients = ["ops@example.com"]
+		notify_on_ok     = true
+	}
+	`, acc.MonitoringInstanceID, acc.MonitoringInstanceRegion, name, enabled)
+}
+
+func testAccCheckIBMObNotificationChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := monitoring.GetMonitoringClient(acc.TestAccProvider.Meta(), parts[1], parts[0], "public")
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Request(context.Background(), core.GET, "/api/notificationChannels/{id}", map[string]string{"id": parts[2]}, nil, nil)
+		return err
+	}
+}
+
+func testAccCheckIBMObNotificationChannelDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_ob_notification_channel" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := monitoring.GetMonitoringClient(acc.TestAccProvider.Meta(), parts[1], parts[0], "public")
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Request(context.Background(), core.GET, "/api/notificationChannels/{id}", map[string]string{"id": parts[2]}, nil, nil)
+		if err == nil {
+			return fmt.Errorf("ibm_ob_notification_channel still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for ibm_ob_notification_channel (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
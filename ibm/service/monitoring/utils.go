@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+const (
+	cloudEndpoint     = "cloud.ibm.com"
+	testCloudEndpoint = "test.cloud.ibm.com"
+)
+
+// MonitoringClient issues requests against the REST API of a single
+// IBM Cloud Monitoring instance.
+type MonitoringClient struct {
+	Service    *core.BaseService
+	InstanceID string
+}
+
+// getMonitoringInstanceRegion returns the region configured on the resource,
+// falling back to the provider region.
+func getMonitoringInstanceRegion(d *schema.ResourceData, meta interface{}) (string, error) {
+	if region, ok := d.GetOk("region"); ok {
+		return region.(string), nil
+	}
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	return sess.Config.Region, nil
+}
+
+// getMonitoringInstanceEndpointType returns the endpoint type configured on the
+// resource, falling back to the provider visibility.
+func getMonitoringInstanceEndpointType(d *schema.ResourceData, meta interface{}) string {
+	if endpointType, ok := d.GetOk("endpoint_type"); ok {
+		return endpointType.(string)
+	}
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err == nil && sess.Config.Visibility == "private" {
+		return "private"
+	}
+	return "public"
+}
+
+// <region>.monitoring.cloud.ibm.com or private.<region>.monitoring.cloud.ibm.com
+func getMonitoringInstanceEndpoint(region, endpointType string) string {
+	domain := cloudEndpoint
+	if strings.Contains(os.Getenv("IBMCLOUD_IAM_API_ENDPOINT"), "test") {
+		domain = testCloudEndpoint
+	}
+	var endpoint string
+	if endpointType == "private" {
+		endpoint = fmt.Sprintf("https://private.%s.monitoring.%s", region, domain)
+	} else {
+		endpoint = fmt.Sprintf("https://%s.monitoring.%s", region, domain)
+	}
+	return conns.EnvFallBack([]string{"IBMCLOUD_MONITORING_API_ENDPOINT"}, endpoint)
+}
+
+// GetMonitoringClient builds a client for the monitoring instance in the given region.
+func GetMonitoringClient(meta interface{}, instanceID, region, endpointType string) (*MonitoringClient, error) {
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+
+	var authenticator core.Authenticator
+	token := session.Config.IAMAccessToken
+
+	if token != "" {
+		token = strings.Replace(token, "Bearer ", "", -1)
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: token,
+		}
+	} else {
+		iamURL := iamidentityv1.DefaultServiceURL
+		if session.Config.Visibility == "private" || session.Config.Visibility == "public-and-private" {
+			if session.Config.Region == "us-south" || session.Config.Region == "us-east" {
+				iamURL = conns.ContructEndpoint(fmt.Sprintf("private.%s.iam", session.Config.Region), cloudEndpoint)
+			} else {
+				iamURL = conns.ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		authenticator = &core.IamAuthenticator{
+			ApiKey: session.Config.BluemixAPIKey,
+			URL:    conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		}
+	}
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+		URL:           getMonitoringInstanceEndpoint(region, endpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring Monitoring service: %q", err)
+	}
+	service.SetUserAgent("terraform-provider-ibm/" + version.Version)
+
+	return &MonitoringClient{
+		Service:    service,
+		InstanceID: instanceID,
+	}, nil
+}
+
+// Request sends a JSON request to the monitoring API and decodes the response into result.
+func (c *MonitoringClient) Request(context context.Context, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	_, err := builder.ResolveRequestURL(c.Service.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("IBMInstanceID", c.InstanceID)
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Service.Request(request, result)
+}
+
+// Add the fields needed for building the instance endpoint to the given schema
+func AddMonitoringInstanceFields(resource *schema.Resource) *schema.Resource {
+	resource.Schema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the IBM Cloud Monitoring instance.",
+	}
+	resource.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the IBM Cloud Monitoring instance.",
+	}
+	resource.Schema["endpoint_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "public or private.",
+	}
+
+	return resource
+}
+
+// monitoringClientFromID parses an id of the form <region>/<instance_id>/<resource_id>
+// and returns a client for that instance along with the parsed parts.
+func monitoringClientFromID(d *schema.ResourceData, meta interface{}) (*MonitoringClient, string, string, string, error) {
+	idList, err := flex.IdParts(d.Id())
+	if err != nil || len(idList) != 3 {
+		return nil, "", "", "", fmt.Errorf("Invalid Id %s. Error: %s", d.Id(), err)
+	}
+
+	region := idList[0]
+	instanceID := idList[1]
+	resourceID := idList[2]
+	client, err := GetMonitoringClient(meta, instanceID, region, getMonitoringInstanceEndpointType(d, meta))
+	if err != nil {
+		return nil, "", "", "", err
+	}
+
+	return client, region, instanceID, resourceID, nil
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MQSCChannel holds the MQSC attributes of a channel. Unset attributes are not sent, so they keep their
+// current value on ALTER and their default value on DEFINE.
+type MQSCChannel struct {
+	ChannelType       *string `json:"chltype,omitempty"`
+	Description       *string `json:"descr,omitempty"`
+	ConnectionName    *string `json:"conname,omitempty"`
+	TransmissionQueue *string `json:"xmitq,omitempty"`
+	SSLCipherSpec     *string `json:"sslciph,omitempty"`
+	SSLClientAuth     *string `json:"sslcauth,omitempty"`
+	MaxMessageLength  *int64  `json:"maxmsgl,omitempty"`
+	HeartbeatInterval *int64  `json:"hbint,omitempty"`
+	MaxInstances      *int64  `json:"maxinst,omitempty"`
+}
+
+// mqscCommand is the runCommandJSON request of the administrative REST API.
+type mqscCommand struct {
+	Type               string       `json:"type"`
+	Command            string       `json:"command"`
+	Qualifier          string       `json:"qualifier"`
+	Name               string       `json:"name"`
+	Parameters         *MQSCChannel `json:"parameters,omitempty"`
+	ResponseParameters []string     `json:"responseParameters,omitempty"`
+}
+
+// mqscCommandResponse is the response of a queue manager to a single MQSC command.
+type mqscCommandResponse struct {
+	CompletionCode int             `json:"completionCode"`
+	ReasonCode     int             `json:"reasonCode"`
+	Message        []string        `json:"message"`
+	Parameters     json.RawMessage `json:"parameters"`
+}
+
+type mqscResponse struct {
+	CommandResponse       []mqscCommandResponse `json:"commandResponse"`
+	OverallCompletionCode int                   `json:"overallCompletionCode"`
+	OverallReasonCode     int                   `json:"overallReasonCode"`
+}
+
+// MQSCError is returned when the queue manager runs an MQSC command unsuccessfully.
+type MQSCError struct {
+	Command          string
+	QueueManagerName string
+	ReasonCode       int
+	Message          []string
+}
+
+func (e *MQSCError) Error() string {
+	return fmt.Sprintf("[ERROR] %s failed on queue manager %s with reason code %d: %s", e.Command, e.QueueManagerName, e.ReasonCode, strings.Join(e.Message, " "))
+}
+
+// mqscObjectNotFoundReasonCodes are the reason codes of the MQSC commands on an object that does not exist.
+var mqscObjectNotFoundReasonCodes = map[int]bool{
+	2085: true, // MQRC_UNKNOWN_OBJECT_NAME
+	3065: true, // MQRCCF_CHANNEL_NOT_FOUND
+}
+
+// isMQSCObjectNotFound returns whether err reports that the object of the MQSC command does not exist.
+func isMQSCObjectNotFound(err error) bool {
+	var mqscErr *MQSCError
+	return errors.As(err, &mqscErr) && mqscObjectNotFoundReasonCodes[mqscErr.ReasonCode]
+}
+
+// MqQueueManagerAdminV2 runs MQSC commands on a queue manager through the administrative REST API of the
+// queue manager, authenticated as an MQ administrator with its IBM Cloud API key.
+type MqQueueManagerAdminV2 struct {
+	Service          *core.BaseService
+	QueueManagerName string
+}
+
+// NewMqQueueManagerAdminV2 returns an administrative client of the queue manager served at endpoint.
+func NewMqQueueManagerAdminV2(endpoint, queueManagerName, username, apiKey string) (*MqQueueManagerAdminV2, error) {
+	// the administrator API endpoint of the queue manager may be given with the path of the REST API
+	if i := strings.Index(endpoint, "/ibmmq/"); i != -1 {
+		endpoint = endpoint[:i]
+	}
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL: strings.TrimSuffix(endpoint, "/"),
+		Authenticator: &core.BasicAuthenticator{
+			Username: username,
+			Password: apiKey,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occurred while configuring the administrative REST API of queue manager %s: %q", queueManagerName, err)
+	}
+	service.SetUserAgent("terraform-provider-ibm/" + version.Version)
+	return &MqQueueManagerAdminV2{
+		Service:          service,
+		QueueManagerName: queueManagerName,
+	}, nil
+}
+
+// DefineChannelWithContext defines a channel with the given attributes.
+func (admin *MqQueueManagerAdminV2) DefineChannelWithContext(ctx context.Context, name string, channel *MQSCChannel) error {
+	_, err := admin.runMQSC(ctx, &mqscCommand{Command: "define", Qualifier: "channel", Name: name, Parameters: channel})
+	return err
+}
+
+// DisplayChannelWithContext returns all the attributes of a channel.
+func (admin *MqQueueManagerAdminV2) DisplayChannelWithContext(ctx context.Context, name string) (*MQSCChannel, error) {
+	commandResponse, err := admin.runMQSC(ctx, &mqscCommand{Command: "display", Qualifier: "channel", Name: name, ResponseParameters: []string{"all"}})
+	if err != nil {
+		return nil, err
+	}
+	channel := &MQSCChannel{}
+	if len(commandResponse.Parameters) > 0 {
+		if err = json.Unmarshal(commandResponse.Parameters, channel); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error decoding channel %s of queue manager %s: %s", name, admin.QueueManagerName, err)
+		}
+	}
+	return channel, nil
+}
+
+// AlterChannelWithContext changes the attributes of a channel that are set in channel.
+func (admin *MqQueueManagerAdminV2) AlterChannelWithContext(ctx context.Context, name string, channel *MQSCChannel) error {
+	_, err := admin.runMQSC(ctx, &mqscCommand{Command: "alter", Qualifier: "channel", Name: name, Parameters: channel})
+	return err
+}
+
+// DeleteChannelWithContext deletes a channel.
+func (admin *MqQueueManagerAdminV2) DeleteChannelWithContext(ctx context.Context, name string) error {
+	_, err := admin.runMQSC(ctx, &mqscCommand{Command: "delete", Qualifier: "channel", Name: name})
+	return err
+}
+
+func (admin *MqQueueManagerAdminV2) runMQSC(ctx context.Context, command *mqscCommand) (*mqscCommandResponse, error) {
+	command.Type = "runCommandJSON"
+	description := fmt.Sprintf("%s %s %s", command.Command, command.Qualifier, command.Name)
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(admin.Service.GetServiceURL(), "/ibmmq/rest/v2/admin/action/qmgr/{qmgr}/mqsc", map[string]string{"qmgr": admin.QueueManagerName})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	// the administrative REST API rejects requests without a CSRF token header, its value is not checked
+	builder.AddHeader("ibm-mq-rest-csrf-token", "terraform")
+	if _, err = builder.SetBodyContentJSON(command); err != nil {
+		return nil, err
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	result := &mqscResponse{}
+	response, err := admin.Service.Request(request, result)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] %s failed on queue manager %s: %s\n%s", description, admin.QueueManagerName, err, response)
+	}
+	if len(result.CommandResponse) == 0 {
+		return nil, fmt.Errorf("[ERROR] %s returned no response from queue manager %s", description, admin.QueueManagerName)
+	}
+	commandResponse := &result.CommandResponse[0]
+	if commandResponse.CompletionCode != 0 {
+		return nil, &MQSCError{
+			Command:          description,
+			QueueManagerName: admin.QueueManagerName,
+			ReasonCode:       commandResponse.ReasonCode,
+			Message:          commandResponse.Message,
+		}
+	}
+	return commandResponse, nil
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMqQueueManagerAdminClient(t *testing.T, handler func(t *testing.T, command map[string]interface{}) string) *MqQueueManagerAdminV2 {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/ibmmq/rest/v2/admin/action/qmgr/QM1/mqsc", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("ibm-mq-rest-csrf-token"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "api-key", password)
+
+		command := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&command))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(handler(t, command)))
+	}))
+	t.Cleanup(server.Close)
+
+	// the endpoint is given with the path of the REST API in the queue manager details
+	client, err := NewMqQueueManagerAdminV2(server.URL+"/ibmmq/rest/v2/", "QM1", "admin", "api-key")
+	assert.Nil(t, err)
+	return client
+}
+
+func TestMqQueueManagerAdminDefineChannel(t *testing.T) {
+	client := testMqQueueManagerAdminClient(t, func(t *testing.T, command map[string]interface{}) string {
+		assert.Equal(t, map[string]interface{}{
+			"type":      "runCommandJSON",
+			"command":   "define",
+			"qualifier": "channel",
+			"name":      "APP.SVRCONN",
+			"parameters": map[string]interface{}{
+				"chltype": "svrconn",
+				"descr":   "",
+				"maxinst": float64(10),
+			},
+		}, command)
+		return `{"commandResponse":[{"completionCode":0,"reasonCode":0,"message":["AMQ8014I: IBM MQ channel created."]}],"overallCompletionCode":0,"overallReasonCode":0}`
+	})
+
+	err := client.DefineChannelWithContext(context.Background(), "APP.SVRCONN", &MQSCChannel{
+		ChannelType:  core.StringPtr("svrconn"),
+		Description:  core.StringPtr(""),
+		MaxInstances: core.Int64Ptr(10),
+	})
+	assert.Nil(t, err)
+}
+
+func TestMqQueueManagerAdminDisplayChannel(t *testing.T) {
+	client := testMqQueueManagerAdminClient(t, func(t *testing.T, command map[string]interface{}) string {
+		assert.Equal(t, "display", command["command"])
+		assert.Equal(t, []interface{}{"all"}, command["responseParameters"])
+		return `{"commandResponse":[{"completionCode":0,"reasonCode":0,"message":["AMQ8414I: Display Channel details."],` +
+			`"parameters":{"channel":"APP.SVRCONN","chltype":"SVRCONN","descr":"app channel","sslcauth":"REQUIRED","maxmsgl":4194304,"hbint":300,"maxinst":999999999}}],` +
+			`"overallCompletionCode":0,"overallReasonCode":0}`
+	})
+
+	channel, err := client.DisplayChannelWithContext(context.Background(), "APP.SVRCONN")
+	assert.Nil(t, err)
+	assert.Equal(t, "SVRCONN", *channel.ChannelType)
+	assert.Equal(t, "app channel", *channel.Description)
+	assert.Equal(t, "REQUIRED", *channel.SSLClientAuth)
+	assert.Equal(t, int64(4194304), *channel.MaxMessageLength)
+	assert.Equal(t, int64(300), *channel.HeartbeatInterval)
+	assert.Equal(t, int64(999999999), *channel.MaxInstances)
+	assert.Nil(t, channel.ConnectionName)
+}
+
+func TestMqQueueManagerAdminChannelNotFound(t *testing.T) {
+	client := testMqQueueManagerAdminClient(t, func(t *testing.T, command map[string]interface{}) string {
+		return `{"commandResponse":[{"completionCode":2,"reasonCode":3065,"message":["AMQ8147E: IBM MQ object MISSING not found."]}],"overallCompletionCode":2,"overallReasonCode":3008}`
+	})
+
+	_, err := client.DisplayChannelWithContext(context.Background(), "MISSING")
+	assert.NotNil(t, err)
+	assert.True(t, isMQSCObjectNotFound(err))
+
+	err = client.DeleteChannelWithContext(context.Background(), "MISSING")
+	assert.True(t, isMQSCObjectNotFound(err))
+}
+
+func TestMqQueueManagerAdminCommandFailure(t *testing.T) {
+	client := testMqQueueManagerAdminClient(t, func(t *testing.T, command map[string]interface{}) string {
+		return `{"commandResponse":[{"completionCode":2,"reasonCode":2035,"message":["AMQ8135E: Not authorized."]}],"overallCompletionCode":2,"overallReasonCode":3008}`
+	})
+
+	err := client.AlterChannelWithContext(context.Background(), "APP.SVRCONN", &MQSCChannel{ChannelType: core.StringPtr("svrconn")})
+	assert.NotNil(t, err)
+	assert.False(t, isMQSCObjectNotFound(err))
+	assert.Contains(t, err.Error(), "reason code 2035")
+}
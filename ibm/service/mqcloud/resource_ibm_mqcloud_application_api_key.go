@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func ResourceIbmMqcloudApplicationApiKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmMqcloudApplicationApiKeyCreate,
+		ReadContext:   resourceIbmMqcloudApplicationApiKeyRead,
+		DeleteContext: resourceIbmMqcloudApplicationApiKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"service_instance_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_application_api_key", "service_instance_guid"),
+				Description:  "The GUID that uniquely identifies the MQ on Cloud service instance.",
+			},
+			"application_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the application the API key is created for.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_application_api_key", "name"),
+				Description:  "The name of the API key.",
+			},
+			"api_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the IBM Cloud API key.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key the application authenticates to the queue managers with.",
+			},
+		},
+	}
+}
+
+func ResourceIbmMqcloudApplicationApiKeyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "service_instance_guid",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9][-a-zA-Z0-9_]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             64,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_mqcloud_application_api_key", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmMqcloudApplicationApiKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := checkSIPlan(d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Create Application API Key failed %s", err))
+	}
+
+	mqcloudClient, err := meta.(conns.ClientSession).MqcloudV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceInstanceGuid := d.Get("service_instance_guid").(string)
+	applicationID := d.Get("application_id").(string)
+	createApplicationApikeyOptions := mqcloudClient.NewCreateApplicationApikeyOptions(serviceInstanceGuid, applicationID, d.Get("name").(string))
+
+	apiKeyCreated, response, err := mqcloudClient.CreateApplicationApikeyWithContext(context, createApplicationApikeyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateApplicationApikeyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateApplicationApikeyWithContext failed %s\n%s", err, response))
+	}
+	if apiKeyCreated.ApiKeyID == nil {
+		return diag.FromErr(fmt.Errorf("CreateApplicationApikeyWithContext returned no API key for application %s", applicationID))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceInstanceGuid, applicationID, *apiKeyCreated.ApiKeyID))
+	// the API key is returned only on creation
+	if err = d.Set("api_key", apiKeyCreated.ApiKey); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_key: %s", err))
+	}
+
+	return resourceIbmMqcloudApplicationApiKeyRead(context, d, meta)
+}
+
+func resourceIbmMqcloudApplicationApiKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s, expected service_instance_guid/application_id/api_key_id", d.Id()))
+	}
+
+	// the API keys of an application are IBM Cloud API keys of the service ID of the application
+	getApiKeyOptions := &iamidentityv1.GetAPIKeyOptions{}
+	getApiKeyOptions.SetID(parts[2])
+
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(context, getApiKeyOptions)
+	if err != nil || apiKey == nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAPIKeyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAPIKeyWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("service_instance_guid", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_instance_guid: %s", err))
+	}
+	if err = d.Set("application_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting application_id: %s", err))
+	}
+	if err = d.Set("name", apiKey.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("api_key_id", parts[2]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_key_id: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmMqcloudApplicationApiKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s, expected service_instance_guid/application_id/api_key_id", d.Id()))
+	}
+
+	deleteApiKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{}
+	deleteApiKeyOptions.SetID(parts[2])
+
+	response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, deleteApiKeyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteAPIKeyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteAPIKeyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmMqcloudApplicationApiKeyBasic(t *testing.T) {
+	t.Parallel()
+	serviceInstanceGuid := acc.MqcloudInstanceID
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMqcloud(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmMqcloudApplicationApiKeyConfigBasic(serviceInstanceGuid, "appkey", "appkey-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_application_api_key.mqcloud_application_api_key_instance", "service_instance_guid", serviceInstanceGuid),
+					resource.TestCheckResourceAttr("ibm_mqcloud_application_api_key.mqcloud_application_api_key_instance", "name", "appkey-1"),
+					resource.TestCheckResourceAttrSet("ibm_mqcloud_application_api_key.mqcloud_application_api_key_instance", "api_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_mqcloud_application_api_key.mqcloud_application_api_key_instance", "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmMqcloudApplicationApiKeyConfigBasic(serviceInstanceGuid string, applicationName string, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_mqcloud_application" "mqcloud_application_instance" {
+			service_instance_guid = "%s"
+			name = "%s"
+		}
+
+		resource "ibm_mqcloud_application_api_key" "mqcloud_application_api_key_instance" {
+			service_instance_guid = ibm_mqcloud_application.mqcloud_application_instance.service_instance_guid
+			application_id = ibm_mqcloud_application.mqcloud_application_instance.application_id
+			name = "%s"
+		}
+	`, serviceInstanceGuid, applicationName, name)
+}
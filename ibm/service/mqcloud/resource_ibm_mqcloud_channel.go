@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIbmMqcloudChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmMqcloudChannelCreate,
+		ReadContext:   resourceIbmMqcloudChannelRead,
+		UpdateContext: resourceIbmMqcloudChannelUpdate,
+		DeleteContext: resourceIbmMqcloudChannelDelete,
+
+		Schema: map[string]*schema.Schema{
+			"administrator_api_endpoint_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The url through which to access the Admin REST APIs of the queue manager.",
+			},
+			"queue_manager_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the queue manager.",
+			},
+			"administrator_username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the MQ on Cloud user administering the queue manager.",
+			},
+			"administrator_api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The IBM Cloud API key of the MQ on Cloud user administering the queue manager.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "name"),
+				Description:  "The name of the channel.",
+			},
+			"channel_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "channel_type"),
+				Description:  "The type of the channel, svrconn, sdr, rcvr, rqstr or svr.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the channel.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The connection name of the partner queue manager, for example `host(port)`. Required for sdr and rqstr channels.",
+			},
+			"transmission_queue": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the transmission queue of the channel. Required for sdr and svr channels.",
+			},
+			"ssl_cipher_spec": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CipherSpec used for TLS on the channel, for example `ANY_TLS12_OR_HIGHER`.",
+			},
+			"ssl_client_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "ssl_client_auth"),
+				Description:  "Whether the channel requires a certificate from the TLS client, required or optional.",
+			},
+			"max_message_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum length of a message that can be transmitted on the channel, in bytes.",
+			},
+			"heartbeat_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The approximate time between heartbeat flows on the channel, in seconds.",
+			},
+			"max_instances": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum number of simultaneous instances of a svrconn channel.",
+			},
+		},
+	}
+}
+
+func ResourceIbmMqcloudChannelValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9._/%]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             20,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "channel_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "rcvr, rqstr, sdr, svr, svrconn",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "ssl_client_auth",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "optional, required",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_mqcloud_channel", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func mqcloudChannelAdminClient(d *schema.ResourceData) (*MqQueueManagerAdminV2, error) {
+	return NewMqQueueManagerAdminV2(
+		d.Get("administrator_api_endpoint_url").(string),
+		d.Get("queue_manager_name").(string),
+		d.Get("administrator_username").(string),
+		d.Get("administrator_api_key").(string),
+	)
+}
+
+// expandMqcloudChannel returns the MQSC attributes of the channel that are configured or, when changedOnly is
+// set, that changed. The channel type is always set, as ALTER CHANNEL requires it.
+func expandMqcloudChannel(d *schema.ResourceData, changedOnly bool) *MQSCChannel {
+	include := func(key string) bool {
+		if changedOnly {
+			return d.HasChange(key)
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+	stringValue := func(key string) *string {
+		if !include(key) {
+			return nil
+		}
+		return core.StringPtr(d.Get(key).(string))
+	}
+	intValue := func(key string) *int64 {
+		if !include(key) {
+			return nil
+		}
+		return core.Int64Ptr(int64(d.Get(key).(int)))
+	}
+
+	return &MQSCChannel{
+		ChannelType:       core.StringPtr(d.Get("channel_type").(string)),
+		Description:       stringValue("description"),
+		ConnectionName:    stringValue("connection_name"),
+		TransmissionQueue: stringValue("transmission_queue"),
+		SSLCipherSpec:     stringValue("ssl_cipher_spec"),
+		SSLClientAuth:     stringValue("ssl_client_auth"),
+		MaxMessageLength:  intValue("max_message_length"),
+		HeartbeatInterval: intValue("heartbeat_interval"),
+		MaxInstances:      intValue("max_instances"),
+	}
+}
+
+// mqcloudChannelChanged returns whether an MQSC attribute of the channel changed, a change of the
+// administrator credentials only is not applied to the channel.
+func mqcloudChannelChanged(d *schema.ResourceData) bool {
+	return d.HasChanges("description", "connection_name", "transmission_queue", "ssl_cipher_spec", "ssl_client_auth", "max_message_length", "heartbeat_interval", "max_instances")
+}
+
+func resourceIbmMqcloudChannelCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, err := mqcloudChannelAdminClient(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	err = adminClient.DefineChannelWithContext(context, name, expandMqcloudChannel(d, false))
+	if err != nil {
+		log.Printf("[DEBUG] DefineChannelWithContext failed %s", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", adminClient.QueueManagerName, name))
+
+	return resourceIbmMqcloudChannelRead(context, d, meta)
+}
+
+func resourceIbmMqcloudChannelRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, err := mqcloudChannelAdminClient(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	channel, err := adminClient.DisplayChannelWithContext(context, parts[1])
+	if err != nil {
+		if isMQSCObjectNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DisplayChannelWithContext failed %s", err)
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("queue_manager_name", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting queue_manager_name: %s", err))
+	}
+	if err = d.Set("name", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	// the queue manager returns the keywords in upper case and pads some values with blanks
+	attributes := map[string]interface{}{
+		"channel_type":       strings.ToLower(strings.TrimSpace(flex.StringValue(channel.ChannelType))),
+		"description":        strings.TrimSpace(flex.StringValue(channel.Description)),
+		"connection_name":    strings.TrimSpace(flex.StringValue(channel.ConnectionName)),
+		"transmission_queue": strings.TrimSpace(flex.StringValue(channel.TransmissionQueue)),
+		"ssl_cipher_spec":    strings.TrimSpace(flex.StringValue(channel.SSLCipherSpec)),
+		"ssl_client_auth":    strings.ToLower(strings.TrimSpace(flex.StringValue(channel.SSLClientAuth))),
+		"max_message_length": flex.IntValue(channel.MaxMessageLength),
+		"heartbeat_interval": flex.IntValue(channel.HeartbeatInterval),
+		"max_instances":      flex.IntValue(channel.MaxInstances),
+	}
+	for key, value := range attributes {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmMqcloudChannelUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, err := mqcloudChannelAdminClient(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if mqcloudChannelChanged(d) {
+		err = adminClient.AlterChannelWithContext(context, d.Get("name").(string), expandMqcloudChannel(d, true))
+		if err != nil {
+			log.Printf("[DEBUG] AlterChannelWithContext failed %s", err)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmMqcloudChannelRead(context, d, meta)
+}
+
+func resourceIbmMqcloudChannelDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, err := mqcloudChannelAdminClient(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = adminClient.DeleteChannelWithContext(context, d.Get("name").(string))
+	if err != nil && !isMQSCObjectNotFound(err) {
+		log.Printf("[DEBUG] DeleteChannelWithContext failed %s", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
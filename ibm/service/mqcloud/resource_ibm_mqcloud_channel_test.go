@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmMqcloudChannelBasic(t *testing.T) {
+	t.Parallel()
+	adminUsername := os.Getenv("IBM_MQCLOUD_ADMIN_USERNAME")
+	adminApiKey := os.Getenv("IBM_MQCLOUD_ADMIN_API_KEY")
+	if adminUsername == "" || adminApiKey == "" {
+		t.Skip("IBM_MQCLOUD_ADMIN_USERNAME and IBM_MQCLOUD_ADMIN_API_KEY must be set for the channel acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMqcloud(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmMqcloudChannelConfigBasic(adminUsername, adminApiKey, "TF.SVRCONN", "terraform channel"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "name", "TF.SVRCONN"),
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "channel_type", "svrconn"),
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "description", "terraform channel"),
+					resource.TestCheckResourceAttrSet("ibm_mqcloud_channel.mqcloud_channel_instance", "max_message_length"),
+				),
+			},
+			{
+				Config: testAccCheckIbmMqcloudChannelConfigBasic(adminUsername, adminApiKey, "TF.SVRCONN", "terraform channel updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "description", "terraform channel updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmMqcloudChannelConfigBasic(adminUsername string, adminApiKey string, name string, description string) string {
+	return fmt.Sprintf(`
+		data "ibm_mqcloud_queue_manager" "mqcloud_queue_manager" {
+			service_instance_guid = "%s"
+		}
+
+		locals {
+			queue_manager = [for qm in data.ibm_mqcloud_queue_manager.mqcloud_queue_manager.queue_managers : qm if qm.id == "%s"][0]
+		}
+
+		resource "ibm_mqcloud_channel" "mqcloud_channel_instance" {
+			administrator_api_endpoint_url = local.queue_manager.administrator_api_endpoint_url
+			queue_manager_name             = local.queue_manager.name
+			administrator_username         = "%s"
+			administrator_api_key          = "%s"
+			name                           = "%s"
+			channel_type                   = "svrconn"
+			description                    = "%s"
+			ssl_cipher_spec                = "ANY_TLS12_OR_HIGHER"
+			ssl_client_auth                = "optional"
+		}
+	`, acc.MqcloudInstanceID, acc.MqcloudQueueManagerID, adminUsername, adminApiKey, name, description)
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIPlacementGroupCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIPlacementGroupComplianceRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_PlacementGroupName: {
+				Description:  "The name or ID of the placement group.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Compliant: {
+				Computed:    true,
+				Description: "Whether the members of the placement group satisfy its policy. Members without a known host are not taken into account.",
+				Type:        schema.TypeBool,
+			},
+			Attr_Members: {
+				Computed:    true,
+				Description: "The server instances that are members of the placement group and the hosts they run on.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_HostID: {
+							Computed:    true,
+							Description: "The ID of the host the server instance runs on, empty when the host is not known.",
+							Type:        schema.TypeString,
+						},
+						Attr_InstanceID: {
+							Computed:    true,
+							Description: "The ID of the server instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the server instance.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_Policy: {
+				Computed:    true,
+				Description: "The value of the group's affinity policy. Valid values are affinity and anti-affinity.",
+				Type:        schema.TypeString,
+			},
+			Attr_Violations: {
+				Computed:    true,
+				Description: "The IDs of the server instances that do not satisfy the policy of the placement group.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIPlacementGroupComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	placementGroupName := d.Get(Arg_PlacementGroupName).(string)
+	client := instance.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
+	instanceClient := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+
+	placementGroup, err := client.Get(placementGroupName)
+	if err != nil {
+		log.Printf("[DEBUG] get placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	members := make([]map[string]interface{}, 0, len(placementGroup.Members))
+	hosts := make(map[string]string, len(placementGroup.Members))
+	for _, memberID := range placementGroup.Members {
+		pvmInstance, err := instanceClient.Get(memberID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error getting member %s of placement group %s: %v", memberID, placementGroupName, err))
+		}
+		hostID := pvmInstanceHostID(pvmInstance)
+		member := map[string]interface{}{
+			Attr_HostID:     hostID,
+			Attr_InstanceID: memberID,
+		}
+		if pvmInstance.ServerName != nil {
+			member[Attr_Name] = *pvmInstance.ServerName
+		}
+		members = append(members, member)
+		if hostID != "" {
+			hosts[memberID] = hostID
+		}
+	}
+
+	policy := ""
+	if placementGroup.Policy != nil {
+		policy = *placementGroup.Policy
+	}
+	violations := placementGroupViolations(policy, hosts)
+
+	d.SetId(*placementGroup.ID)
+	d.Set(Attr_Compliant, len(violations) == 0)
+	d.Set(Attr_Members, members)
+	d.Set(Attr_Policy, policy)
+	d.Set(Attr_Violations, violations)
+
+	return nil
+}
+
+// pvmInstanceHostID returns the ID of the host a server instance runs on, or an empty string when the
+// host is not reported.
+func pvmInstanceHostID(pvmInstance *models.PVMInstance) string {
+	if pvmInstance.HostID == 0 {
+		return ""
+	}
+	return strconv.FormatInt(pvmInstance.HostID, 10)
+}
+
+// placementGroupViolations returns the sorted IDs of the members that do not satisfy the policy of the
+// placement group, given the host of each member. Members of an anti-affinity group violate the policy
+// when they share a host, members of an affinity group when they are not on the host of most members.
+func placementGroupViolations(policy string, hosts map[string]string) []string {
+	byHost := map[string][]string{}
+	for memberID, hostID := range hosts {
+		byHost[hostID] = append(byHost[hostID], memberID)
+	}
+
+	violations := []string{}
+	switch policy {
+	case "anti-affinity":
+		for _, memberIDs := range byHost {
+			if len(memberIDs) > 1 {
+				violations = append(violations, memberIDs...)
+			}
+		}
+	case "affinity":
+		mainHost := ""
+		for hostID, memberIDs := range byHost {
+			if mainHost == "" || len(memberIDs) > len(byHost[mainHost]) || (len(memberIDs) == len(byHost[mainHost]) && hostID < mainHost) {
+				mainHost = hostID
+			}
+		}
+		for hostID, memberIDs := range byHost {
+			if hostID != mainHost {
+				violations = append(violations, memberIDs...)
+			}
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIPlacementGroupComplianceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIPlacementGroupComplianceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_placement_group_compliance.testacc_ds_placement_group_compliance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_placement_group_compliance.testacc_ds_placement_group_compliance", "compliant"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_placement_group_compliance.testacc_ds_placement_group_compliance", "policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIPlacementGroupComplianceDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_placement_group_compliance" "testacc_ds_placement_group_compliance" {
+			pi_placement_group_name = "%s"
+			pi_cloud_instance_id = "%s"
+		}`, acc.Pi_placement_group_name, acc.Pi_cloud_instance_id)
+}
@@ -26,6 +26,11 @@ func DataSourceIBMPISAPProfiles() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_Certified: {
+				Description: "If set to true, only SAP certified profiles are listed.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 
 			// Attributes
 			Attr_Profiles: {
@@ -82,7 +87,11 @@ func dataSourceIBMPISAPProfilesRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	result := make([]map[string]interface{}, 0, len(sapProfiles.Profiles))
+	certifiedOnly := d.Get(Arg_Certified).(bool)
 	for _, sapProfile := range sapProfiles.Profiles {
+		if certifiedOnly && (sapProfile.Certified == nil || !*sapProfile.Certified) {
+			continue
+		}
 		profile := map[string]interface{}{
 			Attr_Certified: *sapProfile.Certified,
 			Attr_Cores:     *sapProfile.Cores,
@@ -33,3 +33,27 @@ func testAccCheckIBMPISAPProfilesDataSourceConfig() string {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_cloud_instance_id)
 }
+
+func TestAccIBMPISAPProfilesDataSourceCertified(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISAPProfilesDataSourceCertifiedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_sap_profiles.test", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_sap_profiles.test", "profiles.0.certified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISAPProfilesDataSourceCertifiedConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_sap_profiles" "test" {
+			pi_certified         = true
+			pi_cloud_instance_id = "%s"
+		}`, acc.Pi_cloud_instance_id)
+}
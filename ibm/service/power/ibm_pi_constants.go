@@ -9,8 +9,13 @@ const (
 	Arg_AffinityVolume                      = "pi_affinity_volume"
 	Arg_AntiAffinityInstances               = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                 = "pi_anti_affinity_volumes"
+	Arg_CaptureName                         = "pi_capture_name"
+	Arg_Certified                           = "pi_certified"
+	Arg_CloneCount                          = "pi_clone_count"
+	Arg_CloneNameTemplate                   = "pi_clone_name_template"
 	Arg_CloudConnectionName                 = "pi_cloud_connection_name"
 	Arg_CloudInstanceID                     = "pi_cloud_instance_id"
+	Arg_CloudInstanceIDs                    = "pi_cloud_instance_ids"
 	Arg_Datacenter                          = "pi_datacenter"
 	Arg_DatacenterZone                      = "pi_datacenter_zone"
 	Arg_Description                         = "pi_description"
@@ -25,9 +30,12 @@ const (
 	Arg_IBMiRDSUsers                        = "pi_ibmi_rds_users"
 	Arg_ImageName                           = "pi_image_name"
 	Arg_InstanceName                        = "pi_instance_name"
+	Arg_KeepImage                           = "pi_keep_image"
 	Arg_KeyName                             = "pi_key_name"
+	Arg_KeyPairName                         = "pi_key_pair_name"
 	Arg_LanguageCode                        = "pi_language_code"
 	Arg_Name                                = "pi_name"
+	Arg_NetworkMappings                     = "pi_network_mappings"
 	Arg_NetworkName                         = "pi_network_name"
 	Arg_PIInstanceSharedProcessorPool       = "pi_shared_processor_pool"
 	Arg_PlacementGroupName                  = "pi_placement_group_name"
@@ -37,9 +45,11 @@ const (
 	Arg_PVMInstanceHealthStatus             = "pi_health_status"
 	Arg_PVMInstanceId                       = "pi_instance_id"
 	Arg_ReplicationEnabled                  = "pi_replication_enabled"
+	Arg_RequiredCapabilities                = "pi_required_capabilities"
 	Arg_ResourceGroupID                     = "pi_resource_group_id"
 	Arg_SAP                                 = "sap"
 	Arg_SAPProfileID                        = "pi_sap_profile_id"
+	Arg_SetHostname                         = "pi_set_hostname"
 	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
 	Arg_SharedProcessorPoolID               = "pi_shared_processor_pool_id"
 	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
@@ -51,6 +61,7 @@ const (
 	Arg_SPPPlacementGroupName               = "pi_spp_placement_group_name"
 	Arg_SPPPlacementGroupPolicy             = "pi_spp_placement_group_policy"
 	Arg_SSHKey                              = "pi_ssh_key"
+	Arg_SSHKeys                             = "pi_ssh_keys"
 	Arg_StoragePool                         = "pi_storage_pool"
 	Arg_StorageType                         = "pi_storage_type"
 	Arg_VolumeGroupID                       = "pi_volume_group_id"
@@ -83,10 +94,12 @@ const (
 	Attr_Certified                                   = "certified"
 	Attr_CIDR                                        = "cidr"
 	Attr_ClassicEnabled                              = "classic_enabled"
+	Attr_Clones                                      = "clones"
 	Attr_CloudConnectionID                           = "cloud_connection_id"
 	Attr_CloudInstanceID                             = "cloud_instance_id"
 	Attr_CloudInstances                              = "cloud_instances"
 	Attr_Code                                        = "code"
+	Attr_Compliant                                   = "compliant"
 	Attr_ConnectionMode                              = "connection_mode"
 	Attr_Connections                                 = "connections"
 	Attr_ConsistencyGroupName                        = "consistency_group_name"
@@ -98,6 +111,7 @@ const (
 	Attr_Cores                                       = "cores"
 	Attr_CPUs                                        = "cpus"
 	Attr_Created                                     = "created"
+	Attr_CreatedKeys                                 = "created_keys"
 	Attr_CreateTime                                  = "create_time"
 	Attr_CreationDate                                = "creation_date"
 	Attr_CRN                                         = "crn"
@@ -156,7 +170,9 @@ const (
 	Attr_ImageInfo                                   = "image_info"
 	Attr_Images                                      = "images"
 	Attr_ImageType                                   = "image_type"
+	Attr_ImportJobID                                 = "import_job_id"
 	Attr_InputVolumes                                = "input_volumes"
+	Attr_InstanceID                                  = "instance_id"
 	Attr_Instances                                   = "instances"
 	Attr_InstanceSnapshots                           = "instance_snapshots"
 	Attr_InstanceVolumes                             = "instance_volumes"
@@ -170,6 +186,7 @@ const (
 	Attr_KeyCreationDate                             = "creation_date"
 	Attr_KeyID                                       = "key_id"
 	Attr_KeyName                                     = "name"
+	Attr_KeyNames                                    = "key_names"
 	Attr_Keys                                        = "keys"
 	Attr_Language                                    = "language"
 	Attr_LastUpdateDate                              = "last_update_date"
@@ -208,6 +225,7 @@ const (
 	Attr_NumberOfVolumes                             = "number_of_volumes"
 	Attr_Onboardings                                 = "onboardings"
 	Attr_OperatingSystem                             = "operating_system"
+	Attr_PEREnabled                                  = "per_enabled"
 	Attr_PercentComplete                             = "percent_complete"
 	Attr_PIInstanceSharedProcessorPool               = "shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPoolID             = "shared_processor_pool_id"
@@ -266,6 +284,7 @@ const (
 	Attr_SharedProcessorPoolStatusDetail             = "status_detail"
 	Attr_Size                                        = "size"
 	Attr_SnapshotID                                  = "snapshot_id"
+	Attr_SourceNetworkID                             = "source_network_id"
 	Attr_SourceVolumeName                            = "source_volume_name"
 	Attr_Speed                                       = "speed"
 	Attr_SPPPlacementGroupID                         = "spp_placement_group_id"
@@ -306,6 +325,7 @@ const (
 	Attr_UserIPAddress                               = "user_ip_address"
 	Attr_VCPUs                                       = "vcpus"
 	Attr_VirtualCoresAssigned                        = "virtual_cores_assigned"
+	Attr_Violations                                  = "violations"
 	Attr_VLanID                                      = "vlan_id"
 	Attr_VolumeGroupName                             = "volume_group_name"
 	Attr_VolumeGroups                                = "volume_groups"
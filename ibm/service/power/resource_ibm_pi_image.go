@@ -119,7 +119,7 @@ func ResourceIBMPIImage() *schema.Resource {
 			helpers.PIImageStoragePool: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Storage pool where the image will be loaded, if provided then pi_affinity_policy will be ignored",
+				Description: "Storage pool where the image will be loaded, if provided then pi_affinity_policy will be ignored; every volume of a multi-volume OVA is loaded in this pool",
 				ForceNew:    true,
 			},
 			PIAffinityPolicy: {
@@ -166,6 +166,40 @@ func ResourceIBMPIImage() *schema.Resource {
 				Computed:    true,
 				Description: "Image ID",
 			},
+			Attr_ImportJobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the job that imported the image from Cloud Object Storage",
+			},
+			Attr_Volumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The volumes of the image; a multi-volume OVA has one volume per disk",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Bootable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the volume is bootable",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume",
+						},
+						Attr_Size: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The size of the volume in GB",
+						},
+						Attr_VolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the volume",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -265,10 +299,17 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(err)
 		}
 
+		jobID := *imageResponse.ID
+		d.Set(Attr_ImportJobID, jobID)
+
 		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			// a partially imported image is kept in the state, so that it is tainted and replaced by the next apply
+			if image, getErr := client.Get(imageName); getErr == nil && image.ImageID != nil {
+				d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *image.ImageID))
+			}
+			return diag.Errorf("[ERROR] Error importing image %s from Cloud Object Storage with job %s: %s", imageName, jobID, err)
 		}
 
 		// Once the job is completed find by name
@@ -311,6 +352,28 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("image_id", imageid)
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
 
+	volumes := make([]map[string]interface{}, 0, len(imagedata.Volumes))
+	for _, volume := range imagedata.Volumes {
+		if volume == nil {
+			continue
+		}
+		v := map[string]interface{}{}
+		if volume.Bootable != nil {
+			v[Attr_Bootable] = *volume.Bootable
+		}
+		if volume.Name != nil {
+			v[Attr_Name] = *volume.Name
+		}
+		if volume.Size != nil {
+			v[Attr_Size] = *volume.Size
+		}
+		if volume.VolumeID != nil {
+			v[Attr_VolumeID] = *volume.VolumeID
+		}
+		volumes = append(volumes, v)
+	}
+	d.Set(Attr_Volumes, volumes)
+
 	return nil
 }
 
@@ -381,10 +444,15 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *st.IBMPIJobClient, jo
 				log.Printf("[DEBUG] get job failed with empty response")
 				return nil, "", fmt.Errorf("failed to get job status for job id %s", jobID)
 			}
+			progress := ""
+			if job.Status.Progress != nil {
+				progress = *job.Status.Progress
+			}
 			if *job.Status.State == helpers.JobStatusFailed {
 				log.Printf("[DEBUG] job status failed with message: %v", job.Status.Message)
-				return nil, helpers.JobStatusFailed, fmt.Errorf("job status failed for job id %s with message: %v", jobID, job.Status.Message)
+				return nil, helpers.JobStatusFailed, fmt.Errorf("job status failed for job id %s at progress %s with message: %v", jobID, progress, job.Status.Message)
 			}
+			log.Printf("[INFO] job %s is %s, progress: %s %s", jobID, *job.Status.State, progress, job.Status.Message)
 			return job, *job.Status.State, nil
 		},
 		Timeout:    timeout,
@@ -136,3 +136,39 @@ func testAccCheckIBMPIImageCOSPublicConfig(name string) string {
 	}
 	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name)
 }
+
+func TestAccIBMPIImageCOSImportVolumes(t *testing.T) {
+	imageRes := "ibm_pi_image.cos_image"
+	name := fmt.Sprintf("tf-pi-image-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImageCOSImportVolumesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIImageExists(imageRes),
+					resource.TestCheckResourceAttr(imageRes, "pi_image_name", name),
+					resource.TestCheckResourceAttrSet(imageRes, "image_id"),
+					resource.TestCheckResourceAttrSet(imageRes, "import_job_id"),
+					resource.TestCheckResourceAttrSet(imageRes, "volumes.0.volume_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImageCOSImportVolumesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image" "cos_image" {
+		pi_image_name       = "%[1]s"
+		pi_cloud_instance_id = "%[2]s"
+		pi_image_bucket_name = "%[3]s"
+		pi_image_bucket_access = "public"
+		pi_image_bucket_region = "us-south"
+		pi_image_bucket_file_name = "%[4]s"
+		pi_image_storage_type = "tier1"
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name)
+}
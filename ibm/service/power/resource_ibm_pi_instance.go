@@ -12,8 +12,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
@@ -29,7 +31,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceRead,
 		UpdateContext: resourceIBMPIInstanceUpdate,
 		DeleteContext: resourceIBMPIInstanceDelete,
-		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.All(
+			resourceIBMPIInstanceSAPProfileDiff,
+			resourceIBMPIInstanceIBMiLicenseDiff,
+		),
+		Importer: &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -356,12 +362,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 			Arg_IBMiCSS: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "IBM i Cloud Storage Solution",
+				Description: "IBM i Cloud Storage Solution, only supported with IBM i images",
 			},
 			Arg_IBMiPHA: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "IBM i Power High Availability",
+				Description: "IBM i Power High Availability, only supported with IBM i images",
 			},
 			Attr_IBMiRDS: {
 				Type:        schema.TypeBool,
@@ -371,9 +377,10 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "IBM i Rational Dev Studio",
 			},
 			Arg_IBMiRDSUsers: {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "IBM i Rational Dev Studio Number of User Licenses",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "IBM i Rational Dev Studio Number of User Licenses, only supported with IBM i images",
 			},
 			Attr_Fault: {
 				Computed:    true,
@@ -1278,6 +1285,124 @@ func expandPVMNetworks(networks []interface{}) []*models.PVMInstanceAddNetwork {
 	return pvmNetworks
 }
 
+// resourceIBMPIInstanceSAPProfileDiff validates an SAP instance at plan time: the profile must be
+// available in the workspace, the storage type must be one supported for SAP HANA and a network
+// can be attached only once.
+func resourceIBMPIInstanceSAPProfileDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	profileID := diff.Get(PISAPInstanceProfileID).(string)
+	if profileID == "" || !diff.NewValueKnown(PISAPInstanceProfileID) {
+		return nil
+	}
+
+	if storageType := diff.Get(helpers.PIInstanceStorageType).(string); storageType != "" && diff.HasChange(helpers.PIInstanceStorageType) {
+		if storageType != "tier0" && storageType != "tier1" {
+			return fmt.Errorf("%s %s is not supported for SAP instances, use tier0 or tier1", helpers.PIInstanceStorageType, storageType)
+		}
+	}
+
+	networks := map[string]bool{}
+	for _, v := range diff.Get(PIInstanceNetwork).([]interface{}) {
+		network, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		networkID := network["network_id"].(string)
+		if networkID == "" {
+			continue
+		}
+		if networks[networkID] {
+			return fmt.Errorf("network %s is attached more than once, SAP instances need one interface per network", networkID)
+		}
+		networks[networkID] = true
+	}
+
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+	if !diff.HasChange(PISAPInstanceProfileID) || !diff.NewValueKnown(helpers.PICloudInstanceId) {
+		return nil
+	}
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	client := st.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
+	sapProfiles, err := client.GetAllSAPProfiles(cloudInstanceID)
+	if err != nil {
+		log.Printf("[DEBUG] get all sap profiles failed %v", err)
+		return err
+	}
+	available := make([]string, 0, len(sapProfiles.Profiles))
+	for _, profile := range sapProfiles.Profiles {
+		if profile.ProfileID == nil {
+			continue
+		}
+		if *profile.ProfileID == profileID {
+			if profile.Certified == nil || !*profile.Certified {
+				log.Printf("[WARN] SAP profile %s is not certified", profileID)
+			}
+			return nil
+		}
+		available = append(available, *profile.ProfileID)
+	}
+	return fmt.Errorf("SAP profile %s is not available in cloud instance %s, available profiles are: %s", profileID, cloudInstanceID, strings.Join(available, ", "))
+}
+
+// ibmiMaxProcessorsBySysType is the maximum number of processors of an IBM i instance on system types
+// licensed in the P10 IBM i software tier.
+var ibmiMaxProcessorsBySysType = map[string]float64{
+	"s922":  4,
+	"s1022": 4,
+}
+
+// resourceIBMPIInstanceIBMiLicenseDiff validates the IBM i software licenses and the processors of an
+// IBM i instance against its image and system type, the licenses of an instance of another operating
+// system are otherwise silently ignored.
+func resourceIBMPIInstanceIBMiLicenseDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges(helpers.PIInstanceImageId, helpers.PIInstanceSystemType, helpers.PIInstanceProcessors, Arg_IBMiCSS, Arg_IBMiPHA, Arg_IBMiRDSUsers) {
+		return nil
+	}
+	if !diff.NewValueKnown(helpers.PICloudInstanceId) || !diff.NewValueKnown(helpers.PIInstanceImageId) {
+		return nil
+	}
+
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+	imageID := diff.Get(helpers.PIInstanceImageId).(string)
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	imageData, err := imageClient.GetStockImage(imageID)
+	if err != nil {
+		imageData, err = imageClient.Get(imageID)
+		if err != nil {
+			log.Printf("[DEBUG] get image %s failed %v", imageID, err)
+			return nil
+		}
+	}
+	if imageData.Specifications == nil {
+		return nil
+	}
+
+	if imageData.Specifications.OperatingSystem != OS_IBMI {
+		for _, license := range []string{Arg_IBMiCSS, Arg_IBMiPHA, Arg_IBMiRDSUsers} {
+			if _, ok := diff.GetOk(license); ok {
+				return fmt.Errorf("%s is only supported with IBM i images, image %s runs %s", license, imageID, imageData.Specifications.OperatingSystem)
+			}
+		}
+		return nil
+	}
+
+	sysType := diff.Get(helpers.PIInstanceSystemType).(string)
+	maxProcessors, ok := ibmiMaxProcessorsBySysType[sysType]
+	if !ok || !diff.NewValueKnown(helpers.PIInstanceProcessors) || !diff.NewValueKnown(helpers.PIInstanceSystemType) {
+		return nil
+	}
+	if processors := diff.Get(helpers.PIInstanceProcessors).(float64); processors > maxProcessors {
+		return fmt.Errorf("IBM i instances on system type %s are licensed in the P10 software tier, which is limited to %g processors, got %g", sysType, maxProcessors, processors)
+	}
+	return nil
+}
+
 func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_capability string) bool {
 	log.Printf("Checking for the following capability %s", custom_capability)
 	log.Printf("the instance features are %s", cloudInstance.Capabilities)
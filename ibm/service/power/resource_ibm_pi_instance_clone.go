@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cloneIndexPlaceholder is replaced by the index of the clone, starting at 1, in the clone name template.
+const cloneIndexPlaceholder = "{index}"
+
+// ResourceIBMPIInstanceClone captures an instance to the image catalog and deploys clones of it.
+func ResourceIBMPIInstanceClone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstanceCloneCreate,
+		ReadContext:   resourceIBMPIInstanceCloneRead,
+		UpdateContext: resourceIBMPIInstanceCloneUpdate,
+		DeleteContext: resourceIBMPIInstanceCloneDelete,
+		CustomizeDiff: resourceIBMPIInstanceCloneNameTemplateDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CaptureName: {
+				Description:  "The name of the image the instance is captured to. It must be unique in the image catalog of the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CloneCount: {
+				Description:  "The number of clones to deploy.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_CloneNameTemplate: {
+				Description:  "The name of the clones. The " + cloneIndexPlaceholder + " placeholder is replaced by the index of the clone, starting at 1, and is required when more than one clone is deployed.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_KeepImage: {
+				Default:     false,
+				Description: "Whether the captured image is kept in the image catalog when the clones are destroyed.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_KeyPairName: {
+				Description: "The name of the SSH key of the clones.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_NetworkMappings: {
+				Description: "The networks of the source instance that are replaced by another network on the clones. The other networks of the source instance are attached to the clones as is.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_NetworkID: {
+							Description:  "The ID of the network the clones are attached to instead.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Attr_SourceNetworkID: {
+							Description:  "The ID of a network of the source instance.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			Arg_PVMInstanceId: {
+				Description:  "The ID or name of the instance to clone.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SetHostname: {
+				Default:     true,
+				Description: "Whether the host name of each clone is set to its name with cloud-init user data.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+
+			// Attributes
+			Attr_Clones: {
+				Computed:    true,
+				Description: "The deployed clones.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_HealthStatus: {
+							Computed:    true,
+							Description: "The health status of the clone.",
+							Type:        schema.TypeString,
+						},
+						Attr_InstanceID: {
+							Computed:    true,
+							Description: "The ID of the clone.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the clone.",
+							Type:        schema.TypeString,
+						},
+						Attr_Networks: {
+							Computed:    true,
+							Description: "The networks of the clone.",
+							Type:        schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_IP: {
+										Computed:    true,
+										Description: "The IP address of the clone in the network.",
+										Type:        schema.TypeString,
+									},
+									Attr_NetworkID: {
+										Computed:    true,
+										Description: "The ID of the network.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the clone.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			Attr_ImageID: {
+				Computed:    true,
+				Description: "The ID of the captured image.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIInstanceCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_PVMInstanceId).(string)
+	captureName := d.Get(Arg_CaptureName).(string)
+	cloneCount := d.Get(Arg_CloneCount).(int)
+	nameTemplate := d.Get(Arg_CloneNameTemplate).(string)
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	source, err := client.Get(instanceID)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error getting the source instance %s: %s", instanceID, err)
+	}
+
+	// capture the source instance to the image catalog
+	destination := imageCatalogDestination
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(instanceID, &models.PVMInstanceCapture{
+		CaptureDestination: &destination,
+		CaptureName:        &captureName,
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] Error capturing instance %s: %s", instanceID, err)
+	}
+	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// no ID is set yet, so the image of a failed capture is removed here instead of on destroy
+		if deleteErr := imageClient.Delete(captureName); deleteErr != nil {
+			switch errors.Unwrap(deleteErr).(type) {
+			case *p_cloud_images.PcloudCloudinstancesImagesDeleteNotFound, *p_cloud_images.PcloudCloudinstancesImagesDeleteGone:
+				log.Printf("[DEBUG] captured image %s of the failed capture does not exist %v", captureName, deleteErr)
+			default:
+				log.Printf("[WARN] Error deleting captured image %s of the failed capture: %s", captureName, deleteErr)
+			}
+		}
+		return diag.Errorf("[ERROR] Error waiting for the capture of instance %s: %s", instanceID, err)
+	}
+	image, err := imageClient.Get(captureName)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error getting the captured image %s: %s", captureName, err)
+	}
+
+	// the ID is set as soon as the image exists so that a failed deployment can be cleaned up
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, captureName))
+	d.Set(Attr_ImageID, image.ImageID)
+
+	networks := expandPIInstanceCloneNetworks(source.Networks, d.Get(Arg_NetworkMappings).([]interface{}))
+	cloneIDs := make([]string, 0, cloneCount)
+	for i := 1; i <= cloneCount; i++ {
+		name := strings.ReplaceAll(nameTemplate, cloneIndexPlaceholder, strconv.Itoa(i))
+		body := &models.PVMInstanceCreate{
+			ImageID:    image.ImageID,
+			Memory:     source.Memory,
+			Networks:   networks,
+			ProcType:   source.ProcType,
+			Processors: source.Processors,
+			ServerName: flex.PtrToString(name),
+			SysType:    source.SysType,
+		}
+		if source.StorageType != nil {
+			body.StorageType = *source.StorageType
+		}
+		if keyPairName, ok := d.GetOk(Arg_KeyPairName); ok {
+			body.KeyPairName = keyPairName.(string)
+		}
+		if d.Get(Arg_SetHostname).(bool) {
+			body.UserData = encodeBase64(fmt.Sprintf("#cloud-config\npreserve_hostname: false\nhostname: %s\n", name))
+		}
+
+		pvmList, err := client.Create(body)
+		if err != nil {
+			d.Set(Attr_Clones, flattenPIInstanceClones(cloneIDs, nil))
+			return diag.Errorf("[ERROR] Error deploying clone %s: %s", name, err)
+		}
+		for _, pvm := range *pvmList {
+			cloneIDs = append(cloneIDs, *pvm.PvmInstanceID)
+		}
+	}
+	d.Set(Attr_Clones, flattenPIInstanceClones(cloneIDs, nil))
+
+	// the clones are deployed in parallel, so they are polled concurrently
+	errs := make([]error, len(cloneIDs))
+	var wg sync.WaitGroup
+	for i, cloneID := range cloneIDs {
+		wg.Add(1)
+		go func(i int, cloneID string) {
+			defer wg.Done()
+			_, errs[i] = isWaitForPIInstanceAvailable(ctx, client, cloneID, helpers.PIInstanceHealthOk)
+		}(i, cloneID)
+	}
+	wg.Wait()
+	var diags diag.Diagnostics
+	for i, err := range errs {
+		if err != nil {
+			diags = append(diags, diag.Errorf("[ERROR] Error waiting for clone %s to be available: %s", cloneIDs[i], err)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceIBMPIInstanceCloneRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceCloneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := parts[0]
+	captureName := parts[1]
+
+	imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	image, err := imageClient.Get(captureName)
+	if err != nil {
+		switch errors.Unwrap(err).(type) {
+		case *p_cloud_images.PcloudCloudinstancesImagesGetNotFound:
+			// the clones outlive their image, which can be deleted independently
+			log.Printf("[DEBUG] captured image %s does not exist %v", captureName, err)
+			d.Set(Attr_ImageID, "")
+		default:
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set(Attr_ImageID, image.ImageID)
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	cloneIDs := []string{}
+	clones := map[string]*models.PVMInstance{}
+	for _, c := range d.Get(Attr_Clones).([]interface{}) {
+		cloneID := c.(map[string]interface{})[Attr_InstanceID].(string)
+		clone, err := client.Get(cloneID)
+		if err != nil {
+			switch errors.Unwrap(err).(type) {
+			case *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound:
+				log.Printf("[DEBUG] clone %s does not exist %v", cloneID, err)
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		cloneIDs = append(cloneIDs, cloneID)
+		clones[cloneID] = clone
+	}
+	if len(cloneIDs) == 0 {
+		log.Printf("[WARN] none of the clones of %s exist anymore, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_CaptureName, captureName)
+	d.Set(Attr_Clones, flattenPIInstanceClones(cloneIDs, clones))
+	return nil
+}
+
+// resourceIBMPIInstanceCloneUpdate only records pi_keep_image, all the other arguments force a new resource.
+func resourceIBMPIInstanceCloneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMPIInstanceCloneRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := parts[0]
+	captureName := parts[1]
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	cloneIDs := []string{}
+	for _, c := range d.Get(Attr_Clones).([]interface{}) {
+		cloneID := c.(map[string]interface{})[Attr_InstanceID].(string)
+		err = client.Delete(cloneID)
+		if err != nil {
+			switch errors.Unwrap(err).(type) {
+			case *p_cloud_p_vm_instances.PcloudPvminstancesDeleteNotFound, *p_cloud_p_vm_instances.PcloudPvminstancesDeleteGone:
+				log.Printf("[DEBUG] clone %s does not exist while deleting %v", cloneID, err)
+				continue
+			}
+			return diag.Errorf("[ERROR] Error deleting clone %s: %s", cloneID, err)
+		}
+		cloneIDs = append(cloneIDs, cloneID)
+	}
+	for _, cloneID := range cloneIDs {
+		_, err = isWaitForPIInstanceDeleted(ctx, client, cloneID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if !d.Get(Arg_KeepImage).(bool) {
+		imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		err = imageClient.Delete(captureName)
+		if err != nil {
+			switch errors.Unwrap(err).(type) {
+			case *p_cloud_images.PcloudCloudinstancesImagesDeleteNotFound, *p_cloud_images.PcloudCloudinstancesImagesDeleteGone:
+				log.Printf("[DEBUG] captured image %s does not exist while deleting %v", captureName, err)
+			default:
+				return diag.Errorf("[ERROR] Error deleting captured image %s: %s", captureName, err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIInstanceCloneNameTemplateDiff rejects a name template without the index placeholder when
+// more than one clone is deployed, as the clones would all get the same name.
+func resourceIBMPIInstanceCloneNameTemplateDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(Arg_CloneCount) || !diff.NewValueKnown(Arg_CloneNameTemplate) {
+		return nil
+	}
+	if diff.Get(Arg_CloneCount).(int) > 1 && !strings.Contains(diff.Get(Arg_CloneNameTemplate).(string), cloneIndexPlaceholder) {
+		return fmt.Errorf("%s must contain %s when %s is greater than 1", Arg_CloneNameTemplate, cloneIndexPlaceholder, Arg_CloneCount)
+	}
+	return nil
+}
+
+// expandPIInstanceCloneNetworks attaches the clones to the networks of the source instance, replacing
+// the mapped networks. The IP addresses are not copied, as they are assigned to the source instance.
+func expandPIInstanceCloneNetworks(sourceNetworks []*models.PVMInstanceNetwork, mappings []interface{}) []*models.PVMInstanceAddNetwork {
+	networkIDs := map[string]string{}
+	for _, m := range mappings {
+		mapping := m.(map[string]interface{})
+		networkIDs[mapping[Attr_SourceNetworkID].(string)] = mapping[Attr_NetworkID].(string)
+	}
+	networks := make([]*models.PVMInstanceAddNetwork, 0, len(sourceNetworks))
+	for _, n := range sourceNetworks {
+		if n == nil {
+			continue
+		}
+		networkID := n.NetworkID
+		if mapped, ok := networkIDs[networkID]; ok {
+			networkID = mapped
+		}
+		networks = append(networks, &models.PVMInstanceAddNetwork{
+			NetworkID: flex.PtrToString(networkID),
+		})
+	}
+	return networks
+}
+
+func flattenPIInstanceClones(cloneIDs []string, clones map[string]*models.PVMInstance) []map[string]interface{} {
+	cloneList := make([]map[string]interface{}, 0, len(cloneIDs))
+	for _, cloneID := range cloneIDs {
+		c := map[string]interface{}{
+			Attr_InstanceID: cloneID,
+		}
+		if clone, ok := clones[cloneID]; ok {
+			if clone.ServerName != nil {
+				c[Attr_Name] = *clone.ServerName
+			}
+			if clone.Status != nil {
+				c[Attr_Status] = *clone.Status
+			}
+			if clone.Health != nil {
+				c[Attr_HealthStatus] = clone.Health.Status
+			}
+			networks := make([]map[string]interface{}, 0, len(clone.Networks))
+			for _, n := range clone.Networks {
+				if n != nil {
+					networks = append(networks, map[string]interface{}{
+						Attr_IP:        n.IPAddress,
+						Attr_NetworkID: n.NetworkID,
+					})
+				}
+			}
+			c[Attr_Networks] = networks
+		}
+		cloneList = append(cloneList, c)
+	}
+	return cloneList
+}
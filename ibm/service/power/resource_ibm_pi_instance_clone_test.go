@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIInstanceClone_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-clone-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceCloneConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_pi_instance_clone.clone", "image_id"),
+					resource.TestCheckResourceAttr("ibm_pi_instance_clone.clone", "clones.#", "2"),
+					resource.TestCheckResourceAttr("ibm_pi_instance_clone.clone", "clones.0.name", name+"-1"),
+					resource.TestCheckResourceAttr("ibm_pi_instance_clone.clone", "clones.1.name", name+"-2"),
+					resource.TestCheckResourceAttr("ibm_pi_instance_clone.clone", "clones.0.status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIInstanceClone_nameTemplate(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-clone-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "ibm_pi_instance_clone" "clone" {
+						pi_cloud_instance_id   = "%[1]s"
+						pi_instance_id         = "%[2]s"
+						pi_capture_name        = "%[3]s-capture"
+						pi_clone_count         = 2
+						pi_clone_name_template = "%[3]s"
+					}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("pi_clone_name_template must contain {index}"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceCloneConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_instance_clone" "clone" {
+			pi_cloud_instance_id   = "%[1]s"
+			pi_instance_id         = "%[2]s"
+			pi_capture_name        = "%[3]s-capture"
+			pi_clone_count         = 2
+			pi_clone_name_template = "%[3]s-{index}"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, name)
+}
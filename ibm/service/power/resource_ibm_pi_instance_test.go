@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -359,6 +360,47 @@ func TestAccIBMPIInstanceIBMiLicense(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceIBMiProcessorLimit(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIInstanceIBMiProcessorsConfig(name, "5"),
+				ExpectError: regexp.MustCompile("licensed in the P10 software tier"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceIBMiProcessorsConfig(name, processors string) string {
+	return fmt.Sprintf(`
+		data "ibm_pi_image" "power_image" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_image_name        = "%[3]s"
+		}
+		data "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_network_name      = "%[4]s"
+		}
+		resource "ibm_pi_instance" "power_instance" {
+			pi_memory             = "8"
+			pi_processors         = "%[5]s"
+			pi_instance_name      = "%[2]s"
+			pi_proc_type          = "shared"
+			pi_image_id           = data.ibm_pi_image.power_image.id
+			pi_sys_type           = "s922"
+			pi_cloud_instance_id  = "%[1]s"
+			pi_storage_pool       = data.ibm_pi_image.power_image.storage_pool
+			pi_network {
+				network_id = data.ibm_pi_network.power_networks.id
+			}
+			pi_ibmi_css           = true
+		}`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, processors)
+}
+
 func TestAccIBMPIInstanceReplicant(t *testing.T) {
 	instanceRes := "ibm_pi_instance.power_instance"
 	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMPIKeySync mirrors a set of SSH keys across several workspaces. Only the keys the resource
+// created are replaced or deleted, other keys of the workspaces are left untouched.
+func ResourceIBMPIKeySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIKeySyncCreate,
+		ReadContext:   resourceIBMPIKeySyncRead,
+		UpdateContext: resourceIBMPIKeySyncUpdate,
+		DeleteContext: resourceIBMPIKeySyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceIDs: {
+				Description: "The GUIDs of the service instances of the workspaces the SSH keys are mirrored to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_SSHKeys: {
+				Description: "The SSH keys that are mirrored to every workspace.",
+				MinItems:    1,
+				Required:    true,
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Name: {
+							Description:  "User defined name for the SSH key.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Attr_SSHKey: {
+							Description:  "SSH RSA key.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			// Attributes
+			Attr_CreatedKeys: {
+				Computed:    true,
+				Description: "The SSH keys that were created by the resource. Only these keys are replaced or deleted by the resource.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Name: {
+							Computed:    true,
+							Description: "User defined name for the SSH key.",
+							Type:        schema.TypeString,
+						},
+						Attr_SSHKey: {
+							Computed:    true,
+							Description: "SSH RSA key.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			Attr_Workspaces: {
+				Computed:    true,
+				Description: "The managed SSH keys that are in sync in each workspace.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CloudInstanceID: {
+							Computed:    true,
+							Description: "The GUID of the service instance of the workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_KeyNames: {
+							Computed:    true,
+							Description: "The names of the managed SSH keys that are in sync in the workspace.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPIKeySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspaces := flex.ExpandStringList(d.Get(Arg_CloudInstanceIDs).(*schema.Set).List())
+	keys := expandPIKeySyncKeys(d.Get(Arg_SSHKeys).(*schema.Set).List())
+
+	// the id is set before the keys are created, so that the keys created before a failure are
+	// recorded in the state and deleted on destroy
+	d.SetId(id.UniqueId())
+	created, err := syncPIKeys(ctx, sess, workspaces, nil, keys, map[string]string{})
+	d.Set(Attr_CreatedKeys, flattenPIKeySyncKeys(created))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIKeySyncRead(ctx, d, meta)
+}
+
+func resourceIBMPIKeySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspaces := flex.ExpandStringList(d.Get(Arg_CloudInstanceIDs).(*schema.Set).List())
+	keys := expandPIKeySyncKeys(d.Get(Arg_SSHKeys).(*schema.Set).List())
+
+	// a key stays in the state only when it is in sync in every workspace, so that any drift is
+	// reconciled by the next apply
+	inSync := map[string]int{}
+	workspaceList := make([]map[string]interface{}, 0, len(workspaces))
+	for _, cloudInstanceID := range workspaces {
+		existing, err := getPIKeySyncWorkspaceKeys(ctx, sess, cloudInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		keyNames := []string{}
+		for name, sshKey := range keys {
+			if existingKey, ok := existing[name]; ok && piKeySyncEqual(existingKey, sshKey) {
+				keyNames = append(keyNames, name)
+				inSync[name]++
+			}
+		}
+		sort.Strings(keyNames)
+		workspaceList = append(workspaceList, map[string]interface{}{
+			Attr_CloudInstanceID: cloudInstanceID,
+			Attr_KeyNames:        keyNames,
+		})
+	}
+
+	keyList := make([]interface{}, 0, len(keys))
+	for name, sshKey := range keys {
+		if inSync[name] == len(workspaces) {
+			keyList = append(keyList, map[string]interface{}{
+				Attr_Name:   name,
+				Attr_SSHKey: sshKey,
+			})
+		}
+	}
+	d.Set(Arg_SSHKeys, keyList)
+	d.Set(Attr_Workspaces, workspaceList)
+
+	return nil
+}
+
+func resourceIBMPIKeySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// workspaces that are removed from the set are only no longer reconciled. SSH keys belong to the
+	// tenant rather than to a workspace, so deleting them from a removed workspace would also delete
+	// them from the workspaces that are still configured.
+	if d.HasChanges(Arg_CloudInstanceIDs, Arg_SSHKeys) {
+		oldKeys, newKeys := d.GetChange(Arg_SSHKeys)
+		created, err := syncPIKeys(ctx, sess,
+			flex.ExpandStringList(d.Get(Arg_CloudInstanceIDs).(*schema.Set).List()),
+			expandPIKeySyncKeys(oldKeys.(*schema.Set).List()),
+			expandPIKeySyncKeys(newKeys.(*schema.Set).List()),
+			expandPIKeySyncKeys(d.Get(Attr_CreatedKeys).(*schema.Set).List()))
+		d.Set(Attr_CreatedKeys, flattenPIKeySyncKeys(created))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIKeySyncRead(ctx, d, meta)
+}
+
+func resourceIBMPIKeySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workspaces := flex.ExpandStringList(d.Get(Arg_CloudInstanceIDs).(*schema.Set).List())
+	keys := expandPIKeySyncKeys(d.Get(Arg_SSHKeys).(*schema.Set).List())
+	created, err := syncPIKeys(ctx, sess, workspaces, keys, nil, expandPIKeySyncKeys(d.Get(Attr_CreatedKeys).(*schema.Set).List()))
+	if err != nil {
+		d.Set(Attr_CreatedKeys, flattenPIKeySyncKeys(created))
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// syncPIKeys reconciles the keys of the workspaces and returns the keys created by the resource.
+// Missing keys are created in every workspace. Keys that are no longer configured, or whose value
+// changed, are deleted or replaced only when the resource created them: SSH keys are shared by all
+// the workspaces of a tenant, so a key with the same name may belong to someone else.
+func syncPIKeys(ctx context.Context, sess *ibmpisession.IBMPISession, workspaces []string, oldKeys, newKeys, created map[string]string) (map[string]string, error) {
+	// workspaces of different tenants may still hold the previous value of a key that is replaced
+	previous := map[string]string{}
+	for name, sshKey := range created {
+		previous[name] = sshKey
+	}
+	for _, cloudInstanceID := range workspaces {
+		client := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
+		// keys are listed again for every workspace, as the keys created for a previous workspace of
+		// the same tenant already exist in it
+		existing, err := getPIKeySyncWorkspaceKeys(ctx, sess, cloudInstanceID)
+		if err != nil {
+			return created, err
+		}
+		for name := range oldKeys {
+			if _, ok := newKeys[name]; ok {
+				continue
+			}
+			createdKey, ok := previous[name]
+			if !ok {
+				continue
+			}
+			if existingKey, ok := existing[name]; ok && piKeySyncEqual(existingKey, createdKey) {
+				log.Printf("[DEBUG] Deleting SSH key %s from workspace %s", name, cloudInstanceID)
+				if err = client.Delete(name); err != nil {
+					return created, fmt.Errorf("[ERROR] Error deleting SSH key %s from workspace %s: %s", name, cloudInstanceID, err)
+				}
+			}
+		}
+		for name, sshKey := range newKeys {
+			existingKey, ok := existing[name]
+			if ok && piKeySyncEqual(existingKey, sshKey) {
+				continue
+			}
+			if ok {
+				if createdKey, isCreated := previous[name]; !isCreated || !piKeySyncEqual(existingKey, createdKey) {
+					return created, fmt.Errorf("[ERROR] SSH key %s already exists in workspace %s with a different value and was not created by this resource", name, cloudInstanceID)
+				}
+				log.Printf("[DEBUG] Replacing SSH key %s in workspace %s", name, cloudInstanceID)
+				if err = client.Delete(name); err != nil {
+					return created, fmt.Errorf("[ERROR] Error deleting SSH key %s from workspace %s: %s", name, cloudInstanceID, err)
+				}
+			}
+			keyName, keyValue := name, sshKey
+			_, err = client.Create(&models.SSHKey{
+				Name:   &keyName,
+				SSHKey: &keyValue,
+			})
+			if err != nil {
+				return created, fmt.Errorf("[ERROR] Error creating SSH key %s in workspace %s: %s", name, cloudInstanceID, err)
+			}
+			created[name] = sshKey
+		}
+	}
+
+	for name := range oldKeys {
+		if _, ok := newKeys[name]; !ok {
+			delete(created, name)
+		}
+	}
+	return created, nil
+}
+
+// getPIKeySyncWorkspaceKeys returns the SSH keys of the workspace by name.
+func getPIKeySyncWorkspaceKeys(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (map[string]string, error) {
+	client := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
+	sshKeys, err := client.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the SSH keys of workspace %s: %s", cloudInstanceID, err)
+	}
+	keys := map[string]string{}
+	for _, sshKey := range sshKeys.SSHKeys {
+		if sshKey.Name == nil || sshKey.SSHKey == nil {
+			continue
+		}
+		keys[*sshKey.Name] = *sshKey.SSHKey
+	}
+	return keys, nil
+}
+
+func expandPIKeySyncKeys(keyList []interface{}) map[string]string {
+	keys := map[string]string{}
+	for _, k := range keyList {
+		key := k.(map[string]interface{})
+		keys[key[Attr_Name].(string)] = key[Attr_SSHKey].(string)
+	}
+	return keys
+}
+
+func flattenPIKeySyncKeys(keys map[string]string) []interface{} {
+	keyList := make([]interface{}, 0, len(keys))
+	for name, sshKey := range keys {
+		keyList = append(keyList, map[string]interface{}{
+			Attr_Name:   name,
+			Attr_SSHKey: sshKey,
+		})
+	}
+	return keyList
+}
+
+func piKeySyncEqual(a, b string) bool {
+	return strings.TrimSpace(a) == strings.TrimSpace(b)
+}
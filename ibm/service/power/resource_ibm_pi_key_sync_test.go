@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIKeySync_basic(t *testing.T) {
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	name := fmt.Sprintf("tf-pi-sshkey-sync-%d", acctest.RandIntRange(10, 100))
+	rotatedName := fmt.Sprintf("%s-rotated", name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIKeySyncConfig(publicKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "pi_ssh_keys.#", "1"),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "workspaces.#", "1"),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "workspaces.0.key_names.0", name),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "created_keys.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIKeySyncConfig(publicKey, rotatedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "pi_ssh_keys.#", "1"),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "workspaces.0.key_names.#", "1"),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "workspaces.0.key_names.0", rotatedName),
+					resource.TestCheckResourceAttr("ibm_pi_key_sync.sync", "created_keys.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_pi_key_sync.sync", "created_keys.*", map[string]string{
+						"name": rotatedName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIKeySyncConfig(publicKey, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_key_sync" "sync" {
+			pi_cloud_instance_ids = ["%s"]
+			pi_ssh_keys {
+				name    = "%s"
+				ssh_key = "%s"
+			}
+		  }`, acc.Pi_cloud_instance_id, name, publicKey)
+}
@@ -59,7 +59,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.ValidateIP},
 				Description: "List of PI network DNS name",
 			},
 			helpers.PINetworkCidr: {
@@ -69,10 +69,11 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Description: "PI network CIDR",
 			},
 			helpers.PINetworkGateway: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "PI network gateway",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "PI network gateway",
 			},
 			helpers.PINetworkJumbo: {
 				Type:          schema.TypeBool,
@@ -187,6 +188,9 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 
 		if g, ok := d.GetOk(helpers.PINetworkGateway); ok {
 			gateway = g.(string)
+			if err := validateIPInNetworkCidr(networkcidr, gateway); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] invalid %s: %s", helpers.PINetworkGateway, err))
+			}
 		}
 
 		if ips, ok := d.GetOk(helpers.PINetworkIPAddressRange); ok {
@@ -276,9 +280,17 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 		body := &models.NetworkUpdate{
 			DNSServers: flex.ExpandStringList((d.Get(helpers.PINetworkDNS).(*schema.Set)).List()),
 		}
+
+		// gateway and ip address ranges can only be configured on private networks
 		if d.Get(helpers.PINetworkType).(string) == "vlan" {
-			body.Gateway = flex.PtrToString(d.Get(helpers.PINetworkGateway).(string))
+			gateway := d.Get(helpers.PINetworkGateway).(string)
+			if err := validateIPInNetworkCidr(d.Get(helpers.PINetworkCidr).(string), gateway); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] invalid %s: %s", helpers.PINetworkGateway, err))
+			}
+			body.Gateway = &gateway
 			body.IPAddressRanges = getIPAddressRanges(d.Get(helpers.PINetworkIPAddressRange).([]interface{}))
+		} else if d.HasChanges(helpers.PINetworkGateway, helpers.PINetworkIPAddressRange) {
+			return diag.Errorf("%s and %s can only be updated when %s is vlan", helpers.PINetworkGateway, helpers.PINetworkIPAddressRange, helpers.PINetworkType)
 		}
 
 		if d.HasChange(helpers.PINetworkName) {
@@ -289,6 +301,11 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		_, err = isWaitForIBMPINetworkAvailable(ctx, networkC, networkID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMPINetworkRead(ctx, d, meta)
@@ -391,6 +408,21 @@ func generateIPData(cdir string) (gway, firstip, lastip string, err error) {
 
 }
 
+// validateIPInNetworkCidr checks that the ip address belongs to the cidr of the network.
+func validateIPInNetworkCidr(networkCidr, ip string) error {
+	if networkCidr == "" {
+		return nil
+	}
+	_, ipv4Net, err := net.ParseCIDR(networkCidr)
+	if err != nil {
+		return err
+	}
+	if !ipv4Net.Contains(net.ParseIP(ip)) {
+		return fmt.Errorf("%s is not within the network cidr %s", ip, networkCidr)
+	}
+	return nil
+}
+
 func getIPAddressRanges(ipAddressRanges []interface{}) []*models.IPAddressRange {
 	ipRanges := make([]*models.IPAddressRange, 0, len(ipAddressRanges))
 	for _, v := range ipAddressRanges {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -89,6 +90,21 @@ func TestAccIBMPINetworkGatewaybasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPINetworkGatewayOutsideCidr(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPINetworkGatewayOutsideCidrConfig(name),
+				ExpectError: regexp.MustCompile("is not within the network cidr"),
+			},
+		},
+	})
+}
+
 func TestAccIBMPINetworkGatewaybasicSatellite(t *testing.T) {
 	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
 	resource.Test(t, resource.TestCase{
@@ -240,3 +256,16 @@ func testAccCheckIBMPINetworkGatewayConfigSatellite(name string) string {
 		}
 	`, acc.Pi_cloud_instance_id, name)
 }
+
+func testAccCheckIBMPINetworkGatewayOutsideCidrConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id = "%s"
+			pi_network_name      = "%s"
+			pi_network_type      = "vlan"
+			pi_dns               = ["127.0.0.1"]
+			pi_gateway           = "192.168.18.1"
+			pi_cidr              = "192.168.17.0/24"
+		}
+	`, acc.Pi_cloud_instance_id, name)
+}
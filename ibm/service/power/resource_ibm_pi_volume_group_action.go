@@ -6,6 +6,8 @@ package power
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -14,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/sl"
 )
@@ -48,7 +51,7 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 				ForceNew:    true,
 				MaxItems:    1,
 				MinItems:    1,
-				Description: "Performs an action (start stop reset ) on a volume group(one at a time).",
+				Description: "Performs an action (start stop reset failover failback) on a volume group(one at a time).",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"start": {
@@ -95,6 +98,40 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 								},
 							},
 						},
+						"failover": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							ForceNew:    true,
+							Description: "Makes the aux volumes accessible and, unless disabled, restarts the replication from aux to master.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_replication": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Restart the replication from aux after the failover.",
+									},
+								},
+							},
+						},
+						"failback": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							ForceNew:    true,
+							Description: "Makes the volumes accessible and, unless disabled, restarts the replication from master to aux.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_replication": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Restart the replication from master after the failback.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -115,6 +152,16 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 				Computed:    true,
 				Description: "Volume Group Replication Status",
 			},
+			"replication_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the remote copy relationships of the volume group",
+			},
+			"primary_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether master or aux is the primary of the remote copy relationships",
+			},
 		},
 	}
 }
@@ -126,25 +173,35 @@ func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	vgID := d.Get(PIVolumeGroupID).(string)
-	vgAction, err := expandVolumeGroupAction(d.Get(PIVolumeGroupAction).([]interface{}))
+	vgActions, err := expandVolumeGroupActions(d.Get(PIVolumeGroupAction).([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
-	body := vgAction
-
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
-	_, err = client.VolumeGroupAction(vgID, body)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 
-	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
-
-	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
-	if err != nil {
-		return diag.FromErr(err)
+	// Failover and failback run a stop and a start, each one waits for the
+	// remote copy relationships to settle before the next is sent.
+	for _, body := range vgActions {
+		_, err = client.VolumeGroupAction(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if states := volumeGroupActionTargetStates(body); len(states) != 0 {
+			_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, states, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
@@ -172,6 +229,15 @@ func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceD
 	d.Set("volume_group_status", vg.Status)
 	d.Set("replication_status", vg.ReplicationStatus)
 
+	// Volume groups without replication have no remote copy relationships.
+	vgDetails, err := client.GetVolumeGroupLiveDetails(vgID)
+	if err != nil {
+		log.Printf("[DEBUG] get volume group live details failed %v", err)
+	} else {
+		d.Set("replication_state", vgDetails.State)
+		d.Set("primary_role", vgDetails.PrimaryRole)
+	}
+
 	return nil
 }
 
@@ -181,6 +247,45 @@ func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// expandVolumeGroupActions retrieve the volume group actions to run in order for the
+// pi_volume_group_action block, failover and failback expand to a stop and a start.
+func expandVolumeGroupActions(data []interface{}) ([]*models.VolumeGroupAction, error) {
+	if len(data) == 0 || data[0] == nil {
+		return nil, fmt.Errorf("[ERROR] no pi_volume_group_action received")
+	}
+
+	action := data[0].(map[string]interface{})
+	for _, key := range []string{"failover", "failback"} {
+		v, ok := action[key].([]interface{})
+		if !ok || len(v) == 0 {
+			continue
+		}
+		startReplication := true
+		if m, ok := v[0].(map[string]interface{}); ok {
+			startReplication = m["start_replication"].(bool)
+		}
+		vgActions := []*models.VolumeGroupAction{
+			{Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)}},
+		}
+		if startReplication {
+			source := "aux"
+			if key == "failback" {
+				source = "master"
+			}
+			vgActions = append(vgActions, &models.VolumeGroupAction{
+				Start: &models.VolumeGroupActionStart{Source: sl.String(source)},
+			})
+		}
+		return vgActions, nil
+	}
+
+	vgAction, err := expandVolumeGroupAction(data)
+	if err != nil {
+		return nil, err
+	}
+	return []*models.VolumeGroupAction{vgAction}, nil
+}
+
 // expandVolumeGroupAction retrieve volume group action resource
 func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
 	if len(data) == 0 {
@@ -242,3 +347,52 @@ func expandVolumeGroupResetAction(reset []interface{}) *models.VolumeGroupAction
 		Status: sl.String(s["status"].(string)),
 	}
 }
+
+// volumeGroupActionTargetStates returns the states of the remote copy relationships
+// reached once the action completes, none for a reset.
+func volumeGroupActionTargetStates(vgAction *models.VolumeGroupAction) []string {
+	switch {
+	case vgAction.Start != nil:
+		return []string{"consistent_copying", "consistent_synchronized"}
+	case vgAction.Stop != nil && vgAction.Stop.Access != nil && *vgAction.Stop.Access:
+		return []string{"idling"}
+	case vgAction.Stop != nil:
+		return []string{"consistent_stopped", "inconsistent_stopped"}
+	}
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, states []string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the remote copy relationships of Volume Group (%s) to be %s.", id, strings.Join(states, " or "))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", "pending"},
+		Target:     []string{"done"},
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id, states),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *st.IBMPIVolumeGroupClient, id string, states []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, state := range states {
+			if vg.State == state {
+				return vg, "done", nil
+			}
+		}
+		if strings.HasSuffix(vg.State, "_disconnected") {
+			return vg, "", fmt.Errorf("[ERROR] the remote copy relationships of volume group %s are %s", id, vg.State)
+		}
+
+		return vg, "pending", nil
+	}
+}
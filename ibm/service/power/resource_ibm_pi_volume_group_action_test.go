@@ -45,6 +45,32 @@ func TestAccIBMPIVolumeGroupActionbasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIVolumeGroupActionFailover(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-action-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailActionConfig(name, "failover"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupActionExists("ibm_pi_volume_group_action.power_volume_group_action"),
+					resource.TestCheckResourceAttrSet("ibm_pi_volume_group_action.power_volume_group_action", "replication_state"),
+					resource.TestCheckResourceAttrSet("ibm_pi_volume_group_action.power_volume_group_action", "primary_role"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailActionConfig(name, "failback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupActionExists("ibm_pi_volume_group_action.power_volume_group_action"),
+					resource.TestCheckResourceAttrSet("ibm_pi_volume_group_action.power_volume_group_action", "replication_state"),
+					resource.TestCheckResourceAttr("ibm_pi_volume_group_action.power_volume_group_action", "primary_role", "master"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIVolumeGroupActionExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -105,3 +131,15 @@ func testAccCheckIBMPIVolumeGroupStartActionConfig(name string) string {
 	  }
 	`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIVolumeGroupFailActionConfig(name, action string) string {
+	return testAccCheckIBMPIVolumeGroupConfig(name) + fmt.Sprintf(`
+	  resource "ibm_pi_volume_group_action" "power_volume_group_action" {
+		pi_cloud_instance_id   = "%[1]s"
+		pi_volume_group_id     = ibm_pi_volume_group.power_volume_group.volume_group_id
+		pi_volume_group_action {
+			%[2]s {}
+		}
+	  }
+	`, acc.Pi_cloud_instance_id, action)
+}
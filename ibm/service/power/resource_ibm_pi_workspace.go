@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -21,6 +23,7 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 		CreateContext: resourceIBMPIWorkspaceCreate,
 		ReadContext:   resourceIBMPIWorkspaceRead,
 		DeleteContext: resourceIBMPIWorkspaceDelete,
+		CustomizeDiff: resourceIBMPIWorkspaceCapabilitiesDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -52,6 +55,14 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{Private, Public}),
 			},
+			Arg_RequiredCapabilities: {
+				Description: "Capabilities the datacenter must support, for example power-edge-router; the plan fails when the datacenter lacks one of them.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
 			Arg_ResourceGroupID: {
 				Description:  "The ID of the resource group where you want to create the workspace. You can retrieve the value from data source ibm_resource_group.",
 				ForceNew:     true,
@@ -61,6 +72,11 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 			},
 
 			// Attributes
+			Attr_PEREnabled: {
+				Computed:    true,
+				Description: "Indicates if the workspace is attached to an active Power Edge Router.",
+				Type:        schema.TypeBool,
+			},
 			Attr_WorkspaceDetails: {
 				Computed:    true,
 				Description: "Workspace information.",
@@ -144,9 +160,63 @@ func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set(Attr_WorkspaceDetails, flex.Flatten(wsDetails))
 
+	wsData, err := client.Get(cloudInstanceID)
+	if err != nil {
+		log.Printf("[DEBUG] get workspace %s failed %v", cloudInstanceID, err)
+	} else if wsData.Details != nil {
+		perEnabled := wsData.Details.PowerEdgeRouter != nil && wsData.Details.PowerEdgeRouter.State != nil && *wsData.Details.PowerEdgeRouter.State == State_Active
+		d.Set(Attr_PEREnabled, perEnabled)
+	}
+
 	return nil
 }
 
+// resourceIBMPIWorkspaceCapabilitiesDiff validates the required capabilities against the capabilities of
+// the datacenter at plan time, a workspace can otherwise be created in a datacenter that can not host it.
+func resourceIBMPIWorkspaceCapabilitiesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges(Arg_Datacenter, Arg_RequiredCapabilities) {
+		return nil
+	}
+	if !diff.NewValueKnown(Arg_Datacenter) || !diff.NewValueKnown(Arg_RequiredCapabilities) {
+		return nil
+	}
+	required := diff.Get(Arg_RequiredCapabilities).(*schema.Set)
+	if required.Len() == 0 {
+		return nil
+	}
+
+	datacenter := diff.Get(Arg_Datacenter).(string)
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	client := instance.NewIBMPIDatacenterClient(ctx, sess, "")
+	dcData, err := client.Get(datacenter)
+	if err != nil {
+		log.Printf("[DEBUG] get datacenter %s failed %v", datacenter, err)
+		return fmt.Errorf("[ERROR] Error getting the capabilities of datacenter %s: %s", datacenter, err)
+	}
+
+	missing := []string{}
+	for _, capability := range flex.ExpandStringList(required.List()) {
+		if !dcData.Capabilities[capability] {
+			missing = append(missing, capability)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	available := []string{}
+	for capability, supported := range dcData.Capabilities {
+		if supported {
+			available = append(available, capability)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(available)
+	return fmt.Errorf("datacenter %s does not support the capabilities %s, the supported capabilities are: %s", datacenter, strings.Join(missing, ", "), strings.Join(available, ", "))
+}
+
 func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
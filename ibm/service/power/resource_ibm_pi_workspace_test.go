@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -44,6 +45,39 @@ func testAccCheckIBMPIWorkspaceConfig(name string) string {
 	`, name, acc.Pi_resource_group_id)
 }
 
+func TestAccIBMPIWorkspaceRequiredCapabilities(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIWorkspaceCapabilitiesConfig(name, "tf-unsupported-capability"),
+				ExpectError: regexp.MustCompile("does not support the capabilities tf-unsupported-capability"),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceCapabilitiesConfig(name, "power-edge-router"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "per_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceCapabilitiesConfig(name, capability string) string {
+	return fmt.Sprintf(`
+	 resource "ibm_pi_workspace" "powervs_service_instance" {
+		pi_name                  = "%[1]s"
+		pi_datacenter            = "dal10"
+		pi_resource_group_id     = "%[2]s"
+		pi_required_capabilities = ["%[3]s"]
+	  }
+	`, name, acc.Pi_resource_group_id, capability)
+}
+
 func testAccIBMPIWorkspaceDestroy(s *terraform.State) error {
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
 	if err != nil {
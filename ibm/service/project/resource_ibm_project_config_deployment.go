@@ -0,0 +1,399 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+)
+
+func ResourceIbmProjectConfigDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmProjectConfigDeploymentCreate,
+		ReadContext:   resourceIbmProjectConfigDeploymentRead,
+		DeleteContext: resourceIbmProjectConfigDeploymentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_project_config_deployment", "project_id"),
+				Description:  "The unique project ID.",
+			},
+			"config_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_project_config_deployment", "config_id"),
+				Description:  "The unique configuration ID.",
+			},
+			"config_version": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The version of the configuration to deploy. A new version is validated, approved, and deployed again.",
+			},
+			"approve": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Approve the configuration once it is validated. If false, the deployment waits until the configuration is approved outside of Terraform.",
+			},
+			"approve_comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The comment of the approval.",
+			},
+			"undeploy_on_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Undeploy the configuration when the resource is destroyed.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the configuration.",
+			},
+			"deployed_version": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the configuration that is deployed.",
+			},
+			"last_validated": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the last validation of the configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"result": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the validation.",
+						},
+						"job_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Schematics job of the validation.",
+						},
+						"compliance_status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the compliance check run by the Code Risk Analyzer.",
+						},
+						"currency": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency of the cost estimate.",
+						},
+						"total_hourly_cost": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The estimated hourly cost of the configuration.",
+						},
+						"total_monthly_cost": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The estimated monthly cost of the configuration.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIbmProjectConfigDeploymentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "project_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[\.\-0-9a-zA-Z]+$`,
+			MaxValueLength:             128,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "config_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[\.\-0-9a-zA-Z]+$`,
+			MaxValueLength:             128,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_project_config_deployment", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmProjectConfigDeploymentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	projectClient, err := meta.(conns.ClientSession).ProjectV1()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	projectID := d.Get("project_id").(string)
+	configID := d.Get("config_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	projectConfig, err := waitForProjectConfigState(context, projectClient, projectID, configID, []string{"draft", "validated", "approved", "deployed", "validating_failed", "deploying_failed"}, timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+		return tfErr.GetDiag()
+	}
+	if v, ok := d.GetOk("config_version"); ok && int64(v.(int)) != *projectConfig.Version {
+		err = fmt.Errorf("The configuration %s is at version %d, expected version %d", configID, *projectConfig.Version, v.(int))
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, configID))
+
+	if *projectConfig.State != "approved" && *projectConfig.State != "validated" {
+		validateConfigOptions := projectClient.NewValidateConfigOptions(projectID, configID)
+		_, _, err = projectClient.ValidateConfigWithContext(context, validateConfigOptions)
+		if err != nil {
+			d.SetId("")
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ValidateConfigWithContext failed: %s", err.Error()), "ibm_project_config_deployment", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		projectConfig, err = waitForProjectConfigState(context, projectClient, projectID, configID, []string{"validated", "approved"}, timeout)
+		if err != nil {
+			d.SetId("")
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+			return tfErr.GetDiag()
+		}
+	}
+
+	if *projectConfig.State == "validated" {
+		if d.Get("approve").(bool) {
+			approveOptions := projectClient.NewApproveOptions(projectID, configID)
+			if v, ok := d.GetOk("approve_comment"); ok {
+				approveOptions.SetComment(v.(string))
+			}
+			_, _, err = projectClient.ApproveWithContext(context, approveOptions)
+			if err != nil {
+				d.SetId("")
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ApproveWithContext failed: %s", err.Error()), "ibm_project_config_deployment", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		} else {
+			log.Printf("[INFO] Waiting for the configuration %s to be approved", configID)
+		}
+		_, err = waitForProjectConfigState(context, projectClient, projectID, configID, []string{"approved"}, timeout)
+		if err != nil {
+			d.SetId("")
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+			return tfErr.GetDiag()
+		}
+	}
+
+	deployConfigOptions := projectClient.NewDeployConfigOptions(projectID, configID)
+	_, _, err = projectClient.DeployConfigWithContext(context, deployConfigOptions)
+	if err != nil {
+		d.SetId("")
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeployConfigWithContext failed: %s", err.Error()), "ibm_project_config_deployment", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	_, err = waitForProjectConfigState(context, projectClient, projectID, configID, []string{"deployed"}, timeout)
+	if err != nil {
+		d.SetId("")
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "create")
+		return tfErr.GetDiag()
+	}
+
+	return resourceIbmProjectConfigDeploymentRead(context, d, meta)
+}
+
+func resourceIbmProjectConfigDeploymentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	projectClient, err := meta.(conns.ClientSession).ProjectV1()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "read")
+		return tfErr.GetDiag()
+	}
+
+	getConfigOptions := &projectv1.GetConfigOptions{}
+	getConfigOptions.SetProjectID(parts[0])
+	getConfigOptions.SetID(parts[1])
+
+	projectConfig, response, err := projectClient.GetConfigWithContext(context, getConfigOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetConfigWithContext failed: %s", err.Error()), "ibm_project_config_deployment", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// The deployment is gone once the configuration was undeployed outside of Terraform.
+	if core.IsNil(projectConfig.DeployedVersion) {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("project_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting project_id: %s", err))
+	}
+	if err = d.Set("config_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_id: %s", err))
+	}
+	if err = d.Set("state", projectConfig.State); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state: %s", err))
+	}
+	if err = d.Set("deployed_version", flex.IntValue(projectConfig.DeployedVersion.Version)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting deployed_version: %s", err))
+	}
+	if !core.IsNil(projectConfig.LastValidated) {
+		lastValidatedMap := ResourceIbmProjectConfigDeploymentLastValidatedToMap(projectConfig.LastValidated)
+		if err = d.Set("last_validated", []map[string]interface{}{lastValidatedMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting last_validated: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmProjectConfigDeploymentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("undeploy_on_destroy").(bool) {
+		d.SetId("")
+		return nil
+	}
+
+	projectClient, err := meta.(conns.ClientSession).ProjectV1()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "delete")
+		return tfErr.GetDiag()
+	}
+
+	undeployConfigOptions := projectClient.NewUndeployConfigOptions(parts[0], parts[1])
+	_, response, err := projectClient.UndeployConfigWithContext(context, undeployConfigOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UndeployConfigWithContext failed: %s", err.Error()), "ibm_project_config_deployment", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	_, err = waitForProjectConfigState(context, projectClient, parts[0], parts[1], []string{"draft", "approved", "validated"}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_project_config_deployment", "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// waitForProjectConfigState waits until the configuration reaches one of the target states and fails
+// when a job of the configuration fails.
+func waitForProjectConfigState(context context.Context, projectClient *projectv1.ProjectV1, projectID, configID string, target []string, timeout time.Duration) (*projectv1.ProjectConfig, error) {
+	getConfigOptions := &projectv1.GetConfigOptions{}
+	getConfigOptions.SetProjectID(projectID)
+	getConfigOptions.SetID(configID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"validating", "validated", "approved", "deploying", "deployed", "undeploying", "draft"},
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			projectConfig, response, err := projectClient.GetConfigWithContext(context, getConfigOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetConfigWithContext failed: %s\n%s", err, response)
+			}
+			state := *projectConfig.State
+			log.Printf("[DEBUG] Configuration %s is %s", configID, state)
+			for _, t := range target {
+				if state == t {
+					return projectConfig, state, nil
+				}
+			}
+			if strings.HasSuffix(state, "_failed") {
+				return projectConfig, state, fmt.Errorf("The configuration %s is %s", configID, state)
+			}
+			return projectConfig, state, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	projectConfig, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return projectConfig.(*projectv1.ProjectConfig), nil
+}
+
+func ResourceIbmProjectConfigDeploymentLastValidatedToMap(model *projectv1.LastValidatedActionWithSummary) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.Result != nil {
+		modelMap["result"] = *model.Result
+	}
+	if model.Job != nil && model.Job.ID != nil {
+		modelMap["job_id"] = *model.Job.ID
+	}
+	if craLogs, ok := model.CraLogs.(*projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs); ok && craLogs.Status != nil {
+		modelMap["compliance_status"] = *craLogs.Status
+	}
+	if model.CostEstimate != nil {
+		if model.CostEstimate.Currency != nil {
+			modelMap["currency"] = *model.CostEstimate.Currency
+		}
+		if model.CostEstimate.TotalHourlyCost != nil {
+			modelMap["total_hourly_cost"] = *model.CostEstimate.TotalHourlyCost
+		}
+		if model.CostEstimate.TotalMonthlyCost != nil {
+			modelMap["total_monthly_cost"] = *model.CostEstimate.TotalMonthlyCost
+		}
+	}
+	return modelMap
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package project_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmProjectConfigDeploymentBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmProjectConfigDeploymentConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_project_config_deployment.project_config_deployment_instance", "state", "deployed"),
+					resource.TestCheckResourceAttrPair("ibm_project_config_deployment.project_config_deployment_instance", "deployed_version", "ibm_project_config.project_config_instance", "version"),
+					resource.TestCheckResourceAttrSet("ibm_project_config_deployment.project_config_deployment_instance", "last_validated.0.result"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmProjectConfigDeploymentConfigBasic() string {
+	return testAccCheckIbmProjectConfigConfigBasic() + fmt.Sprintf(`
+		resource "ibm_project_config_deployment" "project_config_deployment_instance" {
+			project_id      = ibm_project.project_instance.id
+			config_id       = ibm_project_config.project_config_instance.project_config_id
+			config_version  = ibm_project_config.project_config_instance.version
+			approve_comment = "%s"
+		}
+	`, "Approved by the acceptance test")
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataIBMContainerRegistryImageVulnerabilityReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImageVulnerabilityReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The full name of the image, with a digest or a tag, for example us.icr.io/namespace/repository@sha256:digest",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The overall scan status of the image: OK, WARN, FAIL, UNSUPPORTED, INCOMPLETE or UNSCANNED",
+			},
+			"scan_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last scan of the image",
+			},
+			"vulnerability_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vulnerable packages that are not exempt",
+			},
+			"configuration_issue_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of configuration issues that are not exempt",
+			},
+			"issue_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of vulnerabilities and configuration issues that are not exempt",
+			},
+			"exempt_vulnerability_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vulnerable packages that are exempt",
+			},
+			"exempt_configuration_issue_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of configuration issues that are exempt",
+			},
+			"exempt_issue_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of vulnerabilities and configuration issues that are exempt",
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImageVulnerabilityReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := d.Get("image").(string)
+	imageStatusQueryPathOptions := vulnerabilityAdvisorClient.NewImageStatusQueryPathOptions(image)
+
+	report, response, err := vulnerabilityAdvisorClient.ImageStatusQueryPathWithContext(context, imageStatusQueryPathOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ImageStatusQueryPathWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the vulnerability report of image %s: %s\n%s", image, err, response))
+	}
+
+	d.SetId(image)
+	for key, value := range dataIBMContainerRegistryImageVulnerabilityReportToMap(report) {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", key, err))
+		}
+	}
+	return nil
+}
+
+// dataIBMContainerRegistryImageVulnerabilityReportToMap returns the attributes of the scan summary of an image.
+// The scan time is a UNIX timestamp, an image that was never scanned has none.
+func dataIBMContainerRegistryImageVulnerabilityReportToMap(report *vulnerabilityadvisorv4.ScanreportSummary) map[string]interface{} {
+	scanTime := ""
+	if report.ScanTime != nil && *report.ScanTime > 0 {
+		scanTime = time.Unix(*report.ScanTime, 0).UTC().Format(time.RFC3339)
+	}
+	return map[string]interface{}{
+		"status":                           flex.StringValue(report.Status),
+		"scan_time":                        scanTime,
+		"vulnerability_count":              flex.IntValue(report.VulnerabilityCount),
+		"configuration_issue_count":        flex.IntValue(report.ConfigurationIssueCount),
+		"issue_count":                      flex.IntValue(report.IssueCount),
+		"exempt_vulnerability_count":       flex.IntValue(report.ExemptVulnerabilityCount),
+		"exempt_configuration_issue_count": flex.IntValue(report.ExemptConfigurationIssueCount),
+		"exempt_issue_count":               flex.IntValue(report.ExemptIssueCount),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImageVulnerabilityReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImageVulnerabilityReportDataSourceConfig(acc.CrImage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cr_image_vulnerability_report.report", "id", acc.CrImage),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_vulnerability_report.report", "status"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_vulnerability_report.report", "vulnerability_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_vulnerability_report.report", "configuration_issue_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageVulnerabilityReportDataSourceConfig(image string) string {
+	return fmt.Sprintf(`
+	data "ibm_cr_image_vulnerability_report" "report" {
+		image = "%s"
+	}
+`, image)
+}
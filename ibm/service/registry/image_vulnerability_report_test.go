@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataIBMContainerRegistryImageVulnerabilityReportToMap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/va/api/v4/report/image/status/us.icr.io/namespace/repository@sha256:digest", r.URL.Path)
+		assert.Equal(t, "account-id", r.Header.Get("Account"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"status": "FAIL",
+			"scan_time": 1718880000,
+			"vulnerability_count": 3,
+			"configuration_issue_count": 0,
+			"issue_count": 3,
+			"exempt_vulnerability_count": 1,
+			"exempt_configuration_issue_count": 0,
+			"exempt_issue_count": 1
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := vulnerabilityadvisorv4.NewVulnerabilityAdvisorV4(&vulnerabilityadvisorv4.VulnerabilityAdvisorV4Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Account:       core.StringPtr("account-id"),
+	})
+	assert.Nil(t, err)
+
+	report, _, err := client.ImageStatusQueryPathWithContext(context.Background(), client.NewImageStatusQueryPathOptions("us.icr.io/namespace/repository@sha256:digest"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"status":                           "FAIL",
+		"scan_time":                        "2024-06-20T10:40:00Z",
+		"vulnerability_count":              3,
+		"configuration_issue_count":        0,
+		"issue_count":                      3,
+		"exempt_vulnerability_count":       1,
+		"exempt_configuration_issue_count": 0,
+		"exempt_issue_count":               1,
+	}, dataIBMContainerRegistryImageVulnerabilityReportToMap(report))
+}
+
+func TestDataIBMContainerRegistryImageVulnerabilityReportToMapUnscanned(t *testing.T) {
+	report := &vulnerabilityadvisorv4.ScanreportSummary{
+		Status:   core.StringPtr("UNSCANNED"),
+		ScanTime: core.Int64Ptr(0),
+	}
+	attributes := dataIBMContainerRegistryImageVulnerabilityReportToMap(report)
+	assert.Equal(t, "UNSCANNED", attributes["status"])
+	assert.Equal(t, "", attributes["scan_time"], "an image that was never scanned has no scan time")
+	assert.Equal(t, 0, attributes["issue_count"])
+}
@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// Page size used when listing resource instances.
+const resourceInstancesPageLimit = 100
+
+func DataSourceIBMResourceInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: DataSourceIBMResourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Return only the resource instances with this name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"service": {
+				Description: "Return only the resource instances of this service, for example cloud-object-storage",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"plan": {
+				Description:  "Return only the resource instances of this plan of the service",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"service"},
+			},
+
+			"resource_group_id": {
+				Description: "Return only the resource instances in this resource group",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_resource_instances",
+					"resource_group_id"),
+			},
+
+			"location": {
+				Description: "Return only the resource instances in this location or environment",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_resource_instances",
+					"location"),
+			},
+
+			"state": {
+				Description: "Return only the resource instances in this state",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_resource_instances",
+					"state"),
+			},
+
+			"tags": {
+				Description: "Return only the resource instances that have all of these tags",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+			},
+
+			"instances": {
+				Description: "The resource instances that match the filters",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource instance",
+						},
+						"guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Guid of resource instance",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource instance name",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CRN of resource instance",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource instance state",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The location or the environment in which instance exists",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the resource group in which the instance is present",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service offering of the instance",
+						},
+						"resource_plan_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the plan of the instance",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the instance, for example service_instance",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the instance was created",
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags of Resource Instance, only populated when filtering by tags",
+						},
+						"extensions": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The extended metadata as a map associated with the resource instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMResourceInstancesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "resource_group_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_group",
+			CloudDataRange:             []string{"resolved_to:id"},
+			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "location",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "region",
+			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			AllowedValues:              "active, provisioning, failed, removed",
+			Optional:                   true})
+
+	ibmIBMResourceInstancesValidator := validate.ResourceValidator{ResourceName: "ibm_resource_instances", Schema: validateSchema}
+	return &ibmIBMResourceInstancesValidator
+}
+
+func DataSourceIBMResourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceInstanceListOptions := rc.ListResourceInstancesOptions{
+		Limit: core.Int64Ptr(resourceInstancesPageLimit),
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		resourceInstanceListOptions.Name = flex.PtrToString(name.(string))
+	}
+	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
+		resourceInstanceListOptions.ResourceGroupID = flex.PtrToString(rsGrpID.(string))
+	}
+	if state, ok := d.GetOk("state"); ok {
+		resourceInstanceListOptions.State = flex.PtrToString(state.(string))
+	}
+
+	if service, ok := d.GetOk("service"); ok {
+		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+		if err != nil {
+			return err
+		}
+		rsCatRepo := rsCatClient.ResourceCatalog()
+
+		serviceOff, err := rsCatRepo.FindByName(service.(string), true)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		}
+		resourceInstanceListOptions.ResourceID = &serviceOff[0].ID
+
+		if plan, ok := d.GetOk("plan"); ok {
+			servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan.(string))
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			}
+			resourceInstanceListOptions.ResourcePlanID = &servicePlan
+		}
+	}
+
+	next_url := ""
+	var instances []rc.ResourceInstance
+	for {
+		if next_url != "" {
+			resourceInstanceListOptions.Start = &next_url
+		}
+		listInstanceResponse, resp, err := rsConClient.ListResourceInstances(&resourceInstanceListOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing resource instances: %s with resp code: %s", err, resp)
+		}
+		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] ListResourceInstances failed. Error occurred while parsing NextURL: %s", err)
+		}
+		instances = append(instances, listInstanceResponse.Resources...)
+		if next_url == "" {
+			break
+		}
+	}
+
+	location := d.Get("location").(string)
+	var filterTags *schema.Set
+	if tags, ok := d.GetOk("tags"); ok {
+		filterTags = tags.(*schema.Set)
+	}
+
+	instanceList := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		if location != "" && flex.GetLocationV2(instance) != location {
+			continue
+		}
+
+		instanceMap := dataSourceIBMResourceInstancesInstanceToMap(instance)
+
+		if filterTags != nil {
+			tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error on get of resource instance (%s) tags: %s", *instance.ID, err)
+			}
+			if !flex.HasAllTags(tags, filterTags) {
+				continue
+			}
+			instanceMap["tags"] = tags
+		}
+
+		instanceList = append(instanceList, instanceMap)
+	}
+
+	d.SetId(dataSourceIBMResourceInstancesID(d))
+	if err = d.Set("instances", instanceList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting instances: %s", err)
+	}
+
+	return nil
+}
+
+// dataSourceIBMResourceInstancesID returns a reasonable ID for the resource instances list.
+func dataSourceIBMResourceInstancesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMResourceInstancesInstanceToMap(instance rc.ResourceInstance) map[string]interface{} {
+	instanceMap := map[string]interface{}{
+		"id":                flex.StringValue(instance.ID),
+		"guid":              flex.StringValue(instance.GUID),
+		"name":              flex.StringValue(instance.Name),
+		"crn":               flex.StringValue(instance.CRN),
+		"state":             flex.StringValue(instance.State),
+		"location":          flex.GetLocationV2(instance),
+		"resource_group_id": flex.StringValue(instance.ResourceGroupID),
+		"resource_id":       flex.StringValue(instance.ResourceID),
+		"resource_plan_id":  flex.StringValue(instance.ResourcePlanID),
+		"type":              flex.StringValue(instance.Type),
+		"created_at":        flex.DateTimeToString(instance.CreatedAt),
+	}
+	if len(instance.Extensions) == 0 {
+		instanceMap["extensions"] = instance.Extensions
+	} else {
+		instanceMap["extensions"] = flex.Flatten(instance.Extensions)
+	}
+	return instanceMap
+}
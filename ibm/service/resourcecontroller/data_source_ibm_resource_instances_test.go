@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceInstancesDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstancesDataSourceConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_instances.by_name", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instances.by_name", "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr("data.ibm_resource_instances.by_name", "instances.0.location", "global"),
+					resource.TestCheckResourceAttrPair("data.ibm_resource_instances.by_name", "instances.0.guid", "ibm_resource_instance.instance", "guid"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_instances.by_plan", "instances.#"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instances.by_tags", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instances.by_tags", "instances.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceInstancesDataSourceConfig(instanceName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = "true"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%[1]s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+		tags              = ["tf-acc-%[1]s"]
+	}
+
+	data "ibm_resource_instances" "by_name" {
+		name              = ibm_resource_instance.instance.name
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	data "ibm_resource_instances" "by_plan" {
+		service    = "cloud-object-storage"
+		plan       = "standard"
+		depends_on = [ibm_resource_instance.instance]
+	}
+
+	data "ibm_resource_instances" "by_tags" {
+		service    = "cloud-object-storage"
+		tags       = ["tf-acc-%[1]s"]
+		depends_on = [ibm_resource_instance.instance]
+	}
+	`, instanceName)
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMResourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Resource group name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"is_default": {
+				Description: "Return only the default resource group",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"resource_groups": {
+				Type:        schema.TypeList,
+				Description: "The resource groups of the account",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the resource group",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the resource group",
+							Computed:    true,
+						},
+						"is_default": {
+							Type:        schema.TypeBool,
+							Description: "Specifies whether its default resource group or not",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "State of the resource group",
+							Computed:    true,
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Description: "The full CRN associated with the resource group",
+							Computed:    true,
+						},
+						"quota_id": {
+							Type:        schema.TypeString,
+							Description: "An alpha-numeric value identifying the quota ID associated with the resource group.",
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: "The date when the resource group was initially created.",
+							Computed:    true,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Description: "The date when the resource group was last updated.",
+							Computed:    true,
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Description: "Account ID",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	accountID := userDetails.UserAccount
+
+	resourceGroupList := rg.ListResourceGroupsOptions{
+		AccountID: &accountID,
+	}
+	if defaultGrp, ok := d.GetOk("is_default"); ok {
+		isDefault := defaultGrp.(bool)
+		resourceGroupList.Default = &isDefault
+	}
+	if name, ok := d.GetOk("name"); ok {
+		n := name.(string)
+		resourceGroupList.Name = &n
+	}
+	rgList, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
+	if err != nil || rgList == nil {
+		return fmt.Errorf("[ERROR] Error retrieving resource groups: %s %s", err, resp)
+	}
+
+	resourceGroups := make([]map[string]interface{}, 0, len(rgList.Resources))
+	for _, resourceGroup := range rgList.Resources {
+		group := map[string]interface{}{}
+		if resourceGroup.ID != nil {
+			group["id"] = *resourceGroup.ID
+		}
+		if resourceGroup.Name != nil {
+			group["name"] = *resourceGroup.Name
+		}
+		if resourceGroup.Default != nil {
+			group["is_default"] = *resourceGroup.Default
+		}
+		if resourceGroup.State != nil {
+			group["state"] = *resourceGroup.State
+		}
+		if resourceGroup.CRN != nil {
+			group["crn"] = *resourceGroup.CRN
+		}
+		if resourceGroup.QuotaID != nil {
+			group["quota_id"] = *resourceGroup.QuotaID
+		}
+		if resourceGroup.CreatedAt != nil {
+			group["created_at"] = resourceGroup.CreatedAt.String()
+		}
+		if resourceGroup.UpdatedAt != nil {
+			group["updated_at"] = resourceGroup.UpdatedAt.String()
+		}
+		if resourceGroup.AccountID != nil {
+			group["account_id"] = *resourceGroup.AccountID
+		}
+		resourceGroups = append(resourceGroups, group)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("resource_groups", resourceGroups); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resource_groups: %s", err)
+	}
+	return nil
+}
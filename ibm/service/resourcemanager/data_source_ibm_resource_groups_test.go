@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceGroupsDataSource_Basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceGroupsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_resource_groups.all", "resource_groups.#"),
+					resource.TestCheckResourceAttr("data.ibm_resource_groups.default", "resource_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_resource_groups.default", "resource_groups.0.is_default", "true"),
+					resource.TestCheckResourceAttr("data.ibm_resource_groups.name", "resource_groups.0.name", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceGroupsDataSourceConfig() string {
+	return `
+	data "ibm_resource_groups" "all" {
+	}
+
+	data "ibm_resource_groups" "default" {
+		is_default = true
+	}
+
+	data "ibm_resource_groups" "name" {
+		name = "default"
+	}
+`
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -78,6 +79,7 @@ func ResourceIBMResourceGroup() *schema.Resource {
 			"quota_id": {
 				Type:        schema.TypeString,
 				Description: "An alpha-numeric value identifying the quota ID associated with the resource group.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"resource_linkages": {
@@ -115,6 +117,13 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(*resourceGroup.ID)
 
+	if quotaID, ok := d.GetOk("quota_id"); ok {
+		err = resourceIBMResourceGroupAssignQuota(d.Id(), quotaID.(string), meta)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMResourceGroupRead(d, meta)
 }
 
@@ -202,9 +211,34 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 
 	}
+	if d.HasChange("quota_id") {
+		if quotaID, ok := d.GetOk("quota_id"); ok {
+			err = resourceIBMResourceGroupAssignQuota(resourceGroupID, quotaID.(string), meta)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return resourceIBMResourceGroupRead(d, meta)
 }
 
+// resourceIBMResourceGroupAssignQuota assigns a quota definition to the resource group. The resource
+// manager API of the platform services SDK does not update the quota of a resource group.
+func resourceIBMResourceGroupAssignQuota(resourceGroupID, quotaID string, meta interface{}) error {
+	rsManagementAPI, err := meta.(conns.ClientSession).ResourceManagementAPIv2()
+	if err != nil {
+		return err
+	}
+	updateRequest := managementv2.ResourceGroupUpdateRequest{
+		QuotaID: quotaID,
+	}
+	_, err = rsManagementAPI.ResourceGroup().Update(resourceGroupID, &updateRequest)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error assigning quota definition %s to resource group %s: %s", quotaID, resourceGroupID, err)
+	}
+	return nil
+}
+
 func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
@@ -80,6 +80,24 @@ func TestAccIBMResourceGroupWithTags(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceGroupQuota(t *testing.T) {
+	var conf string
+	resourceGroupName := fmt.Sprintf("tf-rg-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceGroupWithQuota(resourceGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceGroupExists("ibm_resource_group.resourceGroup", &conf),
+					resource.TestCheckResourceAttrPair("ibm_resource_group.resourceGroup", "quota_id", "data.ibm_resource_quota.quota", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceGroupExists(n string, obj *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -167,3 +185,16 @@ func testAccCheckIBMResourceGroupWithupdatedTags(resourceGroupName string) strin
 		  }
 	`, resourceGroupName)
 }
+
+func testAccCheckIBMResourceGroupWithQuota(resourceGroupName string) string {
+	return fmt.Sprintf(`
+		  data "ibm_resource_quota" "quota" {
+			name = "Trial Quota"
+		  }
+
+		  resource "ibm_resource_group" "resourceGroup" {
+			name     = "%s"
+			quota_id = data.ibm_resource_quota.quota.id
+		  }
+	`, resourceGroupName)
+}
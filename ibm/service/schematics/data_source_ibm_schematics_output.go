@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -44,6 +46,52 @@ func DataSourceIBMSchematicsOutput() *schema.Resource {
 				Optional:    true,
 				Description: "The json output in string",
 			},
+			"outputs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The output values of the template, decoded according to their type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the output.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The decoded type of the output value: `string`, `number`, `bool`, `list`, `map` or `null`.",
+						},
+						"sensitive": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the output is marked as sensitive.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of a `string`, `number` or `bool` output.",
+						},
+						"list_value": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The elements of a `list` output. Nested collections are encoded as JSON strings.",
+						},
+						"map_value": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The entries of a `map` output. Nested collections are encoded as JSON strings.",
+						},
+						"json_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the output encoded as JSON, which can be decoded with `jsondecode`.",
+						},
+					},
+				},
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -82,6 +130,7 @@ func dataSourceIBMSchematicsOutputRead(d *schema.ResourceData, meta interface{})
 
 	var outputJSON string
 	items := make(map[string]interface{})
+	outputs := make([]map[string]interface{}, 0)
 	found := false
 	for _, fields := range outputValuesList {
 		if *fields.ID == templateID {
@@ -97,6 +146,11 @@ func dataSourceIBMSchematicsOutputRead(d *schema.ResourceData, meta interface{})
 				for key, val := range value {
 					val2 := val.(map[string]interface{})["value"]
 					items[key] = val2
+					decoded, err := schematicsOutputDecode(key, val.(map[string]interface{}))
+					if err != nil {
+						return err
+					}
+					outputs = append(outputs, decoded)
 				}
 			}
 		}
@@ -108,6 +162,12 @@ func dataSourceIBMSchematicsOutputRead(d *schema.ResourceData, meta interface{})
 	d.Set("output_json", outputJSON)
 	d.SetId(fmt.Sprintf("%s/%s", workspaceID, templateID))
 	d.Set("output_values", flex.Flatten(items))
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i]["name"].(string) < outputs[j]["name"].(string)
+	})
+	if err = d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("[ERROR] Error setting outputs: %s", err)
+	}
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -123,3 +183,84 @@ func dataSourceIBMSchematicsOutputRead(d *schema.ResourceData, meta interface{})
 func dataSourceIBMSchematicsOutputID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
+
+// schematicsOutputDecode converts a workspace output, as returned by the Schematics API, into the
+// typed attributes of the outputs block.
+func schematicsOutputDecode(name string, output map[string]interface{}) (map[string]interface{}, error) {
+	decoded := map[string]interface{}{
+		"name":       name,
+		"sensitive":  false,
+		"value":      "",
+		"list_value": []string{},
+		"map_value":  map[string]string{},
+	}
+	if sensitive, ok := output["sensitive"].(bool); ok {
+		decoded["sensitive"] = sensitive
+	}
+
+	value := output["value"]
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error encoding output %s: %s", name, err)
+	}
+	decoded["json_value"] = string(jsonValue)
+
+	switch v := value.(type) {
+	case nil:
+		decoded["type"] = "null"
+	case string:
+		decoded["type"] = "string"
+		decoded["value"] = v
+	case float64:
+		decoded["type"] = "number"
+		decoded["value"] = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		decoded["type"] = "bool"
+		decoded["value"] = strconv.FormatBool(v)
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, elem := range v {
+			elemValue, err := schematicsOutputElementString(elem)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error encoding output %s: %s", name, err)
+			}
+			list = append(list, elemValue)
+		}
+		decoded["type"] = "list"
+		decoded["list_value"] = list
+	case map[string]interface{}:
+		entries := make(map[string]string, len(v))
+		for key, elem := range v {
+			elemValue, err := schematicsOutputElementString(elem)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error encoding output %s: %s", name, err)
+			}
+			entries[key] = elemValue
+		}
+		decoded["type"] = "map"
+		decoded["map_value"] = entries
+	default:
+		decoded["type"] = "string"
+		decoded["value"] = string(jsonValue)
+	}
+	return decoded, nil
+}
+
+// schematicsOutputElementString returns primitive elements as plain strings and nested collections as JSON.
+func schematicsOutputElementString(elem interface{}) (string, error) {
+	switch v := elem.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	elemJSON, err := json.Marshal(elem)
+	if err != nil {
+		return "", err
+	}
+	return string(elemJSON), nil
+}
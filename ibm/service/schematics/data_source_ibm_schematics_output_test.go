@@ -22,6 +22,7 @@ func TestAccIBMSchematicsOutputDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMSchematicsOutputDataSourceConfigBasic(acc.WorkspaceID, acc.TemplateID),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.ibm_schematics_output.schematics_output", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_output.schematics_output", "outputs.#"),
 				),
 			},
 		},
@@ -120,10 +120,15 @@ func resourceIbmSchematicsAgentDeployCreate(context context.Context, d *schema.R
 	d.SetId(fmt.Sprintf("%s/%s", *deployAgentJobOptions.AgentID, *agentDeployJob.JobID))
 	log.Printf("[INFO] Agent : %s", *deployAgentJobOptions.AgentID)
 
-	_, err = isWaitForAgentAvailable(context, schematicsClient, *deployAgentJobOptions.AgentID, d.Timeout(schema.TimeoutCreate))
+	agent, err := isWaitForAgentAvailable(context, schematicsClient, *deployAgentJobOptions.AgentID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Waiting for agent to be available failed %s", err))
 	}
+	if recentJob := agent.(*schematicsv1.AgentData).RecentDeployJob; recentJob != nil {
+		if err = agentJobError("deploy", recentJob.StatusCode, recentJob.StatusMessage, recentJob.LogURL); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceIbmSchematicsAgentDeployRead(context, d, meta)
 }
@@ -162,13 +167,26 @@ func agentRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string) re
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Agent: %s\n%s", err, response)
 		}
-		if agent.RecentDeployJob.StatusCode != nil {
+		if agent.RecentDeployJob != nil && agent.RecentDeployJob.StatusCode != nil {
 			return agent, *agent.RecentDeployJob.StatusCode, nil
 		}
 		return agent, agentProvisioningStatusCodeJobPending, nil
 	}
 }
 
+// agentJobError returns an error when the agent job did not finish successfully, so that a failed
+// deploy or pre-requisite scan fails the apply instead of being stored in the state.
+func agentJobError(job string, statusCode, statusMessage, logURL *string) error {
+	if statusCode == nil {
+		return nil
+	}
+	switch *statusCode {
+	case agentProvisioningStatusCodeJobFailed, agentProvisioningStatusCodeJobCancelled, agentProvisioningStatusCodeJobStopped:
+		return fmt.Errorf("[ERROR] Agent %s job ended with status %s: %s, see the logs at %s", job, *statusCode, flex.StringValue(statusMessage), flex.StringValue(logURL))
+	}
+	return nil
+}
+
 func resourceIbmSchematicsAgentDeployRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
 	if err != nil {
@@ -269,10 +287,15 @@ func resourceIbmSchematicsAgentDeployUpdate(context context.Context, d *schema.R
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *deployAgentJobOptions.AgentID, *agentDeployJob.JobID))
 
-		_, err = isWaitForAgentAvailable(context, schematicsClient, parts[0], d.Timeout(schema.TimeoutUpdate))
+		agent, err := isWaitForAgentAvailable(context, schematicsClient, parts[0], d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("Waiting for agent to be available failed %s", err))
 		}
+		if recentJob := agent.(*schematicsv1.AgentData).RecentDeployJob; recentJob != nil {
+			if err = agentJobError("deploy", recentJob.StatusCode, recentJob.StatusMessage, recentJob.LogURL); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceIbmSchematicsAgentDeployRead(context, d, meta)
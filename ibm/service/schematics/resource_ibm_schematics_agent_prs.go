@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -25,6 +27,11 @@ func ResourceIbmSchematicsAgentPrs() *schema.Resource {
 		DeleteContext: resourceIbmSchematicsAgentPrsDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"agent_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -107,6 +114,16 @@ func resourceIbmSchematicsAgentPrsCreate(context context.Context, d *schema.Reso
 
 	d.SetId(fmt.Sprintf("%s/%s", *prsAgentJobOptions.AgentID, *agentPrsJob.JobID))
 
+	agent, err := isWaitForAgentPrsCompleted(context, schematicsClient, *prsAgentJobOptions.AgentID, *agentPrsJob.JobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Waiting for agent prs job to complete failed %s", err))
+	}
+	if recentJob := agent.(*schematicsv1.AgentData).RecentPrsJob; recentJob != nil {
+		if err = agentJobError("prs", recentJob.StatusCode, recentJob.StatusMessage, recentJob.LogURL); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmSchematicsAgentPrsRead(context, d, meta)
 }
 
@@ -211,11 +228,55 @@ func resourceIbmSchematicsAgentPrsUpdate(context context.Context, d *schema.Reso
 			return diag.FromErr(fmt.Errorf("PrsAgentJobWithContext failed %s\n%s", err, response))
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *prsAgentJobOptions.AgentID, *agentPrsJob.JobID))
+
+		agent, err := isWaitForAgentPrsCompleted(context, schematicsClient, parts[0], *agentPrsJob.JobID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Waiting for agent prs job to complete failed %s", err))
+		}
+		if recentJob := agent.(*schematicsv1.AgentData).RecentPrsJob; recentJob != nil {
+			if err = agentJobError("prs", recentJob.StatusCode, recentJob.StatusMessage, recentJob.LogURL); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceIbmSchematicsAgentPrsRead(context, d, meta)
 }
 
+func isWaitForAgentPrsCompleted(context context.Context, schematicsClient *schematicsv1.SchematicsV1, id, jobID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for agent (%s) prs job (%s) to complete.", id, jobID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", agentProvisioningStatusCodeJobInProgress, agentProvisioningStatusCodeJobPending, agentProvisioningStatusCodeJobReadyToExecute, agentProvisioningStatusCodeJobStopInProgress},
+		Target:     []string{agentProvisioningStatusCodeJobFinished, agentProvisioningStatusCodeJobFailed, agentProvisioningStatusCodeJobCancelled, agentProvisioningStatusCodeJobStopped, ""},
+		Refresh:    agentPrsRefreshFunc(schematicsClient, id, jobID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+// agentPrsRefreshFunc returns the status of the prs job with the given ID. The job is pending until it is
+// reported as the recent prs job of the agent, so that the status of a previous job is never returned.
+func agentPrsRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getAgentDataOptions := &schematicsv1.GetAgentDataOptions{
+			AgentID: core.StringPtr(id),
+			Profile: core.StringPtr("detailed"),
+		}
+
+		agent, response, err := schematicsClient.GetAgentData(getAgentDataOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Agent: %s\n%s", err, response)
+		}
+		recentJob := agent.RecentPrsJob
+		if recentJob != nil && flex.StringValue(recentJob.JobID) == jobID && recentJob.StatusCode != nil {
+			return agent, *recentJob.StatusCode, nil
+		}
+		return agent, agentProvisioningStatusCodeJobPending, nil
+	}
+}
+
 func resourceIbmSchematicsAgentPrsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
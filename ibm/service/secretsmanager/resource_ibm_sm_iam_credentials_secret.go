@@ -9,11 +9,14 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -29,6 +32,13 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 		DeleteContext: resourceIbmSmIamCredentialsSecretDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.All(
+			// the next rotation date is recomputed by the service when the rotation policy changes
+			customdiff.ComputedIf("next_rotation_date", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("rotation")
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
 				Type:        schema.TypeString,
@@ -60,10 +70,11 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"ttl": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: StringIsIntBetween(60, 7776000),
-				Description:  "The time-to-live (TTL) or lease duration to assign to generated credentials.For `iam_credentials` secrets, the TTL defines for how long each generated API key remains valid. The value is an integer that specifies the number of seconds .Minimum duration is 1 minute. Maximum is 90 days.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     StringIsDurationBetween(60, 7776000),
+				DiffSuppressFunc: durationDiffSuppress,
+				Description:      "The time-to-live (TTL) or lease duration to assign to generated credentials.For `iam_credentials` secrets, the TTL defines for how long each generated API key remains valid. The value is an integer that specifies the number of seconds, or an integer followed by a unit `s`, `m`, `h` or `d`, for example `12h` or `30d`.Minimum duration is 1 minute. Maximum is 90 days.",
 			},
 			"expiration_date": &schema.Schema{
 				Type:        schema.TypeString,
@@ -71,18 +82,26 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 				Description: "The date a secret is expired. The date format follows RFC 3339.",
 			},
 			"access_groups": &schema.Schema{
-				Type:        schema.TypeList,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Access Groups that you can use for an `iam_credentials` secret.Up to 10 Access Groups can be used for each secret.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MinItems:      1,
+				MaxItems:      10,
+				ConflictsWith: []string{"service_id"},
+				Description:   "Access Groups that you can use for an `iam_credentials` secret.Up to 10 Access Groups can be used for each secret.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^AccessGroupId-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`), "must be the ID of an access group, AccessGroupId-<uuid>"),
+				},
 			},
 			"service_id": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Description: "The service ID under which the API key (see the `api_key` field) is created.If you omit this parameter, Secrets Manager generates a new service ID for your secret at its creation and adds it to the access groups that you assign.Optionally, you can use this field to provide your own service ID if you prefer to manage its access directly or retain the service ID after your secret expires, is rotated, or deleted. If you provide a service ID, do not include the `access_groups` parameter.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_groups"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^ServiceId-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`), "must be the ID of a service ID, ServiceId-<uuid>"),
+				Description:   "The service ID under which the API key (see the `api_key` field) is created.If you omit this parameter, Secrets Manager generates a new service ID for your secret at its creation and adds it to the access groups that you assign.Optionally, you can use this field to provide your own service ID if you prefer to manage its access directly or retain the service ID after your secret expires, is rotated, or deleted. The same service ID of the account can be reused by several secrets. If you provide a service ID, do not include the `access_groups` parameter.",
 			},
 			"reuse_api_key": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -371,7 +390,7 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
 	}
 	if err = d.Set("ttl", secret.TTL); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting signing_algorithm: %s", err))
+		return diag.FromErr(fmt.Errorf("Error setting ttl: %s", err))
 	}
 	if secret.AccessGroups != nil {
 		if err = d.Set("access_groups", secret.AccessGroups); err != nil {
@@ -481,7 +500,7 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 		hasChange = true
 	}
 	if d.HasChange("ttl") {
-		patchVals.TTL = core.StringPtr(d.Get("ttl").(string))
+		patchVals.TTL = core.StringPtr(normalizeDuration(d.Get("ttl").(string)))
 		hasChange = true
 	}
 	if d.HasChange("rotation") {
@@ -576,7 +595,7 @@ func resourceIbmSmIamCredentialsSecretMapToSecretPrototype(d *schema.ResourceDat
 		model.Labels = labelsParsed
 	}
 	if _, ok := d.GetOk("ttl"); ok {
-		model.TTL = core.StringPtr(d.Get("ttl").(string))
+		model.TTL = core.StringPtr(normalizeDuration(d.Get("ttl").(string)))
 	}
 	if _, ok := d.GetOk("access_groups"); ok {
 		accessGroups := d.Get("access_groups").([]interface{})
@@ -18,8 +18,8 @@ import (
 
 var iamCredentialsSecretName = "terraform-test-iam-secret"
 var modifiedIamCredentialsSecretName = "modified-terraform-test-iam-secret"
-var iamCredentialsTtl = "259200"          // 3 days in seconds
-var modifiedIamCredentialsTtl = "7776000" // 3 months in seconds
+var iamCredentialsTtl = "259200"      // 3 days in seconds
+var modifiedIamCredentialsTtl = "90d" // 3 months, normalized to 7776000 seconds
 
 func TestAccIbmSmIamCredentialsSecretBasic(t *testing.T) {
 	resourceName := "ibm_sm_iam_credentials_secret.sm_iam_credentials_secret_basic"
@@ -26,6 +26,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
@@ -214,6 +215,36 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 					},
 				},
 			},
+			"cis": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IBM Cloud Internet Services instance in which the DNS challenges of a certificate ordered with the `manual` DNS provider are created automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cis_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the IBM Cloud Internet Services instance.",
+						},
+						"domain_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The ID of the domain in which the challenge records are created. If not provided, the domain is looked up by the name of each challenge.",
+						},
+						"ttl": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     120,
+							Description: "The time to live of the challenge records, in seconds.",
+						},
+					},
+				},
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -663,6 +694,13 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 		}
 		return resourceIbmSmPublicCertificateRead(context, d, meta)
 	}
+	if d.Get("dns").(string) == "manual" && len(d.Get("cis").([]interface{})) > 0 && d.Get("state_description").(string) == "pre_activation" {
+		err := setChallengesWithCisAndValidateManualDns(context, d, meta, secret, secretsManagerClient)
+		if err != nil {
+			return err
+		}
+		return resourceIbmSmPublicCertificateRead(context, d, meta)
+	}
 	return nil
 }
 
@@ -1282,3 +1320,145 @@ func getZone(currentZone string, originalDomain string, config edgegrid.Config)
 		return "", diag.FromErr(fmt.Errorf("error in performing akamai 'GET' zone request for zone: %s:: %s", currentZone, string(body)))
 	}
 }
+
+func setChallengesWithCisAndValidateManualDns(context context.Context, d *schema.ResourceData, meta interface{}, secret *secretsmanagerv2.PublicCertificate, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) diag.Diagnostics {
+	cisData := d.Get("cis").([]interface{})[0].(map[string]interface{})
+	crn := cisData["cis_crn"].(string)
+	domainId := cisData["domain_id"].(string)
+	ttl := cisData["ttl"].(int)
+
+	dnsRecordsClient, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dnsRecordsClient.Crn = core.StringPtr(crn)
+
+	// the ids of the challenge records created by the provider, by domain id, which are removed once the certificate is validated
+	createdRecords := make(map[string][]string)
+	failed := func(diags diag.Diagnostics) diag.Diagnostics {
+		deleteCisChallengeRecords(dnsRecordsClient, createdRecords)
+		resourceIbmSmPublicCertificateDelete(context, d, meta)
+		return diags
+	}
+
+	if secret.IssuanceInfo == nil || len(secret.IssuanceInfo.Challenges) == 0 {
+		return failed(diag.FromErr(fmt.Errorf("error: no DNS challenges were returned for the certificate %s", d.Id())))
+	}
+
+	var zones map[string]string
+	for _, challengeItem := range secret.IssuanceInfo.Challenges {
+		txtRecordName := strings.TrimSuffix(*challengeItem.TxtRecordName, ".")
+
+		zoneId := domainId
+		if zoneId == "" {
+			if zones == nil {
+				zones, err = listCisZones(meta, crn)
+				if err != nil {
+					return failed(diag.FromErr(err))
+				}
+			}
+			zoneId = findCisZoneForRecord(zones, txtRecordName)
+			if zoneId == "" {
+				return failed(diag.FromErr(fmt.Errorf("could not find a domain in the CIS instance %s for the domain: %s", crn, *challengeItem.Domain)))
+			}
+		}
+
+		recordId, err := createCisChallengeRecord(dnsRecordsClient, zoneId, txtRecordName, *challengeItem.TxtRecordValue, ttl)
+		if err != nil {
+			return failed(diag.FromErr(err))
+		}
+		if recordId != "" {
+			createdRecords[zoneId] = append(createdRecords[zoneId], recordId)
+		}
+	}
+
+	diags := validateManualDns(context, d, secretsManagerClient)
+	deleteCisChallengeRecords(dnsRecordsClient, createdRecords)
+	return diags
+}
+
+// listCisZones returns the ids of the domains of the CIS instance by domain name.
+func listCisZones(meta interface{}, crn string) (map[string]string, error) {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+
+	opt := cisClient.NewListZonesOptions()
+	opt.SetPage(1)       // list all zones in one page
+	opt.SetPerPage(1000) // maximum allowed limit is 1000 per page
+	result, response, err := cisClient.ListZones(opt)
+	if err != nil {
+		log.Printf("[DEBUG] ListZones failed %s\n%s", err, response)
+		return nil, fmt.Errorf("error listing the domains of the CIS instance %s: %s\n%s", crn, err, response)
+	}
+
+	zones := make(map[string]string)
+	for _, zone := range result.Result {
+		zones[*zone.Name] = *zone.ID
+	}
+	return zones, nil
+}
+
+// findCisZoneForRecord returns the id of the most specific domain that contains the record name.
+func findCisZoneForRecord(zones map[string]string, recordName string) string {
+	zoneName := recordName
+	for {
+		if zoneId, ok := zones[zoneName]; ok {
+			return zoneId
+		}
+		i := strings.Index(zoneName, ".")
+		if i < 0 {
+			return ""
+		}
+		zoneName = zoneName[i+1:]
+	}
+}
+
+// createCisChallengeRecord creates the TXT record of a challenge and returns its id. An empty id is returned
+// when the record already exists, in which case it is left in place.
+func createCisChallengeRecord(dnsRecordsClient *dnsrecordsv1.DnsRecordsV1, zoneId string, txtRecordName string, txtRecordValue string, ttl int) (string, error) {
+	dnsRecordsClient.ZoneIdentifier = core.StringPtr(zoneId)
+
+	listOpt := dnsRecordsClient.NewListAllDnsRecordsOptions()
+	listOpt.SetType("TXT")
+	listOpt.SetName(txtRecordName)
+	records, response, err := dnsRecordsClient.ListAllDnsRecords(listOpt)
+	if err != nil {
+		log.Printf("[DEBUG] ListAllDnsRecords failed %s\n%s", err, response)
+		return "", fmt.Errorf("error listing the TXT records %s in CIS: %s\n%s", txtRecordName, err, response)
+	}
+	for _, record := range records.Result {
+		if record.Content != nil && strings.Trim(*record.Content, "\"") == txtRecordValue {
+			return "", nil
+		}
+	}
+
+	opt := dnsRecordsClient.NewCreateDnsRecordOptions()
+	opt.SetType("TXT")
+	opt.SetName(txtRecordName)
+	opt.SetContent(txtRecordValue)
+	opt.SetTTL(int64(ttl))
+	result, response, err := dnsRecordsClient.CreateDnsRecord(opt)
+	if err != nil {
+		log.Printf("[DEBUG] CreateDnsRecord failed %s\n%s", err, response)
+		return "", fmt.Errorf("error creating the TXT record %s in CIS: %s\n%s", txtRecordName, err, response)
+	}
+	return *result.Result.ID, nil
+}
+
+// deleteCisChallengeRecords removes the challenge records created by the provider. Failures are only logged, as
+// the records are not needed once the certificate is validated.
+func deleteCisChallengeRecords(dnsRecordsClient *dnsrecordsv1.DnsRecordsV1, records map[string][]string) {
+	for zoneId, recordIds := range records {
+		dnsRecordsClient.ZoneIdentifier = core.StringPtr(zoneId)
+		for _, recordId := range recordIds {
+			opt := dnsRecordsClient.NewDeleteDnsRecordOptions(recordId)
+			_, response, err := dnsRecordsClient.DeleteDnsRecord(opt)
+			if err != nil {
+				log.Printf("[WARN] Error deleting the challenge TXT record %s in CIS: %s\n%s", recordId, err, response)
+			}
+		}
+	}
+}
@@ -114,6 +114,36 @@ func TestAccIbmSmPublicCertificateAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmPublicCertificateManualDnsCis(t *testing.T) {
+	resourceName := "ibm_sm_public_certificate.sm_public_certificate_manual_dns_cis"
+	commonName := generatePublicCertCommonName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPublicCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: publicCertificateConfigManualDnsCis(commonName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttr(resourceName, "dns", "manual"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+					resource.TestCheckResourceAttr(resourceName, "state", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state_description", "active"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cis", "issuance_info"},
+			},
+		},
+	})
+}
+
 var publicCertBasicConfigFormat = `
 		resource "ibm_sm_public_certificate" "sm_public_certificate_basic" {
 			instance_id   = "%s"
@@ -142,6 +172,19 @@ var publicCertFullConfigFormat = `
 			}
 		}`
 
+var publicCertManualDnsCisConfigFormat = `
+		resource "ibm_sm_public_certificate" "sm_public_certificate_manual_dns_cis" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			common_name = "%s"
+  			ca = ibm_sm_public_certificate_configuration_ca_lets_encrypt.sm_public_certificate_configuration_ca_lets_encrypt_instance.name
+  			dns = "manual"
+			cis {
+				cis_crn = "%s"
+			}
+		}`
+
 func letsEncryptCaConfig() string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "sm_public_certificate_configuration_ca_lets_encrypt_instance" {
@@ -171,6 +214,12 @@ func publicCertificateConfigBasic(commonName string) string {
 			publicCertName, commonName)
 }
 
+func publicCertificateConfigManualDnsCis(commonName string) string {
+	return letsEncryptCaConfig() +
+		fmt.Sprintf(publicCertManualDnsCisConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+			publicCertName, commonName, acc.SecretsManagerPublicCertificateCisCrn)
+}
+
 func publicCertificateConfigAllArgs(commonName string) string {
 	rotateKeys := "false"
 	return letsEncryptCaConfig() + dnsCisConfig() +
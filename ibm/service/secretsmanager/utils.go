@@ -57,6 +57,12 @@ func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schem
 	}
 }
 
+// GetClientWithInstanceEndpoint clones the base secrets manager client and sets the API endpoint of the
+// instance, for the resources of other services that read their credentials from a secret.
+func GetClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string, endpointType string) *secretsmanagerv2.SecretsManagerV2 {
+	return getClientWithInstanceEndpoint(originalClient, instanceId, region, endpointType)
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string, endpointType string) *secretsmanagerv2.SecretsManagerV2 {
 	// build the api endpoint
@@ -127,6 +133,79 @@ func StringIsIntBetween(min, max int) schema.SchemaValidateFunc {
 	}
 }
 
+// ttlUnitSeconds are the units a duration can be expressed in, a duration without unit is in seconds.
+var ttlUnitSeconds = map[string]int{
+	"s": 1,
+	"m": 60,
+	"h": 60 * 60,
+	"d": 24 * 60 * 60,
+}
+
+// durationToSeconds converts a duration such as `3600`, `90m`, `12h` or `30d` to a number of seconds.
+func durationToSeconds(duration string) (int, error) {
+	value := strings.TrimSpace(duration)
+	multiplier := 1
+	if len(value) > 0 {
+		if unitSeconds, ok := ttlUnitSeconds[strings.ToLower(value[len(value)-1:])]; ok {
+			multiplier = unitSeconds
+			value = value[:len(value)-1]
+		}
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("expected an integer number of seconds or an integer followed by a unit s, m, h or d, got %s", duration)
+	}
+	return v * multiplier, nil
+}
+
+// StringIsDurationBetween validates a duration, expressed as accepted by durationToSeconds, is between
+// min and max seconds.
+func StringIsDurationBetween(min, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		vs, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return warnings, errors
+		}
+
+		v, err := durationToSeconds(vs)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("invalid %s: %s", k, err))
+			return warnings, errors
+		}
+
+		if v < min || v > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d) seconds, got %d", k, min, max, v))
+			return warnings, errors
+		}
+
+		return warnings, errors
+	}
+}
+
+// durationDiffSuppress suppresses the diff between two durations of the same number of seconds.
+func durationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldSeconds, err := durationToSeconds(old)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := durationToSeconds(new)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
+
+// normalizeDuration returns a duration, expressed as accepted by durationToSeconds, as a number of
+// seconds.
+func normalizeDuration(duration string) string {
+	seconds, err := durationToSeconds(duration)
+	if err != nil {
+		return duration
+	}
+	return strconv.Itoa(seconds)
+}
+
 func DateTimeToRFC3339(dt *strfmt.DateTime) (s string) {
 	if dt != nil {
 		s = time.Time(*dt).Format(time.RFC3339)
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationToSeconds(t *testing.T) {
+	testcases := []struct {
+		duration    string
+		expected    int
+		expectError bool
+	}{
+		{duration: "3600", expected: 3600},
+		{duration: "45s", expected: 45},
+		{duration: "90m", expected: 5400},
+		{duration: "12h", expected: 43200},
+		{duration: "30d", expected: 2592000},
+		{duration: " 2H ", expected: 7200},
+		{duration: "0", expected: 0},
+		{duration: "", expectError: true},
+		{duration: "d", expectError: true},
+		{duration: "1.5h", expectError: true},
+		{duration: "-5m", expectError: true},
+		{duration: "10w", expectError: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.duration, func(t *testing.T) {
+			seconds, err := durationToSeconds(tc.duration)
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, seconds)
+		})
+	}
+}
+
+func TestDurationToSecondsErrorShowsValue(t *testing.T) {
+	_, err := durationToSeconds("abcd")
+	assert.Contains(t, err.Error(), "got abcd")
+}
+
+func TestStringIsDurationBetween(t *testing.T) {
+	validate := StringIsDurationBetween(60, 90*24*60*60)
+
+	_, errors := validate("1h", "ttl")
+	assert.Empty(t, errors)
+	_, errors = validate("90d", "ttl")
+	assert.Empty(t, errors)
+
+	_, errors = validate("30s", "ttl")
+	assert.Len(t, errors, 1, "below the minimum")
+	_, errors = validate("91d", "ttl")
+	assert.Len(t, errors, 1, "above the maximum")
+	_, errors = validate("one hour", "ttl")
+	assert.Len(t, errors, 1, "not a duration")
+	_, errors = validate(3600, "ttl")
+	assert.Len(t, errors, 1, "not a string")
+}
+
+func TestDurationDiffSuppress(t *testing.T) {
+	assert.True(t, durationDiffSuppress("ttl", "86400", "1d", nil))
+	assert.True(t, durationDiffSuppress("ttl", "60m", "1h", nil))
+	assert.False(t, durationDiffSuppress("ttl", "86400", "2d", nil))
+	assert.False(t, durationDiffSuppress("ttl", "", "1d", nil), "a new ttl is not suppressed")
+	assert.False(t, durationDiffSuppress("ttl", "1d", "invalid", nil))
+}
+
+func TestNormalizeDuration(t *testing.T) {
+	assert.Equal(t, "7776000", normalizeDuration("90d"))
+	assert.Equal(t, "3600", normalizeDuration("3600"))
+	// an invalid duration is returned unchanged, the validation reports it
+	assert.Equal(t, "invalid", normalizeDuration("invalid"))
+}
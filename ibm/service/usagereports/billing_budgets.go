@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// Budget is the budget model of the billing API.
+type Budget struct {
+	ID              string   `json:"id,omitempty"`
+	AccountID       string   `json:"account_id,omitempty"`
+	ResourceGroupID string   `json:"resource_group_id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Amount          *float64 `json:"amount,omitempty"`
+	Period          string   `json:"period,omitempty"`
+	Currency        string   `json:"currency,omitempty"`
+	CurrentSpend    *float64 `json:"current_spend,omitempty"`
+	CreatedAt       string   `json:"created_at,omitempty"`
+	UpdatedAt       string   `json:"updated_at,omitempty"`
+}
+
+// BudgetPatch holds the budget fields that are updated, unset fields are left unchanged.
+type BudgetPatch struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Amount      *float64 `json:"amount,omitempty"`
+	Period      *string  `json:"period,omitempty"`
+}
+
+// BudgetNotification is the spending notification model of the billing API.
+type BudgetNotification struct {
+	ID                            string   `json:"id,omitempty"`
+	Threshold                     *int64   `json:"threshold,omitempty"`
+	Type                          string   `json:"type,omitempty"`
+	EventNotificationsInstanceCRN string   `json:"event_notifications_instance_crn,omitempty"`
+	Emails                        []string `json:"emails,omitempty"`
+	LastTriggeredAt               string   `json:"last_triggered_at,omitempty"`
+	CreatedAt                     string   `json:"created_at,omitempty"`
+	UpdatedAt                     string   `json:"updated_at,omitempty"`
+}
+
+// BudgetNotificationPatch holds the notification fields that are updated, unset fields are left unchanged.
+type BudgetNotificationPatch struct {
+	Threshold                     *int64    `json:"threshold,omitempty"`
+	Type                          *string   `json:"type,omitempty"`
+	EventNotificationsInstanceCRN *string   `json:"event_notifications_instance_crn,omitempty"`
+	Emails                        *[]string `json:"emails,omitempty"`
+}
+
+// BillingBudgetsV1 implements the budget operations of the billing API, which are not yet
+// covered by the usagereportsv4 SDK. It shares the authenticator and endpoint of the SDK client.
+type BillingBudgetsV1 struct {
+	Service *core.BaseService
+}
+
+// NewBillingBudgetsV1 returns a budgets client that sends its requests through usageReportsClient.
+func NewBillingBudgetsV1(usageReportsClient *usagereportsv4.UsageReportsV4) *BillingBudgetsV1 {
+	return &BillingBudgetsV1{Service: usageReportsClient.Service}
+}
+
+// CreateBudgetWithContext creates a budget.
+func (billing *BillingBudgetsV1) CreateBudgetWithContext(ctx context.Context, budget *Budget) (*Budget, *core.DetailedResponse, error) {
+	result := &Budget{}
+	response, err := billing.request(ctx, core.POST, "/v1/budgets", nil, budget, result)
+	return result, response, err
+}
+
+// GetBudgetWithContext retrieves the budget with the given ID.
+func (billing *BillingBudgetsV1) GetBudgetWithContext(ctx context.Context, id string) (*Budget, *core.DetailedResponse, error) {
+	result := &Budget{}
+	response, err := billing.request(ctx, core.GET, "/v1/budgets/{id}", map[string]string{"id": id}, nil, result)
+	return result, response, err
+}
+
+// UpdateBudgetWithContext updates the fields of the budget with the given ID that are set in patch.
+func (billing *BillingBudgetsV1) UpdateBudgetWithContext(ctx context.Context, id string, patch *BudgetPatch) (*core.DetailedResponse, error) {
+	return billing.request(ctx, core.PATCH, "/v1/budgets/{id}", map[string]string{"id": id}, patch, nil)
+}
+
+// DeleteBudgetWithContext deletes the budget with the given ID.
+func (billing *BillingBudgetsV1) DeleteBudgetWithContext(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return billing.request(ctx, core.DELETE, "/v1/budgets/{id}", map[string]string{"id": id}, nil, nil)
+}
+
+// CreateBudgetNotificationWithContext creates a spending notification on the given budget.
+func (billing *BillingBudgetsV1) CreateBudgetNotificationWithContext(ctx context.Context, budgetID string, notification *BudgetNotification) (*BudgetNotification, *core.DetailedResponse, error) {
+	result := &BudgetNotification{}
+	response, err := billing.request(ctx, core.POST, "/v1/budgets/{budget_id}/notifications", map[string]string{"budget_id": budgetID}, notification, result)
+	return result, response, err
+}
+
+// GetBudgetNotificationWithContext retrieves a spending notification of the given budget.
+func (billing *BillingBudgetsV1) GetBudgetNotificationWithContext(ctx context.Context, budgetID, id string) (*BudgetNotification, *core.DetailedResponse, error) {
+	result := &BudgetNotification{}
+	pathParams := map[string]string{"budget_id": budgetID, "id": id}
+	response, err := billing.request(ctx, core.GET, "/v1/budgets/{budget_id}/notifications/{id}", pathParams, nil, result)
+	return result, response, err
+}
+
+// UpdateBudgetNotificationWithContext updates the fields of a spending notification that are set in patch.
+func (billing *BillingBudgetsV1) UpdateBudgetNotificationWithContext(ctx context.Context, budgetID, id string, patch *BudgetNotificationPatch) (*core.DetailedResponse, error) {
+	pathParams := map[string]string{"budget_id": budgetID, "id": id}
+	return billing.request(ctx, core.PATCH, "/v1/budgets/{budget_id}/notifications/{id}", pathParams, patch, nil)
+}
+
+// DeleteBudgetNotificationWithContext deletes a spending notification of the given budget.
+func (billing *BillingBudgetsV1) DeleteBudgetNotificationWithContext(ctx context.Context, budgetID, id string) (*core.DetailedResponse, error) {
+	pathParams := map[string]string{"budget_id": budgetID, "id": id}
+	return billing.request(ctx, core.DELETE, "/v1/budgets/{budget_id}/notifications/{id}", pathParams, nil, nil)
+}
+
+func (billing *BillingBudgetsV1) request(ctx context.Context, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = billing.Service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(billing.Service.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return billing.Service.Request(request, result)
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBillingBudgetsClient(t *testing.T, handler http.HandlerFunc) *BillingBudgetsV1 {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return &BillingBudgetsV1{Service: service}
+}
+
+func TestBillingBudgetsCreateBudget(t *testing.T) {
+	client := testBillingBudgetsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/budgets", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"account_id":"acct","name":"budget","amount":100,"period":"monthly"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"budget-id","name":"budget","amount":100,"currency":"USD"}`))
+	})
+
+	budget, _, err := client.CreateBudgetWithContext(context.Background(), &Budget{
+		AccountID: "acct",
+		Name:      "budget",
+		Amount:    core.Float64Ptr(100),
+		Period:    "monthly",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "budget-id", budget.ID)
+	assert.Equal(t, "USD", budget.Currency)
+	assert.Equal(t, float64(100), *budget.Amount)
+}
+
+func TestBillingBudgetsUpdateBudgetSendsOnlyChangedFields(t *testing.T) {
+	client := testBillingBudgetsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/v1/budgets/budget-id", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		// A cleared description is sent as an empty string
+		assert.JSONEq(t, `{"description":"","amount":250}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.UpdateBudgetWithContext(context.Background(), "budget-id", &BudgetPatch{
+		Description: core.StringPtr(""),
+		Amount:      core.Float64Ptr(250),
+	})
+	assert.Nil(t, err)
+}
+
+func TestBillingBudgetsGetBudgetNotFound(t *testing.T) {
+	client := testBillingBudgetsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/budgets/missing", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	})
+
+	_, response, err := client.GetBudgetWithContext(context.Background(), "missing")
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestBillingBudgetsNotificationOperations(t *testing.T) {
+	client := testBillingBudgetsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "/v1/budgets/budget-id/notifications/notification-id", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"notification-id","threshold":80,"type":"forecasted","emails":["finops@example.com"]}`))
+		case http.MethodPatch:
+			assert.Equal(t, "/v1/budgets/budget-id/notifications/notification-id", r.URL.Path)
+			patch := map[string]interface{}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&patch))
+			// Removing every email sends an empty list rather than leaving the emails unchanged
+			assert.Equal(t, map[string]interface{}{"threshold": float64(90), "emails": []interface{}{}}, patch)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			assert.Equal(t, "/v1/budgets/budget-id/notifications/notification-id", r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	notification, _, err := client.GetBudgetNotificationWithContext(context.Background(), "budget-id", "notification-id")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(80), *notification.Threshold)
+	assert.Equal(t, "forecasted", notification.Type)
+	assert.Equal(t, []string{"finops@example.com"}, notification.Emails)
+
+	_, err = client.UpdateBudgetNotificationWithContext(context.Background(), "budget-id", "notification-id", &BudgetNotificationPatch{
+		Threshold: core.Int64Ptr(90),
+		Emails:    &[]string{},
+	})
+	assert.Nil(t, err)
+
+	_, err = client.DeleteBudgetNotificationWithContext(context.Background(), "budget-id", "notification-id")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMBillingBudget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMBillingBudgetCreate,
+		ReadContext:   resourceIBMBillingBudgetRead,
+		UpdateContext: resourceIBMBillingBudgetUpdate,
+		DeleteContext: resourceIBMBillingBudgetDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_billing_budget", "name"),
+				Description:  "The name of the budget.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description of the budget.",
+			},
+			"amount": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "The spending limit of the budget for each period, in the billing currency of the account.",
+			},
+			"period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "monthly",
+				ValidateFunc: validate.InvokeValidator("ibm_billing_budget", "period"),
+				Description:  "The period over which spending is accumulated against the budget.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group that the budget is scoped to. When not set, the budget covers the whole account.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account that the budget belongs to.",
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency of the budget amount.",
+			},
+			"current_spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The spending accumulated against the budget in the current period.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the budget was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the budget was last updated.",
+			},
+		},
+	}
+}
+
+func ResourceIBMBillingBudgetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9 _.-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "period",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "monthly, quarterly, yearly",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_billing_budget", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMBillingBudgetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	amount := d.Get("amount").(float64)
+	prototype := &Budget{
+		AccountID: userDetails.UserAccount,
+		Name:      d.Get("name").(string),
+		Amount:    &amount,
+		Period:    d.Get("period").(string),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		prototype.Description = d.Get("description").(string)
+	}
+	if _, ok := d.GetOk("resource_group_id"); ok {
+		prototype.ResourceGroupID = d.Get("resource_group_id").(string)
+	}
+
+	budget, response, err := NewBillingBudgetsV1(usageReportsClient).CreateBudgetWithContext(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBudgetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateBudgetWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(budget.ID)
+
+	return resourceIBMBillingBudgetRead(context, d, meta)
+}
+
+func resourceIBMBillingBudgetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	budget, response, err := NewBillingBudgetsV1(usageReportsClient).GetBudgetWithContext(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBudgetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetBudgetWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", budget.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", budget.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if !core.IsNil(budget.Amount) {
+		if err = d.Set("amount", *budget.Amount); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting amount: %s", err))
+		}
+	}
+	if err = d.Set("period", budget.Period); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting period: %s", err))
+	}
+	if err = d.Set("resource_group_id", budget.ResourceGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_group_id: %s", err))
+	}
+	if err = d.Set("account_id", budget.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("currency", budget.Currency); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting currency: %s", err))
+	}
+	if !core.IsNil(budget.CurrentSpend) {
+		if err = d.Set("current_spend", *budget.CurrentSpend); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting current_spend: %s", err))
+		}
+	}
+	if err = d.Set("created_at", budget.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", budget.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMBillingBudgetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChange := false
+	patch := &BudgetPatch{}
+	if d.HasChange("name") {
+		patch.Name = core.StringPtr(d.Get("name").(string))
+		hasChange = true
+	}
+	if d.HasChange("description") {
+		patch.Description = core.StringPtr(d.Get("description").(string))
+		hasChange = true
+	}
+	if d.HasChange("amount") {
+		patch.Amount = core.Float64Ptr(d.Get("amount").(float64))
+		hasChange = true
+	}
+	if d.HasChange("period") {
+		patch.Period = core.StringPtr(d.Get("period").(string))
+		hasChange = true
+	}
+
+	if hasChange {
+		response, err := NewBillingBudgetsV1(usageReportsClient).UpdateBudgetWithContext(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBudgetWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateBudgetWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMBillingBudgetRead(context, d, meta)
+}
+
+func resourceIBMBillingBudgetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := NewBillingBudgetsV1(usageReportsClient).DeleteBudgetWithContext(context, d.Id())
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteBudgetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteBudgetWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMBillingBudgetNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMBillingBudgetNotificationCreate,
+		ReadContext:   resourceIBMBillingBudgetNotificationRead,
+		UpdateContext: resourceIBMBillingBudgetNotificationUpdate,
+		DeleteContext: resourceIBMBillingBudgetNotificationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"budget_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the budget that the notification is attached to.",
+			},
+			"threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_billing_budget_notification", "threshold"),
+				Description:  "The percentage of the budget amount at which the notification is sent.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "actual",
+				ValidateFunc: validate.InvokeValidator("ibm_billing_budget_notification", "type"),
+				Description:  "Whether the threshold is evaluated against the actual or the forecasted spend of the period.",
+			},
+			"event_notifications_instance_crn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"event_notifications_instance_crn", "emails"},
+				Description:  "The CRN of the Event Notifications instance that receives the notification.",
+			},
+			"emails": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"event_notifications_instance_crn", "emails"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "The email addresses that receive the notification.",
+			},
+			"notification_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the notification.",
+			},
+			"last_triggered_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the notification was last sent.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the notification was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the notification was last updated.",
+			},
+		},
+	}
+}
+
+func ResourceIBMBillingBudgetNotificationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "threshold",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1000",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "actual, forecasted",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_billing_budget_notification", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMBillingBudgetNotificationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	budgetID := d.Get("budget_id").(string)
+	threshold := int64(d.Get("threshold").(int))
+	prototype := &BudgetNotification{
+		Threshold: &threshold,
+		Type:      d.Get("type").(string),
+	}
+	if _, ok := d.GetOk("event_notifications_instance_crn"); ok {
+		prototype.EventNotificationsInstanceCRN = d.Get("event_notifications_instance_crn").(string)
+	}
+	if _, ok := d.GetOk("emails"); ok {
+		prototype.Emails = flex.ExpandStringList(d.Get("emails").([]interface{}))
+	}
+
+	notification, response, err := NewBillingBudgetsV1(usageReportsClient).CreateBudgetNotificationWithContext(context, budgetID, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBudgetNotificationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateBudgetNotificationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", budgetID, notification.ID))
+
+	return resourceIBMBillingBudgetNotificationRead(context, d, meta)
+}
+
+func resourceIBMBillingBudgetNotificationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notification, response, err := NewBillingBudgetsV1(usageReportsClient).GetBudgetNotificationWithContext(context, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBudgetNotificationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetBudgetNotificationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("budget_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting budget_id: %s", err))
+	}
+	if !core.IsNil(notification.Threshold) {
+		if err = d.Set("threshold", flex.IntValue(notification.Threshold)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting threshold: %s", err))
+		}
+	}
+	if err = d.Set("type", notification.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("event_notifications_instance_crn", notification.EventNotificationsInstanceCRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications_instance_crn: %s", err))
+	}
+	if err = d.Set("emails", notification.Emails); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting emails: %s", err))
+	}
+	if err = d.Set("notification_id", notification.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting notification_id: %s", err))
+	}
+	if err = d.Set("last_triggered_at", notification.LastTriggeredAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_triggered_at: %s", err))
+	}
+	if err = d.Set("created_at", notification.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", notification.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMBillingBudgetNotificationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChange := false
+	patch := &BudgetNotificationPatch{}
+	if d.HasChange("threshold") {
+		patch.Threshold = core.Int64Ptr(int64(d.Get("threshold").(int)))
+		hasChange = true
+	}
+	if d.HasChange("type") {
+		patch.Type = core.StringPtr(d.Get("type").(string))
+		hasChange = true
+	}
+	if d.HasChange("event_notifications_instance_crn") {
+		patch.EventNotificationsInstanceCRN = core.StringPtr(d.Get("event_notifications_instance_crn").(string))
+		hasChange = true
+	}
+	if d.HasChange("emails") {
+		emails := flex.ExpandStringList(d.Get("emails").([]interface{}))
+		patch.Emails = &emails
+		hasChange = true
+	}
+
+	if hasChange {
+		response, err := NewBillingBudgetsV1(usageReportsClient).UpdateBudgetNotificationWithContext(context, parts[0], parts[1], patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBudgetNotificationWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateBudgetNotificationWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMBillingBudgetNotificationRead(context, d, meta)
+}
+
+func resourceIBMBillingBudgetNotificationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := NewBillingBudgetsV1(usageReportsClient).DeleteBudgetNotificationWithContext(context, parts[0], parts[1])
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteBudgetNotificationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteBudgetNotificationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
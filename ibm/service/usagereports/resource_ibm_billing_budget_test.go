@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/usagereports"
+)
+
+func TestAccIBMBillingBudgetBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-budget-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-budget-update-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckUsage(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMBillingBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingBudgetConfigBasic(name, 1000, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_billing_budget.billing_budget_instance", "name", name),
+					resource.TestCheckResourceAttr("ibm_billing_budget.billing_budget_instance", "amount", "1000"),
+					resource.TestCheckResourceAttr("ibm_billing_budget.billing_budget_instance", "period", "monthly"),
+					resource.TestCheckResourceAttrSet("ibm_billing_budget.billing_budget_instance", "account_id"),
+					resource.TestCheckResourceAttr("ibm_billing_budget_notification.billing_budget_notification_instance", "threshold", "80"),
+					resource.TestCheckResourceAttr("ibm_billing_budget_notification.billing_budget_notification_instance", "type", "actual"),
+					resource.TestCheckResourceAttrSet("ibm_billing_budget_notification.billing_budget_notification_instance", "notification_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMBillingBudgetConfigBasic(nameUpdate, 2000, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_billing_budget.billing_budget_instance", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_billing_budget.billing_budget_instance", "amount", "2000"),
+					resource.TestCheckResourceAttr("ibm_billing_budget_notification.billing_budget_notification_instance", "threshold", "90"),
+				),
+			},
+			{
+				ResourceName:      "ibm_billing_budget.billing_budget_instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMBillingBudgetNotificationNoDestination(t *testing.T) {
+	name := fmt.Sprintf("tf-budget-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckUsage(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "ibm_billing_budget" "billing_budget_instance" {
+						name   = "%s"
+						amount = 1000
+					}
+
+					resource "ibm_billing_budget_notification" "billing_budget_notification_instance" {
+						budget_id = ibm_billing_budget.billing_budget_instance.id
+						threshold = 80
+					}
+				`, name),
+				ExpectError: regexp.MustCompile("one of `emails,event_notifications_instance_crn` must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingBudgetConfigBasic(name string, amount int, threshold int) string {
+	return fmt.Sprintf(`
+		resource "ibm_billing_budget" "billing_budget_instance" {
+			name        = "%s"
+			description = "Budget created by terraform acceptance tests"
+			amount      = %d
+		}
+
+		resource "ibm_billing_budget_notification" "billing_budget_notification_instance" {
+			budget_id = ibm_billing_budget.billing_budget_instance.id
+			threshold = %d
+			emails    = ["finops@example.com"]
+		}
+	`, name, amount, threshold)
+}
+
+func testAccCheckIBMBillingBudgetDestroy(s *terraform.State) error {
+	usageReportsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_billing_budget" {
+			continue
+		}
+
+		_, response, err := usagereports.NewBillingBudgetsV1(usageReportsClient).GetBudgetWithContext(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("billing_budget still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for billing_budget (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,459 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+// directorSiteFileSharesFields maps the arguments of the file shares of a cluster to the fields of the storage
+// tiers of fileShares.
+func directorSiteFileSharesFields(fileShares *vmwarev1.FileShares) map[string]**int64 {
+	return map[string]**int64{
+		"storage_point_two_five_iops_gb": &fileShares.STORAGEPOINTTWOFIVEIOPSGB,
+		"storage_two_iops_gb":            &fileShares.STORAGETWOIOPSGB,
+		"storage_four_iops_gb":           &fileShares.STORAGEFOURIOPSGB,
+		"storage_ten_iops_gb":            &fileShares.STORAGETENIOPSGB,
+	}
+}
+
+func ResourceIbmVmaasDirectorSite() *schema.Resource {
+	fileSharesSchema := map[string]*schema.Schema{}
+	for key := range directorSiteFileSharesFields(&vmwarev1.FileShares{}) {
+		fileSharesSchema[key] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The size of the file shares of this storage tier in GB.",
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIbmVmaasDirectorSiteCreate,
+		ReadContext:   resourceIbmVmaasDirectorSiteRead,
+		UpdateContext: resourceIbmVmaasDirectorSiteUpdate,
+		DeleteContext: resourceIbmVmaasDirectorSiteDelete,
+		CustomizeDiff: resourceIbmVmaasDirectorSiteClustersDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(6 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_vmaas_director_site", "name"),
+				Description:  "A human readable ID for the Cloud Director site.",
+			},
+			"resource_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group of the Cloud Director site, the default resource group when not set.",
+			},
+			"private_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the Cloud Director site is reachable through the private network only.",
+			},
+			"services": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the services deployed with the Cloud Director site, for example `veeam` or `vcda`.",
+			},
+			"pvdcs": &schema.Schema{
+				Type:        schema.TypeList,
+				MinItems:    1,
+				Required:    true,
+				Description: "The resource pools of the Cloud Director site.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the resource pool.",
+						},
+						"data_center_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the data center where the resource pool is deployed, for example `dal10`.",
+						},
+						"clusters": &schema.Schema{
+							Type:        schema.TypeList,
+							MinItems:    1,
+							Required:    true,
+							Description: "The clusters of the resource pool.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: "The name of the cluster.",
+									},
+									"host_profile": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: "The profile of the hosts of the cluster, for example `BM_2S_20_CORES_192_GB`.",
+									},
+									"host_count": &schema.Schema{
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "The number of hosts of the cluster.",
+									},
+									"file_shares": &schema.Schema{
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Optional:    true,
+										Computed:    true,
+										Description: "The sizes of the file shares of the cluster by storage tier.",
+										Elem: &schema.Resource{
+											Schema: fileSharesSchema,
+										},
+									},
+									"id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the cluster.",
+									},
+									"status": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status of the cluster.",
+									},
+								},
+							},
+						},
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource pool.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the resource pool.",
+						},
+					},
+				},
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the Cloud Director site.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the Cloud Director site.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time that the Cloud Director site was ordered.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the Cloud Director site.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the Cloud Director site, single_tenant or multitenant.",
+			},
+		},
+	}
+}
+
+func ResourceIbmVmaasDirectorSiteValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9][-a-zA-Z0-9_]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_vmaas_director_site", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIbmVmaasDirectorSiteClustersDiff refuses to add or remove resource pools and clusters, only
+// the hosts and the file shares of the existing clusters are scaled in place.
+func resourceIbmVmaasDirectorSiteClustersDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("pvdcs") {
+		return nil
+	}
+	oldPvdcs, newPvdcs := diff.GetChange("pvdcs")
+	if len(oldPvdcs.([]interface{})) != len(newPvdcs.([]interface{})) {
+		return fmt.Errorf("[ERROR] Resource pools can not be added to or removed from director site %s", diff.Id())
+	}
+	for i := range newPvdcs.([]interface{}) {
+		oldClusters, newClusters := diff.GetChange(fmt.Sprintf("pvdcs.%d.clusters", i))
+		if len(oldClusters.([]interface{})) != len(newClusters.([]interface{})) {
+			return fmt.Errorf("[ERROR] Clusters can not be added to or removed from resource pool %d of director site %s", i, diff.Id())
+		}
+	}
+	return nil
+}
+
+func resourceIbmVmaasDirectorSiteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareClient, err := meta.(conns.ClientSession).VmwareV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pvdcs := []vmwarev1.PVDCPrototype{}
+	for _, pvdcIntf := range d.Get("pvdcs").([]interface{}) {
+		pvdc := pvdcIntf.(map[string]interface{})
+		clusters := []vmwarev1.ClusterPrototype{}
+		for _, clusterIntf := range pvdc["clusters"].([]interface{}) {
+			cluster := clusterIntf.(map[string]interface{})
+			clusters = append(clusters, vmwarev1.ClusterPrototype{
+				Name:        core.StringPtr(cluster["name"].(string)),
+				HostProfile: core.StringPtr(cluster["host_profile"].(string)),
+				HostCount:   core.Int64Ptr(int64(cluster["host_count"].(int))),
+				FileShares:  resourceIbmVmaasDirectorSiteMapToFileShares(cluster["file_shares"].([]interface{})),
+			})
+		}
+		pvdcs = append(pvdcs, vmwarev1.PVDCPrototype{
+			Name:           core.StringPtr(pvdc["name"].(string)),
+			DataCenterName: core.StringPtr(pvdc["data_center_name"].(string)),
+			Clusters:       clusters,
+		})
+	}
+
+	createDirectorSitesOptions := vmwareClient.NewCreateDirectorSitesOptions(d.Get("name").(string), pvdcs)
+	if resourceGroupID, ok := d.GetOk("resource_group_id"); ok {
+		createDirectorSitesOptions.SetResourceGroup(&vmwarev1.ResourceGroupIdentity{ID: core.StringPtr(resourceGroupID.(string))})
+	}
+	if privateOnly, ok := d.GetOk("private_only"); ok {
+		createDirectorSitesOptions.SetPrivateOnly(privateOnly.(bool))
+	}
+	if services, ok := d.GetOk("services"); ok {
+		serviceIdentities := []vmwarev1.ServiceIdentity{}
+		for _, service := range services.(*schema.Set).List() {
+			serviceIdentities = append(serviceIdentities, vmwarev1.ServiceIdentity{Name: core.StringPtr(service.(string))})
+		}
+		createDirectorSitesOptions.SetServices(serviceIdentities)
+	}
+
+	directorSite, response, err := vmwareClient.CreateDirectorSitesWithContext(context, createDirectorSitesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateDirectorSitesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateDirectorSitesWithContext failed %s\n%s", err, response))
+	}
+	if directorSite.ID == nil {
+		return diag.FromErr(fmt.Errorf("CreateDirectorSitesWithContext returned no director site ID\n%s", response))
+	}
+
+	d.SetId(*directorSite.ID)
+
+	if waitForDirectorSiteStatus {
+		_, err = waitForDirectorSiteStatusUpdate(context, d, meta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for director site (%s) to be ready: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIbmVmaasDirectorSiteRead(context, d, meta)
+}
+
+func resourceIbmVmaasDirectorSiteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directorSite, response, err := getDirectorSite(context, meta, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetDirectorSiteWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDirectorSiteWithContext failed %s\n%s", err, response))
+	}
+
+	// the API does not return private_only, it keeps the configured value
+	attributes := map[string]interface{}{
+		"name":       directorSite.Name,
+		"crn":        directorSite.Crn,
+		"href":       directorSite.Href,
+		"created_at": flex.DateTimeToString(directorSite.OrderedAt),
+		"status":     directorSite.Status,
+		"type":       directorSite.Type,
+	}
+	if directorSite.ResourceGroup != nil {
+		attributes["resource_group_id"] = directorSite.ResourceGroup.ID
+	}
+	services := []string{}
+	for _, service := range directorSite.Services {
+		services = append(services, *service.Name)
+	}
+	attributes["services"] = services
+
+	pvdcs := []map[string]interface{}{}
+	for _, pvdc := range directorSite.Pvdcs {
+		clusters := []map[string]interface{}{}
+		for _, cluster := range pvdc.Clusters {
+			clusters = append(clusters, map[string]interface{}{
+				"id":           cluster.ID,
+				"name":         cluster.Name,
+				"host_profile": cluster.HostProfile,
+				"host_count":   flex.IntValue(cluster.HostCount),
+				"status":       cluster.Status,
+				"file_shares":  resourceIbmVmaasDirectorSiteFileSharesToMap(cluster.FileShares),
+			})
+		}
+		pvdcs = append(pvdcs, map[string]interface{}{
+			"id":               pvdc.ID,
+			"name":             pvdc.Name,
+			"data_center_name": pvdc.DataCenterName,
+			"status":           pvdc.Status,
+			"clusters":         clusters,
+		})
+	}
+	attributes["pvdcs"] = pvdcs
+
+	for key, value := range attributes {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmVmaasDirectorSiteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareClient, err := meta.(conns.ClientSession).VmwareV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for i, pvdcIntf := range d.Get("pvdcs").([]interface{}) {
+		pvdc := pvdcIntf.(map[string]interface{})
+		for j, clusterIntf := range pvdc["clusters"].([]interface{}) {
+			cluster := clusterIntf.(map[string]interface{})
+			clusterKey := fmt.Sprintf("pvdcs.%d.clusters.%d", i, j)
+			if !d.HasChange(clusterKey+".host_count") && !d.HasChange(clusterKey+".file_shares") {
+				continue
+			}
+
+			// the clusters are scaled one after the other, the hosts of a cluster are added or removed
+			// while the site keeps running. The API does not accept the hosts and the file shares in the
+			// same patch, they are sent one after the other.
+			patches := []*vmwarev1.ClusterPatch{}
+			if d.HasChange(clusterKey + ".host_count") {
+				patches = append(patches, &vmwarev1.ClusterPatch{HostCount: core.Int64Ptr(int64(cluster["host_count"].(int)))})
+			}
+			if d.HasChange(clusterKey + ".file_shares") {
+				patches = append(patches, &vmwarev1.ClusterPatch{FileShares: resourceIbmVmaasDirectorSiteMapToFileShares(cluster["file_shares"].([]interface{}))})
+			}
+			for _, patch := range patches {
+				body, err := patch.AsPatch()
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("Error calling AsPatch for ClusterPatch %s", err))
+				}
+				updateClusterOptions := vmwareClient.NewUpdateDirectorSitesPvdcsClusterOptions(d.Id(), cluster["id"].(string), pvdc["id"].(string), body)
+				_, response, err := vmwareClient.UpdateDirectorSitesPvdcsClusterWithContext(context, updateClusterOptions)
+				if err != nil {
+					log.Printf("[DEBUG] UpdateDirectorSitesPvdcsClusterWithContext failed %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("UpdateDirectorSitesPvdcsClusterWithContext failed %s\n%s", err, response))
+				}
+
+				if waitForDirectorSiteStatus {
+					_, err = waitForDirectorSiteStatusUpdate(context, d, meta, d.Timeout(schema.TimeoutUpdate))
+					if err != nil {
+						return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster %s of director site (%s) to be ready: %s", cluster["name"], d.Id(), err))
+					}
+				}
+			}
+		}
+	}
+
+	return resourceIbmVmaasDirectorSiteRead(context, d, meta)
+}
+
+func resourceIbmVmaasDirectorSiteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareClient, err := meta.(conns.ClientSession).VmwareV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDirectorSiteOptions := vmwareClient.NewDeleteDirectorSiteOptions(d.Id())
+
+	_, response, err := vmwareClient.DeleteDirectorSiteWithContext(context, deleteDirectorSiteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteDirectorSiteWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteDirectorSiteWithContext failed %s\n%s", err, response))
+	}
+
+	if waitForDirectorSiteStatus {
+		_, err = waitForDirectorSiteToDelete(context, d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for director site (%s) to be deleted: %s", d.Id(), err))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmVmaasDirectorSiteMapToFileShares(fileSharesList []interface{}) *vmwarev1.FileSharesPrototype {
+	fileShares := &vmwarev1.FileShares{}
+	if len(fileSharesList) > 0 && fileSharesList[0] != nil {
+		modelMap := fileSharesList[0].(map[string]interface{})
+		for key, field := range directorSiteFileSharesFields(fileShares) {
+			if size, ok := modelMap[key].(int); ok && size > 0 {
+				*field = core.Int64Ptr(int64(size))
+			}
+		}
+	}
+	fileSharesPrototype := vmwarev1.FileSharesPrototype(*fileShares)
+	return &fileSharesPrototype
+}
+
+func resourceIbmVmaasDirectorSiteFileSharesToMap(fileShares *vmwarev1.FileShares) []map[string]interface{} {
+	if fileShares == nil {
+		return []map[string]interface{}{}
+	}
+	modelMap := map[string]interface{}{}
+	for key, field := range directorSiteFileSharesFields(fileShares) {
+		modelMap[key] = flex.IntValue(*field)
+	}
+	return []map[string]interface{}{modelMap}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmVmaasDirectorSiteBasic(t *testing.T) {
+	// ordering a director site takes hours and bills bare metal hosts, the test runs only on request
+	if os.Getenv("IBM_VMAAS_DIRECTOR_SITE_TEST") == "" {
+		t.Skip("IBM_VMAAS_DIRECTOR_SITE_TEST must be set for the director site acceptance test")
+	}
+	name := fmt.Sprintf("tf-site-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmVmaasDirectorSiteConfigBasic(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmaas_director_site.vmaas_director_site_instance", "name", name),
+					resource.TestCheckResourceAttr("ibm_vmaas_director_site.vmaas_director_site_instance", "status", "ready_to_use"),
+					resource.TestCheckResourceAttrSet("ibm_vmaas_director_site.vmaas_director_site_instance", "pvdcs.0.id"),
+					resource.TestCheckResourceAttrSet("ibm_vmaas_director_site.vmaas_director_site_instance", "pvdcs.0.clusters.0.id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmVmaasDirectorSiteConfigBasic(name, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmaas_director_site.vmaas_director_site_instance", "pvdcs.0.clusters.0.host_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmVmaasDirectorSiteConfigBasic(name string, hostCount int) string {
+	return fmt.Sprintf(`
+		resource "ibm_vmaas_director_site" "vmaas_director_site_instance" {
+			name = "%s"
+			pvdcs {
+				name             = "pvdc-dal10"
+				data_center_name = "dal10"
+				clusters {
+					name         = "cluster-1"
+					host_profile = "BM_2S_20_CORES_192_GB"
+					host_count   = %d
+					file_shares {
+						storage_two_iops_gb = 24000
+					}
+				}
+			}
+		}
+	`, name, hostCount)
+}
@@ -6,11 +6,14 @@ package vmware
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/IBM/vmware-go-sdk/vmwarev1"
@@ -24,10 +27,21 @@ func loadWaitForVdcStatusEnvVar() bool {
 
 var waitForVdcStatus = loadWaitForVdcStatusEnvVar()
 
+func loadWaitForDirectorSiteStatusEnvVar() bool {
+	envValue := os.Getenv("IBM_VMAAS_WAIT_FOR_DIRECTOR_SITE_STATUS")
+	return strings.ToLower(envValue) != "false"
+}
+
+var waitForDirectorSiteStatus = loadWaitForDirectorSiteStatusEnvVar()
+
 const VdcFinalState = "ready_to_use"
 const VdcCreatingState = "creating"
 const isVdcDeleting = "false"
 const isVdcDeleteDone = "true"
+const DirectorSiteFinalState = "ready_to_use"
+const DirectorSiteFailedState = "failed"
+const isDirectorSiteDeleting = "false"
+const isDirectorSiteDeleteDone = "true"
 
 // waits for Vdc instance to be in ready state
 func waitForVdcStatusUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -105,3 +119,83 @@ func waitForVdcToDelete(context context.Context, d *schema.ResourceData, meta in
 
 	return stateConf.WaitForStateContext(context)
 }
+
+// getDirectorSite returns the director site with the given ID.
+func getDirectorSite(context context.Context, meta interface{}, id string) (*vmwarev1.DirectorSite, *core.DetailedResponse, error) {
+	vmwareClient, err := meta.(conns.ClientSession).VmwareV1()
+	if err != nil {
+		return nil, nil, err
+	}
+	return vmwareClient.GetDirectorSiteWithContext(context, vmwareClient.NewGetDirectorSiteOptions(id))
+}
+
+// directorSiteReadyStatus returns the status of the director site, which is ready when all its clusters
+// are. A failed site or cluster is returned as an error.
+func directorSiteReadyStatus(directorSite *vmwarev1.DirectorSite) (string, error) {
+	status := flex.StringValue(directorSite.Status)
+	if status == DirectorSiteFailedState {
+		return status, fmt.Errorf("[ERROR] The director site %s failed", flex.StringValue(directorSite.ID))
+	}
+	if status != DirectorSiteFinalState {
+		return status, nil
+	}
+	for _, pvdc := range directorSite.Pvdcs {
+		for _, cluster := range pvdc.Clusters {
+			clusterStatus := flex.StringValue(cluster.Status)
+			if clusterStatus == DirectorSiteFailedState {
+				return clusterStatus, fmt.Errorf("[ERROR] The cluster %s of director site %s failed", flex.StringValue(cluster.Name), flex.StringValue(directorSite.ID))
+			}
+			if clusterStatus != "" && clusterStatus != DirectorSiteFinalState {
+				return "modifying", nil
+			}
+		}
+	}
+	return status, nil
+}
+
+// waits for the director site and its clusters to be ready to use, the order of a director site takes
+// several hours
+func waitForDirectorSiteStatusUpdate(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating", "modifying", "updating"},
+		Target:  []string{DirectorSiteFinalState},
+		Refresh: func() (interface{}, string, error) {
+			directorSite, _, err := getDirectorSite(context, meta, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] The director site %s is currently in the %s state", d.Id(), flex.StringValue(directorSite.Status))
+			status, err := directorSiteReadyStatus(directorSite)
+			return directorSite, status, err
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func waitForDirectorSiteToDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isDirectorSiteDeleting},
+		Target:  []string{isDirectorSiteDeleteDone},
+		Refresh: func() (interface{}, string, error) {
+			directorSite, response, err := getDirectorSite(context, meta, d.Id())
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return directorSite, isDirectorSiteDeleteDone, nil
+				}
+				return nil, "", err
+			}
+			if flex.StringValue(directorSite.Status) == "deleted" {
+				return directorSite, isDirectorSiteDeleteDone, nil
+			}
+			return directorSite, isDirectorSiteDeleting, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      60 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
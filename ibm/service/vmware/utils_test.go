@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectorSiteReadyStatus(t *testing.T) {
+	site := func(status string, clusterStatuses ...string) *vmwarev1.DirectorSite {
+		pvdc := vmwarev1.PVDC{}
+		for _, clusterStatus := range clusterStatuses {
+			pvdc.Clusters = append(pvdc.Clusters, vmwarev1.ClusterSummary{Name: core.StringPtr("cluster"), Status: core.StringPtr(clusterStatus)})
+		}
+		return &vmwarev1.DirectorSite{ID: core.StringPtr("site-id"), Status: core.StringPtr(status), Pvdcs: []vmwarev1.PVDC{pvdc}}
+	}
+
+	status, err := directorSiteReadyStatus(site("creating"))
+	assert.Nil(t, err)
+	assert.Equal(t, "creating", status)
+
+	status, err = directorSiteReadyStatus(site(DirectorSiteFinalState, DirectorSiteFinalState, ""))
+	assert.Nil(t, err)
+	assert.Equal(t, DirectorSiteFinalState, status)
+
+	// the site is not ready while one of its clusters is scaled
+	status, err = directorSiteReadyStatus(site(DirectorSiteFinalState, DirectorSiteFinalState, "modifying"))
+	assert.Nil(t, err)
+	assert.Equal(t, "modifying", status)
+
+	_, err = directorSiteReadyStatus(site(DirectorSiteFinalState, DirectorSiteFailedState))
+	assert.NotNil(t, err)
+
+	_, err = directorSiteReadyStatus(site(DirectorSiteFailedState))
+	assert.NotNil(t, err)
+}
+
+func TestResourceIbmVmaasDirectorSiteMapToFileShares(t *testing.T) {
+	fileShares := resourceIbmVmaasDirectorSiteMapToFileShares([]interface{}{
+		map[string]interface{}{"storage_two_iops_gb": 100, "storage_four_iops_gb": 0},
+	})
+	assert.Equal(t, int64(100), *fileShares.STORAGETWOIOPSGB)
+	// unset storage tiers are left out of the request
+	assert.Nil(t, fileShares.STORAGEFOURIOPSGB)
+	assert.Nil(t, fileShares.STORAGETENIOPSGB)
+
+	modelMap := resourceIbmVmaasDirectorSiteFileSharesToMap(&vmwarev1.FileShares{STORAGETENIOPSGB: core.Int64Ptr(200)})
+	assert.Equal(t, 200, modelMap[0]["storage_ten_iops_gb"])
+	assert.Equal(t, 0, modelMap[0]["storage_two_iops_gb"])
+}
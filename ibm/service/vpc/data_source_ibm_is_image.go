@@ -6,6 +6,8 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -31,17 +33,32 @@ func DataSourceIBMISImage() *schema.Resource {
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"identifier", "name"},
+				ExactlyOneOf: []string{"identifier", "name", "os_name"},
 				Description:  "Image name",
 			},
 
 			"identifier": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"identifier", "name"},
+				ExactlyOneOf: []string{"identifier", "name", "os_name"},
 				Description:  "Image id",
 			},
 
+			"os_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"identifier", "name", "os_name"},
+				Description:  "The name of the operating system of the available image to look up, for example ubuntu-22-04-amd64",
+			},
+
+			"most_recent": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"identifier", "name"},
+				Description:   "If more than one image matches os_name, use the most recent one",
+			},
+
 			"visibility": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -155,9 +172,11 @@ func DataSourceIBMISImage() *schema.Resource {
 				Description: "Image Operating system",
 			},
 			"architecture": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The operating system architecture",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"identifier", "name"},
+				Description:   "The operating system architecture",
 			},
 			"crn": {
 				Type:        schema.TypeString,
@@ -269,11 +288,70 @@ func dataSourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
+	} else if osName := d.Get("os_name").(string); osName != "" {
+		err := imageGetByOS(d, meta, osName, d.Get("architecture").(string), visibility, d.Get("most_recent").(bool))
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// imageGetByOS looks up the available image with the given operating system, a stock image
+// unless another visibility is given.
+func imageGetByOS(d *schema.ResourceData, meta interface{}, osName, architecture, visibility string, mostRecent bool) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	if visibility == "" {
+		visibility = "public"
+	}
+	listImagesOptions := &vpcv1.ListImagesOptions{
+		Visibility: &visibility,
+	}
+
+	start := ""
+	allrecs := []vpcv1.Image{}
+	for {
+		if start != "" {
+			listImagesOptions.Start = &start
+		}
+		availableImages, response, err := sess.ListImages(listImagesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+		}
+		for _, image := range availableImages.Images {
+			if *image.Status != "available" || image.OperatingSystem == nil || *image.OperatingSystem.Name != osName {
+				continue
+			}
+			if architecture != "" && *image.OperatingSystem.Architecture != architecture {
+				continue
+			}
+			allrecs = append(allrecs, image)
+		}
+		start = flex.GetNext(availableImages.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	if len(allrecs) == 0 {
+		return fmt.Errorf("[ERROR] No available %s image found with operating system %s", visibility, osName)
+	}
+	if len(allrecs) > 1 && !mostRecent {
+		return fmt.Errorf("[ERROR] %d available %s images found with operating system %s, set most_recent to use the most recent one", len(allrecs), visibility, osName)
+	}
+	sort.SliceStable(allrecs, func(i, j int) bool {
+		if allrecs[i].CreatedAt == nil || allrecs[j].CreatedAt == nil {
+			return allrecs[j].CreatedAt == nil && allrecs[i].CreatedAt != nil
+		}
+		return time.Time(*allrecs[i].CreatedAt).After(time.Time(*allrecs[j].CreatedAt))
+	})
+	return imageGetById(d, meta, *allrecs[0].ID)
+}
+
 func imageGetByName(d *schema.ResourceData, meta interface{}, name, visibility string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
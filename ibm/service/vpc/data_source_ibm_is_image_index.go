@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMISImageIndex() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISImageIndexRead,
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"amd64", "s390x"}),
+				Description:  "The operating system architecture of the indexed images",
+			},
+			"include_deprecated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether an operating system without available images is indexed with its newest deprecated image",
+			},
+			"images": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The unique identifier of the newest public image of each operating system, by operating system name",
+			},
+			"deprecated_operating_systems": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the operating systems indexed with a deprecated image",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISImageIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	architecture := d.Get("architecture").(string)
+	includeDeprecated := d.Get("include_deprecated").(bool)
+
+	start := ""
+	index := map[string]vpcv1.Image{}
+	listImagesOptions := &vpcv1.ListImagesOptions{}
+	listImagesOptions.SetVisibility("public")
+	for {
+		if start != "" {
+			listImagesOptions.Start = &start
+		}
+		images, response, err := sess.ListImagesWithContext(context, listImagesOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response))
+		}
+		for _, image := range images.Images {
+			addToImageIndex(index, image, architecture, includeDeprecated)
+		}
+		start = flex.GetNext(images.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	imageIDs := make(map[string]string, len(index))
+	deprecated := []string{}
+	for name, image := range index {
+		imageIDs[name] = *image.ID
+		if *image.Status == "deprecated" {
+			deprecated = append(deprecated, name)
+		}
+	}
+	sort.Strings(deprecated)
+
+	d.SetId(dataSourceIBMISImageIndexID(architecture, includeDeprecated))
+	if err = d.Set("images", imageIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting images: %s", err))
+	}
+	if err = d.Set("deprecated_operating_systems", deprecated); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting deprecated_operating_systems: %s", err))
+	}
+	return nil
+}
+
+// addToImageIndex keeps image in the index when it is the best image of its operating system so far. An
+// available image always wins over a deprecated one, and the newest image wins otherwise. Obsolete and
+// unusable images are never indexed, so the index can be built in a single pass over the pages.
+func addToImageIndex(index map[string]vpcv1.Image, image vpcv1.Image, architecture string, includeDeprecated bool) {
+	if image.ID == nil || image.Status == nil || image.OperatingSystem == nil || image.OperatingSystem.Name == nil {
+		return
+	}
+	if architecture != "" && (image.OperatingSystem.Architecture == nil || *image.OperatingSystem.Architecture != architecture) {
+		return
+	}
+	if *image.Status != "available" && (*image.Status != "deprecated" || !includeDeprecated) {
+		return
+	}
+	name := *image.OperatingSystem.Name
+	current, ok := index[name]
+	if !ok {
+		index[name] = image
+		return
+	}
+	if *current.Status != *image.Status {
+		if *image.Status == "available" {
+			index[name] = image
+		}
+		return
+	}
+	if image.CreatedAt != nil && (current.CreatedAt == nil || time.Time(*image.CreatedAt).After(time.Time(*current.CreatedAt))) {
+		index[name] = image
+	}
+}
+
+// dataSourceIBMISImageIndexID returns a stable ID for the arguments of the index.
+func dataSourceIBMISImageIndexID(architecture string, includeDeprecated bool) string {
+	if architecture == "" {
+		architecture = "all"
+	}
+	return fmt.Sprintf("public/%s/%t", architecture, includeDeprecated)
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISImageIndexDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_image_index.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageIndexDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttrSet(resName, "images.%"),
+					resource.TestCheckResourceAttr(resName, "deprecated_operating_systems.#", "0"),
+					resource.TestCheckResourceAttrSet(resName, "images.ubuntu-22-04-amd64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImageIndexDataSourceConfig() string {
+	return `
+	data "ibm_is_image_index" "test1" {
+		architecture = "amd64"
+	}
+	`
+}
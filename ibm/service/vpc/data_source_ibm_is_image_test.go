@@ -34,6 +34,35 @@ func TestAccIBMISImageDataSource_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImageDataSource_mostRecent(t *testing.T) {
+	resName := "data.ibm_is_image.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageDataSourceMostRecentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "os", "ubuntu-22-04-amd64"),
+					resource.TestCheckResourceAttr(resName, "architecture", "amd64"),
+					resource.TestCheckResourceAttr(resName, "visibility", "public"),
+					resource.TestCheckResourceAttr(resName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImageDataSourceMostRecentConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_image" "test1" {
+		os_name      = "ubuntu-22-04-amd64"
+		architecture = "amd64"
+		most_recent  = true
+	}`)
+}
+
 func TestAccIBMISImageDataSource_All(t *testing.T) {
 	resName := "data.ibm_is_image.test1"
 	imageName := fmt.Sprintf("tfimage-name-%d", acctest.RandIntRange(10, 100))
@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -15,9 +16,13 @@ import (
 )
 
 const (
-	isImages                = "images"
-	isImagesResourceGroupID = "resource_group"
-	isImageCatalogManaged   = "catalog_managed"
+	isImages                  = "images"
+	isImagesResourceGroupID   = "resource_group"
+	isImageCatalogManaged     = "catalog_managed"
+	isImagesIncludeDeprecated = "include_deprecated"
+	isImagesIncludeObsolete   = "include_obsolete"
+	isImagesOperatingSystems  = "operating_systems"
+	isImagesLatest            = "latest"
 )
 
 func DataSourceIBMISImages() *schema.Resource {
@@ -52,6 +57,64 @@ func DataSourceIBMISImages() *schema.Resource {
 				Optional:    true,
 				Description: "Whether the image is publicly visible or private to the account",
 			},
+			isImagesIncludeDeprecated: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether deprecated images are listed",
+			},
+			isImagesIncludeObsolete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether obsolete images are listed",
+			},
+
+			isImagesOperatingSystems: {
+				Type:        schema.TypeList,
+				Description: "The operating systems of the listed images, with the newest available image of each operating system",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The globally unique name for this operating system",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A unique, display-friendly name for the operating system",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The software family for this operating system",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The major release version of this operating system",
+						},
+						"architecture": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The operating system architecture",
+						},
+						"latest_image": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the newest available image with this operating system",
+						},
+						"images": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The unique identifiers of the listed images with this operating system, newest first",
+						},
+					},
+				},
+			},
 
 			isImages: {
 				Type:        schema.TypeList,
@@ -74,6 +137,16 @@ func DataSourceIBMISImages() *schema.Resource {
 							Computed:    true,
 							Description: "The status of this image",
 						},
+						isImageCreatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the image was created",
+						},
+						isImagesLatest: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this image is the newest available image with its operating system",
+						},
 						"status_reasons": &schema.Schema{
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -367,6 +440,24 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecsTemp
 	}
 
+	includeDeprecated := d.Get(isImagesIncludeDeprecated).(bool)
+	includeObsolete := d.Get(isImagesIncludeObsolete).(bool)
+	if !includeDeprecated || !includeObsolete {
+		allrecsTemp := []vpcv1.Image{}
+		for _, image := range allrecs {
+			if !includeDeprecated && *image.Status == "deprecated" {
+				continue
+			}
+			if !includeObsolete && *image.Status == "obsolete" {
+				continue
+			}
+			allrecsTemp = append(allrecsTemp, image)
+		}
+		allrecs = allrecsTemp
+	}
+
+	operatingSystems, latestImages := imagesByOperatingSystem(allrecs)
+
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
 
@@ -379,6 +470,10 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 			"os":           *image.OperatingSystem.Name,
 			"architecture": *image.OperatingSystem.Architecture,
 		}
+		if image.CreatedAt != nil {
+			l[isImageCreatedAt] = image.CreatedAt.String()
+		}
+		l[isImagesLatest] = latestImages[*image.ID]
 		if len(image.StatusReasons) > 0 {
 			l["status_reasons"] = dataSourceIBMIsImageFlattenStatusReasons(image.StatusReasons)
 		}
@@ -422,9 +517,69 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(dataSourceIBMISImagesID(d))
 	d.Set(isImages, imagesInfo)
+	d.Set(isImagesOperatingSystems, operatingSystems)
 	return nil
 }
 
+// imagesByOperatingSystem groups the images by operating system name, newest first, and
+// returns the groups sorted by name with the IDs of the newest available image of each group.
+func imagesByOperatingSystem(images []vpcv1.Image) ([]map[string]interface{}, map[string]bool) {
+	sorted := make([]vpcv1.Image, 0, len(images))
+	for _, image := range images {
+		if image.OperatingSystem != nil && image.OperatingSystem.Name != nil {
+			sorted = append(sorted, image)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].CreatedAt == nil || sorted[j].CreatedAt == nil {
+			return sorted[j].CreatedAt == nil && sorted[i].CreatedAt != nil
+		}
+		return time.Time(*sorted[i].CreatedAt).After(time.Time(*sorted[j].CreatedAt))
+	})
+
+	groups := map[string]map[string]interface{}{}
+	latest := map[string]bool{}
+	for _, image := range sorted {
+		imageOS := image.OperatingSystem
+		group, ok := groups[*imageOS.Name]
+		if !ok {
+			group = map[string]interface{}{
+				"name":   *imageOS.Name,
+				"images": []string{},
+			}
+			if imageOS.DisplayName != nil {
+				group["display_name"] = *imageOS.DisplayName
+			}
+			if imageOS.Family != nil {
+				group["family"] = *imageOS.Family
+			}
+			if imageOS.Version != nil {
+				group["version"] = *imageOS.Version
+			}
+			if imageOS.Architecture != nil {
+				group["architecture"] = *imageOS.Architecture
+			}
+			groups[*imageOS.Name] = group
+		}
+		group["images"] = append(group["images"].([]string), *image.ID)
+		if _, ok := group["latest_image"]; !ok && *image.Status == "available" {
+			group["latest_image"] = *image.ID
+			latest[*image.ID] = true
+		}
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	operatingSystems := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		operatingSystems = append(operatingSystems, groups[name])
+	}
+	return operatingSystems, latest
+}
+
 // dataSourceIBMISImagesId returns a reasonable ID for a image list.
 func dataSourceIBMISImagesID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
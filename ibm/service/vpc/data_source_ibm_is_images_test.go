@@ -114,6 +114,36 @@ func TestAccIBMISImageDataSource_With_FilterStatus(t *testing.T) {
 	})
 }
 
+func TestAccIBMISImagesDataSource_operatingSystems(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceOperatingSystemsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "images.0.created_at"),
+					resource.TestCheckResourceAttrSet(resName, "images.0.latest"),
+					resource.TestCheckResourceAttrSet(resName, "operating_systems.0.name"),
+					resource.TestCheckResourceAttrSet(resName, "operating_systems.0.latest_image"),
+					resource.TestCheckResourceAttrSet(resName, "operating_systems.0.images.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImagesDataSourceOperatingSystemsConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_images" "test1" {
+		visibility         = "public"
+		include_deprecated = false
+		include_obsolete   = false
+	}`)
+}
+
 func testAccCheckIBMISImagesDataSourceConfig() string {
 	// status filter defaults to empty
 	return fmt.Sprintf(`
@@ -57,6 +57,18 @@ func DataSourceIBMISInstance() *schema.Resource {
 				Description: "The availability policy to use for this virtual server instance. The action to perform if the compute host experiences a failure.",
 			},
 
+			isInstanceConfidentialComputeMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The confidential compute mode for this virtual server instance.",
+			},
+
+			isInstanceEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled for this virtual server instance.",
+			},
+
 			isInstanceName: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -94,6 +106,30 @@ func DataSourceIBMISInstance() *schema.Resource {
 					},
 				},
 			},
+			isInstanceDefaultTrustedProfile: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The default IAM trusted profile to use for this virtual server instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceDefaultTrustedProfileAutoLink1: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set to `true`, the system created a link to the specified `target` trusted profile during instance creation.",
+						},
+						isInstanceDefaultTrustedProfileTargetId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the default IAM trusted profile.",
+						},
+						isInstanceDefaultTrustedProfileTargetCrn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the default IAM trusted profile.",
+						},
+					},
+				},
+			},
 			isInstancePEM: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -1113,20 +1149,13 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 	if err != nil {
 		return err
 	}
-	listInstancesOptions := &vpcv1.ListInstancesOptions{
-		Name: &name,
-	}
-
-	instances, response, err := sess.ListInstances(listInstancesOptions)
+	instance, rawInstance, response, err := getInstanceByNameWithCapabilities(sess, name)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
 	}
-	allrecs := instances.Instances
-
-	if len(allrecs) == 0 {
+	if instance == nil {
 		return fmt.Errorf("[ERROR] No Instance found with name %s", name)
 	}
-	instance := allrecs[0]
 	d.SetId(*instance.ID)
 	id := *instance.ID
 
@@ -1165,6 +1194,9 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 	if instance.AvailabilityPolicy != nil && instance.AvailabilityPolicy.HostFailure != nil {
 		d.Set(isInstanceAvailablePolicyHostFailure, *instance.AvailabilityPolicy.HostFailure)
 	}
+	if err = setInstanceCapabilities(d, rawInstance, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot); err != nil {
+		return err
+	}
 	cpuList := make([]map[string]interface{}, 0)
 	if instance.Vcpu != nil {
 		currentCPU := map[string]interface{}{}
@@ -1403,6 +1435,17 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting instance Initialization: %s\n%s", err, response)
 	}
+	if initParms.DefaultTrustedProfile != nil {
+		defaultTrustedProfileMap := map[string]interface{}{}
+		if initParms.DefaultTrustedProfile.AutoLink != nil {
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileAutoLink1] = *initParms.DefaultTrustedProfile.AutoLink
+		}
+		if initParms.DefaultTrustedProfile.Target != nil {
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTargetId] = initParms.DefaultTrustedProfile.Target.ID
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTargetCrn] = initParms.DefaultTrustedProfile.Target.CRN
+		}
+		d.Set(isInstanceDefaultTrustedProfile, []map[string]interface{}{defaultTrustedProfileMap})
+	}
 	if initParms.Keys != nil {
 		initKeyList := make([]map[string]interface{}, 0)
 		for _, key := range initParms.Keys {
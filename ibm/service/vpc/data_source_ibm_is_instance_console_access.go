@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMISInstanceConsoleAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISInstanceConsoleAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The instance identifier.",
+			},
+			"console_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"serial", "vnc"}),
+				Description:  "The instance console type for which this token may be used.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to disconnect an existing serial console session as the serial console cannot be shared.",
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A URL safe single-use token used to access the console WebSocket.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL to access this instance console.",
+			},
+			"console_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL to access this instance console, with the access token.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the access token was created.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the access token will expire.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISInstanceConsoleAccessRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance").(string)
+	createInstanceConsoleAccessTokenOptions := &vpcv1.CreateInstanceConsoleAccessTokenOptions{}
+	createInstanceConsoleAccessTokenOptions.SetInstanceID(instanceID)
+	createInstanceConsoleAccessTokenOptions.SetConsoleType(d.Get("console_type").(string))
+	createInstanceConsoleAccessTokenOptions.SetForce(d.Get("force").(bool))
+
+	consoleAccessToken, response, err := vpcClient.CreateInstanceConsoleAccessTokenWithContext(context, createInstanceConsoleAccessTokenOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateInstanceConsoleAccessTokenWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the console access token of instance (%s): %s\n%s", instanceID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *consoleAccessToken.ConsoleType))
+	if err = d.Set("access_token", consoleAccessToken.AccessToken); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting access_token: %s", err))
+	}
+	if err = d.Set("href", consoleAccessToken.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if consoleAccessToken.Href != nil && consoleAccessToken.AccessToken != nil {
+		consoleURL, err := url.Parse(*consoleAccessToken.Href)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the console URL %s: %s", *consoleAccessToken.Href, err))
+		}
+		query := consoleURL.Query()
+		query.Set("access_token", *consoleAccessToken.AccessToken)
+		consoleURL.RawQuery = query.Encode()
+		if err = d.Set("console_url", consoleURL.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting console_url: %s", err))
+		}
+	}
+	if consoleAccessToken.CreatedAt != nil {
+		if err = d.Set("created_at", consoleAccessToken.CreatedAt.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+		}
+	}
+	if consoleAccessToken.ExpiresAt != nil {
+		if err = d.Set("expires_at", consoleAccessToken.ExpiresAt.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting expires_at: %s", err))
+		}
+	}
+
+	return nil
+}
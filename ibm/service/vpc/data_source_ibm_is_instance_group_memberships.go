@@ -27,6 +27,16 @@ func DataSourceIBMISInstanceGroupMemberships() *schema.Resource {
 				Required:    true,
 				Description: "The instance group identifier.",
 			},
+			isInstanceGroupMemershipInstanceTemplate: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only lists the memberships created from the instance template with this identifier, for example the members of a previous template during a blue/green rollout.",
+			},
+			isInstanceGroupMembershipStatus: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only lists the memberships with this status.",
+			},
 
 			isInstanceGroupMemberships: {
 				Type:        schema.TypeList,
@@ -143,8 +153,16 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 
 	}
 
+	templateFilter := d.Get(isInstanceGroupMemershipInstanceTemplate).(string)
+	statusFilter := d.Get(isInstanceGroupMembershipStatus).(string)
 	memberships := make([]map[string]interface{}, 0)
 	for _, instanceGroupMembership := range allrecs {
+		if templateFilter != "" && (instanceGroupMembership.InstanceTemplate == nil || *instanceGroupMembership.InstanceTemplate.ID != templateFilter) {
+			continue
+		}
+		if statusFilter != "" && *instanceGroupMembership.Status != statusFilter {
+			continue
+		}
 		membership := map[string]interface{}{
 			isInstanceGroupMemershipDeleteInstanceOnMembershipDelete: *instanceGroupMembership.DeleteInstanceOnMembershipDelete,
 			isInstanceGroupMembership:                                *instanceGroupMembership.ID,
@@ -5,14 +5,21 @@ package vpc
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	isInstanceProfiles = "profiles"
+	isInstanceProfiles                        = "profiles"
+	isInstanceProfilesArchitecture            = "architecture"
+	isInstanceProfilesGpuModel                = "gpu_model"
+	isInstanceProfilesConfidentialComputeMode = "confidential_compute_mode"
+	isInstanceProfilesSecureBoot              = "secure_boot"
 )
 
 func DataSourceIBMISInstanceProfiles() *schema.Resource {
@@ -20,6 +27,27 @@ func DataSourceIBMISInstanceProfiles() *schema.Resource {
 		Read: dataSourceIBMISInstanceProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isInstanceProfilesArchitecture: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the profiles supporting this OS architecture, for example `amd64` or `s390x`.",
+			},
+			isInstanceProfilesGpuModel: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the profiles with this GPU model, for example `Tesla V100`.",
+			},
+			isInstanceProfilesConfidentialComputeMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "sgx", "tdx"}, false),
+				Description:  "Lists only the profiles supporting this confidential compute mode, `disabled`, `sgx` or `tdx`.",
+			},
+			isInstanceProfilesSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Lists only the profiles supporting secure boot when `true`, or only the profiles supporting booting without it when `false`.",
+			},
 
 			isInstanceProfiles: {
 				Type:        schema.TypeList,
@@ -217,6 +245,56 @@ func DataSourceIBMISInstanceProfiles() *schema.Resource {
 								},
 							},
 						},
+						"confidential_compute_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The confidential compute modes of an instance with this profile.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The default confidential compute mode for an instance with this profile.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type for this profile field.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The supported confidential compute modes.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"secure_boot_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The secure boot modes of an instance with this profile.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "The default secure boot mode for an instance with this profile.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type for this profile field.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The supported secure boot modes.",
+										Elem:        &schema.Schema{Type: schema.TypeBool},
+									},
+								},
+							},
+						},
 						"gpu_model": {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -690,8 +768,32 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
 	}
+	// the confidential compute and secure boot modes are not modelled by the vpc-go-sdk yet
+	rawProfiles, err := listIBMISRawCollection(sess, "/instance/profiles", isInstanceProfiles)
+	if err != nil {
+		return err
+	}
+	architecture := d.Get(isInstanceProfilesArchitecture).(string)
+	gpuModel := d.Get(isInstanceProfilesGpuModel).(string)
+	confidentialComputeMode := d.Get(isInstanceProfilesConfidentialComputeMode).(string)
+	secureBoot, secureBootOk := d.GetOkExists(isInstanceProfilesSecureBoot)
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
+		rawProfile := rawProfiles[*profile.Name]
+		if architecture != "" && (profile.OsArchitecture == nil || !stringInList(architecture, profile.OsArchitecture.Values)) {
+			continue
+		}
+		if gpuModel != "" && (profile.GpuModel == nil || !stringInList(gpuModel, profile.GpuModel.Values)) {
+			continue
+		}
+		confidentialComputeModes := flattenInstanceProfileRawEnum(rawProfile["confidential_compute_modes"])
+		if confidentialComputeMode != "" && !instanceProfileRawEnumHas(confidentialComputeModes, confidentialComputeMode) {
+			continue
+		}
+		secureBootModes := flattenInstanceProfileRawEnum(rawProfile["secure_boot_modes"])
+		if secureBootOk && !instanceProfileRawEnumHas(secureBootModes, secureBoot) {
+			continue
+		}
 
 		l := map[string]interface{}{
 			"name":   *profile.Name,
@@ -733,6 +835,8 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 		if profile.GpuModel != nil {
 			l["gpu_model"] = dataSourceInstanceProfileFlattenGPUModel(*profile.GpuModel)
 		}
+		l["confidential_compute_modes"] = confidentialComputeModes
+		l["secure_boot_modes"] = secureBootModes
 
 		if profile.ReservationTerms != nil {
 			l["reservation_terms"] = dataSourceInstanceProfileFlattenReservationTerms(*profile.ReservationTerms)
@@ -820,3 +924,95 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 func dataSourceIBMISInstanceProfilesID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
+
+// flattenInstanceProfileRawEnum flattens an enum profile field, such as secure_boot_modes, of a raw
+// instance profile.
+func flattenInstanceProfileRawEnum(field interface{}) []map[string]interface{} {
+	fieldMap, ok := field.(map[string]interface{})
+	if !ok {
+		return []map[string]interface{}{}
+	}
+	enum := map[string]interface{}{
+		"values": []interface{}{},
+	}
+	for _, key := range []string{"default", "type", "values"} {
+		if value, ok := fieldMap[key]; ok && value != nil {
+			enum[key] = value
+		}
+	}
+	return []map[string]interface{}{enum}
+}
+
+func instanceProfileRawEnumHas(enum []map[string]interface{}, value interface{}) bool {
+	if len(enum) == 0 {
+		return false
+	}
+	for _, v := range enum[0]["values"].([]interface{}) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func stringInList(value string, list []string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// listIBMISRawCollection lists a VPC collection with plain requests, for the properties the vpc-go-sdk
+// does not model yet, and returns its members by name.
+func listIBMISRawCollection(sess *vpcv1.VpcV1, path, collection string) (map[string]map[string]interface{}, error) {
+	members := map[string]map[string]interface{}{}
+	start := ""
+	for {
+		builder := core.NewRequestBuilder(core.GET)
+		_, err := builder.ResolveRequestURL(sess.Service.GetServiceURL(), path, nil)
+		if err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Accept", "application/json")
+		if sess.Version != nil {
+			builder.AddQuery("version", *sess.Version)
+		}
+		builder.AddQuery("generation", "2")
+		if start != "" {
+			builder.AddQuery("start", start)
+		}
+		request, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		var result map[string]interface{}
+		response, err := sess.Service.Request(request, &result)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing %s: %s\n%s", path, err, response)
+		}
+		items, _ := result[collection].([]interface{})
+		for _, item := range items {
+			if member, ok := item.(map[string]interface{}); ok {
+				if name, ok := member["name"].(string); ok {
+					members[name] = member
+				}
+			}
+		}
+
+		start = ""
+		if next, ok := result["next"].(map[string]interface{}); ok {
+			if href, ok := next["href"].(string); ok {
+				if u, err := url.Parse(href); err == nil {
+					start = u.Query().Get("start")
+				}
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+	return members, nil
+}
@@ -54,3 +54,30 @@ func testAccCheckIBMISInstanceProfilesDataSourceConfig() string {
       data "ibm_is_instance_profiles" "test1" {
       }`)
 }
+
+func TestAccIBMISInstanceProfilesDataSource_filter(t *testing.T) {
+	resName := "data.ibm_is_instance_profiles.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceProfilesDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "profiles.0.name"),
+					resource.TestCheckResourceAttr(resName, "profiles.0.architecture_values.0", "amd64"),
+					resource.TestCheckResourceAttrSet(resName, "profiles.0.secure_boot_modes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceProfilesDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+      data "ibm_is_instance_profiles" "test1" {
+        architecture = "amd64"
+        secure_boot  = true
+      }`)
+}
@@ -90,6 +90,29 @@ func DataSourceIBMISInstances() *schema.Resource {
 				Description:   "ID of the placement group to filter the instances attached to it",
 			},
 
+			"tags_filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "User tags to filter the instances, only the instances having all of these tags are returned",
+			},
+
+			"access_tags_filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Access management tags to filter the instances, only the instances having all of these tags are returned",
+			},
+
+			"strict_single": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the data source returns an error unless exactly one instance matches the filters",
+			},
+
 			isInstances: {
 				Type:        schema.TypeList,
 				Description: "List of instances",
@@ -1220,8 +1243,40 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecs[:i]
 	}
 
+	var tagsFilter, accessTagsFilter *schema.Set
+	if tagsFilterIntf, ok := d.GetOk("tags_filter"); ok {
+		tagsFilter = tagsFilterIntf.(*schema.Set)
+	}
+	if accessTagsFilterIntf, ok := d.GetOk("access_tags_filter"); ok {
+		accessTagsFilter = accessTagsFilterIntf.(*schema.Set)
+	}
+
 	instancesInfo := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
+		tags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceUserTagType)
+		if err != nil {
+			if tagsFilter != nil {
+				return fmt.Errorf("[ERROR] Error on get of resource vpc Instance (%s) tags: %s", *instance.ID, err)
+			}
+			log.Printf(
+				"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
+		}
+		if tagsFilter != nil && !flex.HasAllTags(tags, tagsFilter) {
+			continue
+		}
+
+		accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
+		if err != nil {
+			if accessTagsFilter != nil {
+				return fmt.Errorf("[ERROR] Error on get of resource vpc Instance (%s) access tags: %s", *instance.ID, err)
+			}
+			log.Printf(
+				"Error on get of resource vpc Instance (%s) access tags: %s", d.Id(), err)
+		}
+		if accessTagsFilter != nil && !flex.HasAllTags(accesstags, accessTagsFilter) {
+			continue
+		}
+
 		id := *instance.ID
 		l := map[string]interface{}{}
 		l["id"] = id
@@ -1295,18 +1350,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			bootVolList = append(bootVolList, bootVol)
 			l["boot_volume"] = bootVolList
 		}
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceUserTagType)
-		if err != nil {
-			log.Printf(
-				"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
-		}
 		l[isInstanceTags] = tags
-
-		accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on get of resource vpc Instance (%s) access tags: %s", d.Id(), err)
-		}
 		l[isInstanceAccessTags] = accesstags
 		//set the status reasons
 		statusReasonsList := make([]map[string]interface{}, 0)
@@ -1553,6 +1597,9 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 
 		instancesInfo = append(instancesInfo, l)
 	}
+	if d.Get("strict_single").(bool) && len(instancesInfo) != 1 {
+		return fmt.Errorf("[ERROR] strict_single is set, expected exactly one instance to match the filters but found %d", len(instancesInfo))
+	}
 	d.SetId(dataSourceIBMISInstancesID(d))
 	d.Set(isInstances, instancesInfo)
 	return nil
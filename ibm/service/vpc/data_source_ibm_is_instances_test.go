@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -191,6 +192,43 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISInstancesDataSource_tagsFilterStrictSingle(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("tfins-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfins-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tfins-ssh-%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tfins-name-%d", acctest.RandIntRange(10, 100))
+	resName := "data.ibm_is_instances.ds_instances1"
+	userData := "a"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, instanceName, userData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancesDataSourceConfigStrictSingle(vpcname, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instances.0.name", instanceName),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISInstancesDataSourceConfigStrictSingle(vpcname, "tf-missing-tag"),
+				ExpectError: regexp.MustCompile("expected exactly one instance to match the filters but found 0"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstancesDataSource_InsGroupfilter(t *testing.T) {
 
 	randInt := acctest.RandIntRange(10, 100)
@@ -257,3 +295,16 @@ func testAccCheckIBMISInstancesDataSourceConfigInstanceGroup(insGrpName string)
 		instance_group_name = "%s"
 	}`, insGrpName)
 }
+
+func testAccCheckIBMISInstancesDataSourceConfigStrictSingle(vpcname, tag string) string {
+	tagsFilter := ""
+	if tag != "" {
+		tagsFilter = fmt.Sprintf(`tags_filter = ["%s"]`, tag)
+	}
+	return fmt.Sprintf(`
+	data "ibm_is_instances" "ds_instances1" {
+		vpc_name      = "%s"
+		strict_single = true
+		%s
+	}`, vpcname, tagsFilter)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"sync"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -38,6 +39,9 @@ const (
 	isLBPrivateIpName         = "name"
 	isLBPrivateIpId           = "reserved_ip"
 	isLBPrivateIpResourceType = "resource_type"
+	isLBListenerDetails       = "listener_details"
+	isLBHealthSummary         = "health_summary"
+	isLBHealth                = "health"
 )
 
 func DataSourceIBMISLB() *schema.Resource {
@@ -221,6 +225,36 @@ func DataSourceIBMISLB() *schema.Resource {
 										Computed:    true,
 										Description: "The unique identifier for this load balancer pool member.",
 									},
+									isLBPoolMemberHealth: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Health of the server member in the pool.",
+									},
+									isLBPoolMemberPort: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The port number of the application running in the server member.",
+									},
+									isLBPoolMemberWeight: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Weight of the server member. Applicable only if the pool algorithm is weighted_round_robin.",
+									},
+									isLBPoolMemberTargetAddress: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the member target.",
+									},
+									isLBPoolMemberTargetID: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the member target.",
+									},
+									poolProvisioningStatus: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The provisioning status of this member.",
+									},
 								},
 							},
 						},
@@ -259,6 +293,104 @@ func DataSourceIBMISLB() *schema.Resource {
 							Computed:    true,
 							Description: "The provisioning status of this pool.",
 						},
+						isLBHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the pool rolled up from the health of its members: ok, degraded, faulted or unknown.",
+						},
+					},
+				},
+			},
+			isLBListenerDetails: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listeners of this load balancer.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer listener.",
+						},
+						href: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The listener's canonical URL.",
+						},
+						isLBListenerPort: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The listener port number, or the inclusive lower bound of the port range.",
+						},
+						isLBListenerPortMin: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive lower bound of the range of ports used by this listener.",
+						},
+						isLBListenerPortMax: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive upper bound of the range of ports used by this listener.",
+						},
+						isLBListenerProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The listener protocol.",
+						},
+						isLBListenerDefaultPool: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the default pool associated with the listener.",
+						},
+						isLBListenerConnectionLimit: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The connection limit of the listener.",
+						},
+						isLBListenerAcceptProxyProtocol: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the listener accepts the PROXY protocol.",
+						},
+						poolProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this listener.",
+						},
+					},
+				},
+			},
+			isLBHealthSummary: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of the load balancer rolled up from the health of the members of all its pools.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rolled up health: ok when all the members are healthy, degraded when some are, faulted when none are and unknown when the health of the members is not known.",
+						},
+						"total_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members in all the pools.",
+						},
+						"ok_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of healthy members.",
+						},
+						"faulted_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of faulted members.",
+						},
+						"unknown_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members whose health is unknown.",
+						},
 					},
 				},
 			},
@@ -458,10 +590,24 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{}
 			listLoadBalancerPoolsOptions.SetLoadBalancerID(*lb.ID)
 			poolsResult, _, _ := sess.ListLoadBalancerPools(listLoadBalancerPoolsOptions)
+			pools := []vpcv1.LoadBalancerPool{}
+			if poolsResult != nil {
+				pools = poolsResult.Pools
+			}
+			tree, err := lbGetListenerPoolMemberTree(sess, *lb.ID, pools)
+			if err != nil {
+				return err
+			}
+			listenerDetails := make([]map[string]interface{}, 0, len(tree.listeners))
+			for _, listener := range tree.listeners {
+				listenerDetails = append(listenerDetails, dataSourceIBMISLBListenerToMap(listener))
+			}
+			d.Set(isLBListenerDetails, listenerDetails)
+			totalSummary := lbHealthSummary{}
 			if poolsResult != nil {
 				poolsInfo := make([]map[string]interface{}, 0)
 
-				for _, p := range poolsResult.Pools {
+				for i, p := range poolsResult.Pools {
 					//	log.Printf("******* p ******** : (%+v)", p)
 					pool := make(map[string]interface{})
 					pool[poolAlgorithm] = *p.Algorithm
@@ -501,6 +647,18 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 						}
 						pool[members] = memberList
 					}
+					// the pool members listed with the health of each member replace the member references
+					poolSummary := lbHealthSummary{}
+					if tree.members[i] != nil {
+						memberList := make([]map[string]interface{}, 0, len(tree.members[i]))
+						for _, m := range tree.members[i] {
+							memberList = append(memberList, dataSourceIBMISLBPoolMemberToMap(m))
+							poolSummary.add(m.Health)
+							totalSummary.add(m.Health)
+						}
+						pool[members] = memberList
+					}
+					pool[isLBHealth] = poolSummary.health()
 
 					if p.InstanceGroup != nil {
 						instanceGroupInfo := make(map[string]interface{})
@@ -514,6 +672,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 				} //for
 				d.Set(isLBPools, poolsInfo)
 			}
+			d.Set(isLBHealthSummary, []map[string]interface{}{totalSummary.toMap()})
 
 			d.Set(isLBResourceGroup, *lb.ResourceGroup.ID)
 			d.Set(isLBHostName, *lb.Hostname)
@@ -543,3 +702,165 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	return fmt.Errorf("[ERROR] No Load balancer found with name %s", name)
 }
+
+// lbListenerPoolMemberTree holds the listeners of a load balancer and the members of each of its pools,
+// indexed like the pools.
+type lbListenerPoolMemberTree struct {
+	listeners []vpcv1.LoadBalancerListener
+	members   [][]vpcv1.LoadBalancerPoolMember
+}
+
+// lbGetListenerPoolMemberTree fetches the listeners and the members of every pool of the load balancer
+// in parallel, so that the whole tree costs a single round trip of latency.
+func lbGetListenerPoolMemberTree(sess *vpcv1.VpcV1, lbID string, pools []vpcv1.LoadBalancerPool) (*lbListenerPoolMemberTree, error) {
+	tree := &lbListenerPoolMemberTree{
+		members: make([][]vpcv1.LoadBalancerPoolMember, len(pools)),
+	}
+	errs := make([]error, len(pools)+1)
+
+	var wg sync.WaitGroup
+	wg.Add(len(pools) + 1)
+	go func() {
+		defer wg.Done()
+		listLoadBalancerListenersOptions := &vpcv1.ListLoadBalancerListenersOptions{}
+		listLoadBalancerListenersOptions.SetLoadBalancerID(lbID)
+		listeners, response, err := sess.ListLoadBalancerListeners(listLoadBalancerListenersOptions)
+		if err != nil {
+			errs[0] = fmt.Errorf("[ERROR] Error Getting Load Balancer Listeners %s\n%s", err, response)
+			return
+		}
+		tree.listeners = listeners.Listeners
+	}()
+	for i, pool := range pools {
+		go func(i int, poolID string) {
+			defer wg.Done()
+			listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
+			listLoadBalancerPoolMembersOptions.SetLoadBalancerID(lbID)
+			listLoadBalancerPoolMembersOptions.SetPoolID(poolID)
+			poolMembers, response, err := sess.ListLoadBalancerPoolMembers(listLoadBalancerPoolMembersOptions)
+			if err != nil {
+				errs[i+1] = fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Members of pool %s %s\n%s", poolID, err, response)
+				return
+			}
+			tree.members[i] = poolMembers.Members
+		}(i, *pool.ID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+func dataSourceIBMISLBListenerToMap(listener vpcv1.LoadBalancerListener) map[string]interface{} {
+	listenerMap := map[string]interface{}{}
+	if listener.ID != nil {
+		listenerMap[ID] = *listener.ID
+	}
+	if listener.Href != nil {
+		listenerMap[href] = *listener.Href
+	}
+	if listener.Port != nil {
+		listenerMap[isLBListenerPort] = *listener.Port
+	}
+	if listener.PortMin != nil {
+		listenerMap[isLBListenerPortMin] = *listener.PortMin
+	}
+	if listener.PortMax != nil {
+		listenerMap[isLBListenerPortMax] = *listener.PortMax
+	}
+	if listener.Protocol != nil {
+		listenerMap[isLBListenerProtocol] = *listener.Protocol
+	}
+	if listener.DefaultPool != nil && listener.DefaultPool.ID != nil {
+		listenerMap[isLBListenerDefaultPool] = *listener.DefaultPool.ID
+	}
+	if listener.ConnectionLimit != nil {
+		listenerMap[isLBListenerConnectionLimit] = *listener.ConnectionLimit
+	}
+	if listener.AcceptProxyProtocol != nil {
+		listenerMap[isLBListenerAcceptProxyProtocol] = *listener.AcceptProxyProtocol
+	}
+	if listener.ProvisioningStatus != nil {
+		listenerMap[poolProvisioningStatus] = *listener.ProvisioningStatus
+	}
+	return listenerMap
+}
+
+func dataSourceIBMISLBPoolMemberToMap(member vpcv1.LoadBalancerPoolMember) map[string]interface{} {
+	memberMap := map[string]interface{}{}
+	if member.ID != nil {
+		memberMap[ID] = *member.ID
+	}
+	if member.Href != nil {
+		memberMap[href] = *member.Href
+	}
+	if member.Health != nil {
+		memberMap[isLBPoolMemberHealth] = *member.Health
+	}
+	if member.Port != nil {
+		memberMap[isLBPoolMemberPort] = *member.Port
+	}
+	if member.Weight != nil {
+		memberMap[isLBPoolMemberWeight] = *member.Weight
+	}
+	if member.ProvisioningStatus != nil {
+		memberMap[isLBPoolMemberProvisioningStatus] = *member.ProvisioningStatus
+	}
+	if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok && target != nil {
+		if target.Address != nil {
+			memberMap[isLBPoolMemberTargetAddress] = *target.Address
+		}
+		if target.ID != nil {
+			memberMap[isLBPoolMemberTargetID] = *target.ID
+		}
+	}
+	return memberMap
+}
+
+// lbHealthSummary counts the members of a load balancer by health.
+type lbHealthSummary struct {
+	ok, faulted, unknown int
+}
+
+func (s *lbHealthSummary) add(health *string) {
+	switch {
+	case health != nil && *health == "ok":
+		s.ok++
+	case health != nil && *health == "faulted":
+		s.faulted++
+	default:
+		s.unknown++
+	}
+}
+
+func (s lbHealthSummary) total() int {
+	return s.ok + s.faulted + s.unknown
+}
+
+func (s lbHealthSummary) health() string {
+	switch {
+	case s.total() == 0:
+		return "unknown"
+	case s.ok == s.total():
+		return "ok"
+	case s.ok > 0:
+		return "degraded"
+	case s.faulted > 0:
+		return "faulted"
+	}
+	return "unknown"
+}
+
+func (s lbHealthSummary) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		isLBHealth:             s.health(),
+		"total_member_count":   s.total(),
+		"ok_member_count":      s.ok,
+		"faulted_member_count": s.faulted,
+		"unknown_member_count": s.unknown,
+	}
+}
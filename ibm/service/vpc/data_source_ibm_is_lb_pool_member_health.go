@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMISLBPoolMemberHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsLbPoolMemberHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"lb": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The load balancer identifier.",
+			},
+			"pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The pool identifier.",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of the pool: ok when all members are healthy, faulted when no member is healthy, otherwise degraded. unknown when the pool has no member.",
+			},
+			"member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members in the pool.",
+			},
+			"ok_member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members whose health is ok.",
+			},
+			"faulted_member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members whose health is faulted.",
+			},
+			"unknown_member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members whose health is unknown.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of each member of the pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer pool member.",
+						},
+						"health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the server member in the pool.",
+						},
+						"provisioning_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this member.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The port number of the application running in the server member.",
+						},
+						"target_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the target virtual server instance or load balancer.",
+						},
+						"target_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the target virtual server instance or load balancer.",
+						},
+						"target_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the target.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsLbPoolMemberHealthRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lbID := d.Get("lb").(string)
+	poolID := d.Get("pool").(string)
+	listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
+	listLoadBalancerPoolMembersOptions.SetLoadBalancerID(lbID)
+	listLoadBalancerPoolMembersOptions.SetPoolID(poolID)
+
+	loadBalancerPoolMemberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response))
+	}
+
+	members := []map[string]interface{}{}
+	okCount, faultedCount, unknownCount := 0, 0, 0
+	for _, member := range loadBalancerPoolMemberCollection.Members {
+		memberMap := map[string]interface{}{}
+		if member.ID != nil {
+			memberMap["id"] = *member.ID
+		}
+		if member.Health != nil {
+			memberMap["health"] = *member.Health
+			switch *member.Health {
+			case "ok":
+				okCount++
+			case "faulted":
+				faultedCount++
+			default:
+				unknownCount++
+			}
+		} else {
+			unknownCount++
+		}
+		if member.ProvisioningStatus != nil {
+			memberMap["provisioning_status"] = *member.ProvisioningStatus
+		}
+		if member.Port != nil {
+			memberMap["port"] = *member.Port
+		}
+		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok && target != nil {
+			if target.ID != nil {
+				memberMap["target_id"] = *target.ID
+			}
+			if target.Name != nil {
+				memberMap["target_name"] = *target.Name
+			}
+			if target.Address != nil {
+				memberMap["target_address"] = *target.Address
+			}
+		}
+		members = append(members, memberMap)
+	}
+
+	health := "unknown"
+	switch {
+	case len(members) == 0:
+	case okCount == len(members):
+		health = "ok"
+	case okCount == 0:
+		health = "faulted"
+	default:
+		health = "degraded"
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", lbID, poolID))
+	if err = d.Set("members", members); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting members %s", err))
+	}
+	d.Set("health", health)
+	d.Set("member_count", len(members))
+	d.Set("ok_member_count", okCount)
+	d.Set("faulted_member_count", faultedCount)
+	d.Set("unknown_member_count", unknownCount)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsLbPoolMemberHealthDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpmh-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpmh-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolh%d", acctest.RandIntRange(10, 100))
+	port := "8080"
+	address := "127.0.0.1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsLbPoolMemberHealthDataSourceConfigBasic(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, port, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "health"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "member_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "members.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "members.0.id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "members.0.health"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "members.0.port", port),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_pool_member_health.is_lb_pool_member_health", "members.0.target_address", address),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsLbPoolMemberHealthDataSourceConfigBasic(vpcname, subnetname, zone, cidr, name, poolName, port, address string) string {
+	return testAccCheckIBMISLBPoolMemberConfig(vpcname, subnetname, zone, cidr, name, poolName, port, address) + `
+	data "ibm_is_lb_pool_member_health" "is_lb_pool_member_health" {
+		lb   = ibm_is_lb.testacc_LB.id
+		pool = element(split("/", ibm_is_lb_pool.testacc_lb_pool.id), 1)
+		depends_on = [ibm_is_lb_pool_member.testacc_lb_mem]
+	}
+	`
+}
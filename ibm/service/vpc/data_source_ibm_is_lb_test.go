@@ -29,6 +29,12 @@ func TestAccIBMISLBDatasource_basic(t *testing.T) {
 						"data.ibm_is_lb.ds_lb", "name", name),
 					resource.TestCheckResourceAttr(
 						"data.ibm_is_lb.ds_lb", "route_mode", routeMode),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_lb.ds_lb", "health_summary.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_lb.ds_lb", "health_summary.0.health"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_lb.ds_lb", "listener_details.#"),
 				),
 			},
 		},
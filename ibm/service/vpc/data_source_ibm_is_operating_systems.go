@@ -10,10 +10,13 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	isOperatingSystems = "operating_systems"
+	isOperatingSystems                      = "operating_systems"
+	isOperatingSystemUserDataFormat         = "user_data_format"
+	isOperatingSystemAllowUserImageCreation = "allow_user_image_creation"
 )
 
 func DataSourceIBMISOperatingSystems() *schema.Resource {
@@ -21,6 +24,22 @@ func DataSourceIBMISOperatingSystems() *schema.Resource {
 		Read: dataSourceIBMISOperatingSystemsRead,
 
 		Schema: map[string]*schema.Schema{
+			isOperatingSystemArchitecture: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the operating systems with this architecture, for example `amd64` or `s390x`.",
+			},
+			isOperatingSystemFamily: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the operating systems of this software family, for example `Ubuntu Linux`.",
+			},
+			isOperatingSystemUserDataFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cloud_init", "esxi_kickstart", "ipxe"}, false),
+				Description:  "Lists only the operating systems using this user data format, `cloud_init`, `esxi_kickstart` or `ipxe`.",
+			},
 			isOperatingSystems: {
 				Type:        schema.TypeList,
 				Description: "List of operating systems",
@@ -70,6 +89,16 @@ func DataSourceIBMISOperatingSystems() *schema.Resource {
 							Computed:    true,
 							Description: "The vendor of the operating system",
 						},
+						isOperatingSystemUserDataFormat: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user data format of the operating system",
+						},
+						isOperatingSystemAllowUserImageCreation: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether users can create images with this operating system",
+						},
 					},
 				},
 			},
@@ -108,8 +137,27 @@ func osList(d *schema.ResourceData, meta interface{}) error {
 			break
 		}
 	}
+	// the user data format is not modelled by the vpc-go-sdk yet
+	rawOperatingSystems, err := listIBMISRawCollection(sess, "/operating_systems", isOperatingSystems)
+	if err != nil {
+		return err
+	}
+	architecture := d.Get(isOperatingSystemArchitecture).(string)
+	family := d.Get(isOperatingSystemFamily).(string)
+	userDataFormat := d.Get(isOperatingSystemUserDataFormat).(string)
 	osInfo := make([]map[string]interface{}, 0)
 	for _, os := range allrecs {
+		rawOperatingSystem := rawOperatingSystems[*os.Name]
+		osUserDataFormat, _ := rawOperatingSystem[isOperatingSystemUserDataFormat].(string)
+		if architecture != "" && *os.Architecture != architecture {
+			continue
+		}
+		if family != "" && *os.Family != family {
+			continue
+		}
+		if userDataFormat != "" && osUserDataFormat != userDataFormat {
+			continue
+		}
 		l := map[string]interface{}{
 			isOperatingSystemName:         *os.Name,
 			isOperatingSystemArchitecture: *os.Architecture,
@@ -120,6 +168,12 @@ func osList(d *schema.ResourceData, meta interface{}) error {
 			isOperatingSystemVendor:       *os.Vendor,
 			isOperatingSystemVersion:      *os.Version,
 		}
+		if osUserDataFormat != "" {
+			l[isOperatingSystemUserDataFormat] = osUserDataFormat
+		}
+		if allowUserImageCreation, ok := rawOperatingSystem[isOperatingSystemAllowUserImageCreation].(bool); ok {
+			l[isOperatingSystemAllowUserImageCreation] = allowUserImageCreation
+		}
 		osInfo = append(osInfo, l)
 	}
 	d.SetId(dataSourceIBMISOperatingSystemsId(d))
@@ -33,3 +33,29 @@ func testAccCheckIBMISOperatingSystemsDataSourceConfig() string {
 		data "ibm_is_operating_systems" "testacc_ds_oslist" {
 			}`)
 }
+
+func TestAccIBMISOperatingSystemsDataSource_filter(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISOperatingSystemsDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_operating_systems.testacc_ds_oslist", "operating_systems.0.name"),
+					resource.TestCheckResourceAttr("data.ibm_is_operating_systems.testacc_ds_oslist", "operating_systems.0.architecture", "amd64"),
+					resource.TestCheckResourceAttr("data.ibm_is_operating_systems.testacc_ds_oslist", "operating_systems.0.user_data_format", "cloud_init"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISOperatingSystemsDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_is_operating_systems" "testacc_ds_oslist" {
+			architecture     = "amd64"
+			user_data_format = "cloud_init"
+		}`)
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type privatePathServiceGatewayEndpointGatewayBindingCollection struct {
+	EndpointGatewayBindings []PrivatePathServiceGatewayEndpointGatewayBinding `json:"endpoint_gateway_bindings"`
+	Next                    *struct {
+		Href *string `json:"href"`
+	} `json:"next"`
+}
+
+func DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_private_path_service_gateway_endpoint_gateway_bindings", "status"),
+				Description:  "Filters the collection to endpoint gateway bindings with the specified status.",
+			},
+			"account": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to endpoint gateway bindings with the specified account identifier.",
+			},
+			"endpoint_gateway_bindings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of endpoint gateway bindings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this endpoint gateway binding.",
+						},
+						"account": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account which created this endpoint gateway binding.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the endpoint gateway binding was created.",
+						},
+						"expiration_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiration date and time for a pending endpoint gateway binding.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this endpoint gateway binding.",
+						},
+						"lifecycle_state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the endpoint gateway binding.",
+						},
+						"resource_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the endpoint gateway binding.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the endpoint gateway binding was updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "status",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "abandoned, denied, expired, pending, permitted",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway_endpoint_gateway_bindings", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsgID := d.Get("private_path_service_gateway").(string)
+	status := d.Get("status").(string)
+	account := d.Get("account").(string)
+
+	start := ""
+	allrecs := []PrivatePathServiceGatewayEndpointGatewayBinding{}
+	for {
+		query := map[string]string{}
+		if start != "" {
+			query["start"] = start
+		}
+		if status != "" {
+			query["status"] = status
+		}
+		if account != "" {
+			query["account.id"] = account
+		}
+
+		collection := &privatePathServiceGatewayEndpointGatewayBindingCollection{}
+		response, err := vpcRawRequestWithQuery(context, vpcClient, core.GET, "/private_path_service_gateways/{id}/endpoint_gateway_bindings", map[string]string{"id": ppsgID}, query, nil, collection)
+		if err != nil {
+			log.Printf("[DEBUG] ListPrivatePathServiceGatewayEndpointGatewayBindingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListPrivatePathServiceGatewayEndpointGatewayBindingsWithContext failed %s\n%s", err, response))
+		}
+		allrecs = append(allrecs, collection.EndpointGatewayBindings...)
+
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	bindings := make([]map[string]interface{}, 0, len(allrecs))
+	for _, binding := range allrecs {
+		bindingMap := map[string]interface{}{
+			"id":              binding.ID,
+			"created_at":      binding.CreatedAt,
+			"expiration_at":   binding.Expiration,
+			"href":            binding.Href,
+			"lifecycle_state": binding.LifecycleState,
+			"resource_type":   binding.ResourceType,
+			"status":          binding.Status,
+			"updated_at":      binding.UpdatedAt,
+		}
+		if binding.Account != nil {
+			bindingMap["account"] = binding.Account.ID
+		}
+		bindings = append(bindings, bindingMap)
+	}
+
+	d.SetId(dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsID(d))
+	if err = d.Set("endpoint_gateway_bindings", bindings); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoint_gateway_bindings %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsID returns a reasonable ID for the endpoint gateway bindings list.
+func dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
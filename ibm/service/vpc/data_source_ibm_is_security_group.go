@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -102,6 +103,12 @@ func DataSourceIBMISSecurityGroup() *schema.Resource {
 							},
 						},
 
+						isSecurityGroupRuleDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the security group rule",
+						},
+
 						isSgRuleType: {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -198,13 +205,14 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 	// Support for pagination
 	start := ""
 	allrecs := []vpcv1.SecurityGroup{}
+	descriptions := map[string]string{}
 
 	for {
 		listSgOptions := &vpcv1.ListSecurityGroupsOptions{}
 		if start != "" {
 			listSgOptions.Start = &start
 		}
-		sgs, response, err := sess.ListSecurityGroups(listSgOptions)
+		sgs, pageDescriptions, response, err := isSecurityGroupsList(context.Background(), sess, listSgOptions)
 		if err != nil || sgs == nil {
 			return fmt.Errorf("[ERROR] Error Getting Security Groups %s\n%s", err, response)
 		}
@@ -213,6 +221,9 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 		}
 		start = flex.GetNext(sgs.Next)
 		allrecs = append(allrecs, sgs.SecurityGroups...)
+		for ruleID, description := range pageDescriptions {
+			descriptions[ruleID] = description
+		}
 
 		if start == "" {
 			break
@@ -252,6 +263,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 							r[isSgRuleType] = int(*rule.Type)
 						}
 						r[isSgRuleDirection] = *rule.Direction
+						r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 						r[isSgRuleIPVersion] = *rule.IPVersion
 						if rule.Protocol != nil {
 							r[isSgRuleProtocol] = *rule.Protocol
@@ -286,6 +298,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 						rule := sgrule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
 						r := make(map[string]interface{})
 						r[isSgRuleDirection] = *rule.Direction
+						r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 						r[isSgRuleIPVersion] = *rule.IPVersion
 						if rule.Protocol != nil {
 							r[isSgRuleProtocol] = *rule.Protocol
@@ -326,6 +339,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 							r[isSgRulePortMax] = int(*rule.PortMax)
 						}
 						r[isSgRuleDirection] = *rule.Direction
+						r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 						r[isSgRuleIPVersion] = *rule.IPVersion
 						if rule.Protocol != nil {
 							r[isSgRuleProtocol] = *rule.Protocol
@@ -123,6 +123,11 @@ func DataSourceIBMIsSecurityGroupRule() *schema.Resource {
 					},
 				},
 			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the security group rule.",
+			},
 			"code": &schema.Schema{
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -158,12 +163,16 @@ func dataSourceIBMIsSecurityGroupRuleRead(context context.Context, d *schema.Res
 	getSecurityGroupRuleOptions.SetSecurityGroupID(d.Get("security_group").(string))
 	getSecurityGroupRuleOptions.SetID(d.Get("security_group_rule").(string))
 
-	securityGroupRuleIntf, response, err := vpcClient.GetSecurityGroupRuleWithContext(context, getSecurityGroupRuleOptions)
+	securityGroupRuleIntf, description, response, err := isSecurityGroupRuleGet(context, vpcClient, *getSecurityGroupRuleOptions.SecurityGroupID, *getSecurityGroupRuleOptions.ID)
 	if err != nil || securityGroupRuleIntf == nil {
 		log.Printf("[DEBUG] GetSecurityGroupRuleWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("GetSecurityGroupRuleWithContext failed %s\n%s", err, response))
 	}
 
+	if err = d.Set("description", description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+
 	switch reflect.TypeOf(securityGroupRuleIntf).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
 		{
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -125,6 +126,11 @@ func DataSourceIBMIsSecurityGroupRules() *schema.Resource {
 								},
 							},
 						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the security group rule.",
+						},
 						"code": &schema.Schema{
 							Type:        schema.TypeInt,
 							Computed:    true,
@@ -159,11 +165,7 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	listSecurityGroupRuleOptions := vpcv1.ListSecurityGroupRulesOptions{
-		SecurityGroupID: &secGrpId,
-	}
-
-	ruleList, response, err := sess.ListSecurityGroupRules(&listSecurityGroupRuleOptions)
+	ruleList, descriptions, response, err := isSecurityGroupRulesList(context.Background(), sess, secGrpId)
 	if err != nil {
 		return fmt.Errorf("Error fetching security group rules %s\n%s", err, response)
 	}
@@ -178,6 +180,7 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 				l["direction"] = *rulex.Direction
 				l["href"] = *rulex.Href
 				l["id"] = *rulex.ID
+				l["description"] = descriptions[*rulex.ID]
 				l["ip_version"] = *rulex.IPVersion
 				l["protocol"] = *rulex.Protocol
 				// nested map for remote.
@@ -202,6 +205,7 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 				l["direction"] = *rulex.Direction
 				l["href"] = *rulex.Href
 				l["id"] = *rulex.ID
+				l["description"] = descriptions[*rulex.ID]
 				l["ip_version"] = *rulex.IPVersion
 				if rulex.Code != nil {
 					l["code"] = *rulex.Code
@@ -231,6 +235,7 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 				l["direction"] = *rulex.Direction
 				l["href"] = *rulex.Href
 				l["id"] = *rulex.ID
+				l["description"] = descriptions[*rulex.ID]
 				l["ip_version"] = *rulex.IPVersion
 				l["protocol"] = *rulex.Protocol
 				l["port_max"] = *rulex.PortMax
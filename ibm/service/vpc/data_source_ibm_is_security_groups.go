@@ -128,6 +128,11 @@ func DataSourceIBMIsSecurityGroups() *schema.Resource {
 										Computed:    true,
 										Description: "The protocol to enforce.",
 									},
+									"description": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The description of the security group rule.",
+									},
 									"local": &schema.Schema{
 										Type:        schema.TypeList,
 										Computed:    true,
@@ -342,6 +347,7 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 
 	start := ""
 	allrecs := []vpcv1.SecurityGroup{}
+	descriptions := map[string]string{}
 	listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{}
 	if resourceGrp != "" {
 		listSecurityGroupsOptions.ResourceGroupID = &resourceGrp
@@ -360,7 +366,7 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		if start != "" {
 			listSecurityGroupsOptions.Start = &start
 		}
-		securityGroupCollection, response, err := vpcClient.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
+		securityGroupCollection, pageDescriptions, response, err := isSecurityGroupsList(context, vpcClient, listSecurityGroupsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("ListSecurityGroupsWithContext failed %s\n%s", err, response))
@@ -368,6 +374,9 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 
 		start = flex.GetNext(securityGroupCollection.Next)
 		allrecs = append(allrecs, securityGroupCollection.SecurityGroups...)
+		for ruleID, description := range pageDescriptions {
+			descriptions[ruleID] = description
+		}
 
 		if start == "" {
 			break
@@ -375,7 +384,7 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 	}
 
 	d.SetId(dataSourceIBMIsSecurityGroupsID(d))
-	err = d.Set("security_groups", dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs, d, meta))
+	err = d.Set("security_groups", dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs, descriptions, d, meta))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting security_groups %s", err))
 	}
@@ -387,16 +396,16 @@ func dataSourceIBMIsSecurityGroupsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
 
-func dataSourceSecurityGroupCollectionFlattenSecurityGroups(modelSlice []vpcv1.SecurityGroup, d *schema.ResourceData, meta interface{}) (result []map[string]interface{}) {
+func dataSourceSecurityGroupCollectionFlattenSecurityGroups(modelSlice []vpcv1.SecurityGroup, descriptions map[string]string, d *schema.ResourceData, meta interface{}) (result []map[string]interface{}) {
 	result = []map[string]interface{}{}
 	for _, securityGroupsItem := range modelSlice {
-		mapItem := dataSourceSecurityGroupCollectionSecurityGroupsToMap(&securityGroupsItem, d, meta)
+		mapItem := dataSourceSecurityGroupCollectionSecurityGroupsToMap(&securityGroupsItem, descriptions, d, meta)
 		result = append(result, mapItem)
 	}
 	return result
 }
 
-func dataSourceSecurityGroupCollectionSecurityGroupsToMap(securityGroupsItem *vpcv1.SecurityGroup, d *schema.ResourceData, meta interface{}) (resultMap map[string]interface{}) {
+func dataSourceSecurityGroupCollectionSecurityGroupsToMap(securityGroupsItem *vpcv1.SecurityGroup, descriptions map[string]string, d *schema.ResourceData, meta interface{}) (resultMap map[string]interface{}) {
 	resultMap = map[string]interface{}{}
 
 	if securityGroupsItem.CreatedAt != nil {
@@ -424,6 +433,9 @@ func dataSourceSecurityGroupCollectionSecurityGroupsToMap(securityGroupsItem *vp
 		var mapSlice []map[string]interface{}
 		for _, listElem := range securityGroupsItem.Rules {
 			mapElem := dataSourceSecurityGroupCollectionSecurityGroupsRulesToMap(listElem)
+			if ruleID, ok := mapElem["id"].(*string); ok && ruleID != nil {
+				mapElem["description"] = descriptions[*ruleID]
+			}
 			mapSlice = append(mapSlice, mapElem)
 		}
 		resultMap["rules"] = mapSlice
@@ -312,6 +312,12 @@ func DataSourceSnapshot() *schema.Resource {
 				Set:         schema.HashString,
 				Description: "Zones for creating the snapshot clone",
 			},
+			isSnapshotCloneAvailability: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Whether the clone of the snapshot in each zone is available for restoring volumes, by zone name",
+			},
 			isSnapshotCapturedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -520,6 +526,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 					}
 				}
 				d.Set(isSnapshotClones, flex.NewStringSet(schema.HashString, clones))
+				d.Set(isSnapshotCloneAvailability, snapshotCloneAvailability(snapshot.Clones))
 				if err = d.Set("service_tags", snapshot.ServiceTags); err != nil {
 					return fmt.Errorf("[ERROR] Error setting service_tags: %s", err)
 				}
@@ -635,6 +642,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 			}
 		}
 		d.Set(isSnapshotClones, flex.NewStringSet(schema.HashString, clones))
+		d.Set(isSnapshotCloneAvailability, snapshotCloneAvailability(snapshot.Clones))
 
 		backupPolicyPlanList := []map[string]interface{}{}
 		if snapshot.BackupPolicyPlan != nil {
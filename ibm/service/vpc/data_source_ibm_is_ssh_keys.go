@@ -34,6 +34,18 @@ func DataSourceIBMIsSshKeys() *schema.Resource {
 		ReadContext: dataSourceIBMIsSshKeysRead,
 
 		Schema: map[string]*schema.Schema{
+			isKeyFingerprint: &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{isKeyPublicKey},
+				Description:   "Filters the collection to the keys with this fingerprint, for example `SHA256:yxavE4CIOL2NlsqcurRO3xGjkP6m/0mp8ugojH5yxlY`.",
+			},
+			isKeyPublicKey: &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{isKeyFingerprint},
+				Description:   "Filters the collection to the keys with the same fingerprint as this public key.",
+			},
 			isKeys: &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -160,6 +172,23 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 
 	}
 
+	fingerprint := d.Get(isKeyFingerprint).(string)
+	if publicKey, ok := d.GetOk(isKeyPublicKey); ok {
+		fingerprint, err = sshKeyFingerprint(publicKey.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing public_key: %s", err))
+		}
+	}
+	if fingerprint != "" {
+		matchingKeys := []vpcv1.Key{}
+		for _, key := range allrecs {
+			if key.Fingerprint != nil && *key.Fingerprint == fingerprint {
+				matchingKeys = append(matchingKeys, key)
+			}
+		}
+		allrecs = matchingKeys
+	}
+
 	d.SetId(dataSourceIBMIsSshKeysID(d))
 	err = d.Set(isKeys, dataSourceKeyCollectionFlattenKeys(allrecs, d, meta))
 	if err != nil {
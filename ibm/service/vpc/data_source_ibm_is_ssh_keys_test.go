@@ -82,3 +82,35 @@ func testAccCheckIBMIsSshKeysDataSourceConfigTags(publicKey, name1 string) strin
 		}
 	`, name1, publicKey)
 }
+
+func TestAccIBMIsSshKeysDataSourcePublicKeyFilter(t *testing.T) {
+	name := fmt.Sprintf("tfssh-name-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHEE9sLlndKFR/hVbF7SUNhKBFrxscJDHrVN/OD1Z+8V abc.edf@ibm.com`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsSshKeysDataSourceConfigPublicKeyFilter(publicKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_ssh_key.key", "type", "ed25519"),
+					resource.TestCheckResourceAttr("data.ibm_is_ssh_keys.is_ssh_keys", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_ssh_keys.is_ssh_keys", "keys.0.name", name),
+					resource.TestCheckResourceAttrPair("data.ibm_is_ssh_keys.is_ssh_keys", "keys.0.fingerprint", "ibm_is_ssh_key.key", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsSshKeysDataSourceConfigPublicKeyFilter(publicKey, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ssh_key" "key" {
+			name 		= "%s"
+			public_key 	= "%s"
+		}
+		data "ibm_is_ssh_keys" "is_ssh_keys" {
+			public_key = ibm_is_ssh_key.key.public_key
+		}
+	`, name, publicKey)
+}
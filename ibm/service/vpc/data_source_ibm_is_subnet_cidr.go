@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsSubnetCidr() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsSubnetCidrRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone in which the subnet is planned.",
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_subnet_cidr", "prefix_length"),
+				Description:  "The prefix length of the CIDR block of the planned subnet.",
+			},
+			"address_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The identifier of the address prefix in which the CIDR block is searched. By default all the address prefixes of the zone are searched in order.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first free CIDR block of the requested prefix length.",
+			},
+			"address_prefix_cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR block of the address prefix that contains the free CIDR block.",
+			},
+			"total_ipv4_address_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of IPv4 addresses in the free CIDR block.",
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsSubnetCidrValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "prefix_length",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "8",
+			MaxValue:                   "29"})
+	ibmISSubnetCidrDataSourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet_cidr", Schema: validateSchema}
+	return &ibmISSubnetCidrDataSourceValidator
+}
+
+func dataSourceIBMIsSubnetCidrRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcID := d.Get("vpc").(string)
+	zone := d.Get("zone").(string)
+	prefixLength := d.Get("prefix_length").(int)
+	addressPrefixID := d.Get("address_prefix").(string)
+
+	start := ""
+	addressPrefixes := []vpcv1.AddressPrefix{}
+	for {
+		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+		listVpcAddressPrefixesOptions.SetVPCID(vpcID)
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, response, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListVpcAddressPrefixesWithContext failed %s\n%s", err, response))
+		}
+		for _, addressPrefix := range addressPrefixCollection.AddressPrefixes {
+			if addressPrefix.Zone == nil || *addressPrefix.Zone.Name != zone {
+				continue
+			}
+			if addressPrefixID != "" && *addressPrefix.ID != addressPrefixID {
+				continue
+			}
+			addressPrefixes = append(addressPrefixes, addressPrefix)
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	if len(addressPrefixes) == 0 {
+		if addressPrefixID != "" {
+			return diag.FromErr(fmt.Errorf("[ERROR] No address prefix %s found in zone %s of VPC %s", addressPrefixID, zone, vpcID))
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] No address prefixes found in zone %s of VPC %s", zone, vpcID))
+	}
+
+	start = ""
+	usedBlocks := []*net.IPNet{}
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		listSubnetsOptions.SetVPCID(vpcID)
+		listSubnetsOptions.SetZoneName(zone)
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnetCollection, response, err := vpcClient.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListSubnetsWithContext failed %s\n%s", err, response))
+		}
+		for _, subnet := range subnetCollection.Subnets {
+			if subnet.Ipv4CIDRBlock == nil {
+				continue
+			}
+			_, block, err := net.ParseCIDR(*subnet.Ipv4CIDRBlock)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the CIDR block %s of subnet %s: %s", *subnet.Ipv4CIDRBlock, *subnet.ID, err))
+			}
+			usedBlocks = append(usedBlocks, block)
+		}
+		start = flex.GetNext(subnetCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	for _, addressPrefix := range addressPrefixes {
+		_, prefixBlock, err := net.ParseCIDR(*addressPrefix.CIDR)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the CIDR block %s of address prefix %s: %s", *addressPrefix.CIDR, *addressPrefix.ID, err))
+		}
+		cidr, ok := subnetCidrNextFree(prefixBlock, prefixLength, usedBlocks)
+		if !ok {
+			continue
+		}
+		d.SetId(fmt.Sprintf("%s/%s/%d", vpcID, zone, prefixLength))
+		if err = d.Set("cidr", cidr.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting cidr: %s", err))
+		}
+		if err = d.Set("address_prefix", *addressPrefix.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting address_prefix: %s", err))
+		}
+		if err = d.Set("address_prefix_cidr", *addressPrefix.CIDR); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting address_prefix_cidr: %s", err))
+		}
+		if err = d.Set("total_ipv4_address_count", 1<<uint(32-prefixLength)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_ipv4_address_count: %s", err))
+		}
+		return nil
+	}
+
+	return diag.FromErr(fmt.Errorf("[ERROR] No free /%d CIDR block left in the address prefixes of zone %s of VPC %s", prefixLength, zone, vpcID))
+}
+
+// subnetCidrNextFree returns the first block of the given prefix length inside prefix that does not
+// overlap any of the used blocks.
+func subnetCidrNextFree(prefix *net.IPNet, prefixLength int, used []*net.IPNet) (*net.IPNet, bool) {
+	prefixOnes, bits := prefix.Mask.Size()
+	if bits != 32 || prefixLength < prefixOnes {
+		return nil, false
+	}
+	prefixStart := uint64(binary.BigEndian.Uint32(prefix.IP.To4()))
+	prefixEnd := prefixStart + 1<<uint(32-prefixOnes)
+	size := uint64(1) << uint(32-prefixLength)
+
+	for candidate := prefixStart; candidate+size <= prefixEnd; {
+		overlapEnd := uint64(0)
+		for _, block := range used {
+			blockIP := block.IP.To4()
+			if blockIP == nil {
+				continue
+			}
+			blockOnes, _ := block.Mask.Size()
+			blockStart := uint64(binary.BigEndian.Uint32(blockIP))
+			blockEnd := blockStart + 1<<uint(32-blockOnes)
+			if blockStart < candidate+size && candidate < blockEnd && blockEnd > overlapEnd {
+				overlapEnd = blockEnd
+			}
+		}
+		if overlapEnd == 0 {
+			ip := make(net.IP, 4)
+			binary.BigEndian.PutUint32(ip, uint32(candidate))
+			return &net.IPNet{IP: ip, Mask: net.CIDRMask(prefixLength, 32)}, true
+		}
+		// skip past the overlapping subnet, keeping the candidate aligned on its size
+		candidate = (overlapEnd + size - 1) / size * size
+	}
+	return nil, false
+}
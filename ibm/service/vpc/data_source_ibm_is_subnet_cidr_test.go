@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsSubnetCidrDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tfvpcuat-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tfaddprename-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsSubnetCidrDataSourceConfigBasic(name, prefixName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_subnet_cidr.is_subnet_cidr", "cidr"),
+					resource.TestCheckResourceAttr("data.ibm_is_subnet_cidr.is_subnet_cidr", "address_prefix_cidr", acc.ISAddressPrefixCIDR),
+					resource.TestCheckResourceAttr("data.ibm_is_subnet_cidr.is_subnet_cidr", "total_ipv4_address_count", "256"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_subnet_cidr.is_subnet_cidr", "address_prefix", "ibm_is_vpc_address_prefix.testacc_vpc_address_prefix", "address_prefix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsSubnetCidrDataSourceInvalidPrefixLength(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "ibm_is_subnet_cidr" "is_subnet_cidr" {
+					vpc           = "r006-00000000-0000-0000-0000-000000000000"
+					zone          = "us-south-1"
+					prefix_length = 30
+				}`,
+				ExpectError: regexp.MustCompile("must contain a valid int value should be in range"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsSubnetCidrDataSourceConfigBasic(name, prefixName string) string {
+	return testAccCheckIBMISVPCAddressPrefixConfig(name, prefixName) + fmt.Sprintf(`
+		data "ibm_is_subnet_cidr" "is_subnet_cidr" {
+			vpc           = ibm_is_vpc.testacc_vpc.id
+			zone          = ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.zone
+			prefix_length = 24
+		}
+	`)
+}
@@ -37,6 +37,11 @@ func DataSourceIBMIsVirtualNetworkInterface() *schema.Resource {
 				Computed:    true,
 				Description: "If `true`:- The VPC infrastructure performs any needed NAT operations.- `floating_ips` must not have more than one floating IP.If `false`:- Packets are passed unchanged to/from the virtual network interface,  allowing the workload to perform any needed NAT operations.- `allow_ip_spoofing` must be `false`.- If the virtual network interface is attached:  - The target `resource_type` must be `bare_metal_server_network_attachment`.  - The target `interface_type` must not be `hipersocket`.",
 			},
+			"protocol_state_filtering_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol state filtering mode used for this virtual network interface. If `auto`, protocol state packet filtering is enabled or disabled based on the virtual network interface's `target` resource type:- `bare_metal_server_network_attachment`: disabled- `instance_network_attachment`: enabled- `share_mount_target`: enabled.",
+			},
 			"tags": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -576,6 +581,14 @@ func dataSourceIBMIsVirtualNetworkInterfaceRead(context context.Context, d *sche
 	if err = d.Set("enable_infrastructure_nat", virtualNetworkInterface.EnableInfrastructureNat); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting enable_infrastructure_nat: %s", err))
 	}
+	protocolStateFilteringMode, response, err := getVirtualNetworkInterfaceProtocolStateFilteringMode(context, vpcClient, *virtualNetworkInterface.ID)
+	if err != nil {
+		log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed reading protocol_state_filtering_mode %s\n%s", err, response))
+	}
+	if err = d.Set("protocol_state_filtering_mode", protocolStateFilteringMode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting protocol_state_filtering_mode: %s", err))
+	}
 
 	ips := []map[string]interface{}{}
 	if virtualNetworkInterface.Ips != nil {
@@ -54,6 +54,11 @@ func DataSourceIBMIsVirtualNetworkInterfaces() *schema.Resource {
 							Computed:    true,
 							Description: "If `true`:- The VPC infrastructure performs any needed NAT operations.- `floating_ips` must not have more than one floating IP.If `false`:- Packets are passed unchanged to/from the virtual network interface,  allowing the workload to perform any needed NAT operations.- `allow_ip_spoofing` must be `false`.- If the virtual network interface is attached:  - The target `resource_type` must be `bare_metal_server_network_attachment`.  - The target `interface_type` must not be `hipersocket`.",
 						},
+						"protocol_state_filtering_mode": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol state filtering mode used for this virtual network interface. If `auto`, protocol state packet filtering is enabled or disabled based on the virtual network interface's `target` resource type:- `bare_metal_server_network_attachment`: disabled- `instance_network_attachment`: enabled- `share_mount_target`: enabled.",
+						},
 						"ips": &schema.Schema{
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -457,6 +462,11 @@ func dataSourceIBMIsVirtualNetworkInterfacesRead(context context.Context, d *sch
 		return diag.FromErr(fmt.Errorf("VirtualNetworkInterfacesPager.GetAll() failed %s", err))
 	}
 
+	protocolStateFilteringModes, err := listVirtualNetworkInterfaceProtocolStateFilteringModes(context, vpcClient, d.Get("resource_group").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIBMIsVirtualNetworkInterfacesID(d))
 
 	mapSlice := []map[string]interface{}{}
@@ -480,6 +490,7 @@ func dataSourceIBMIsVirtualNetworkInterfacesRead(context context.Context, d *sch
 
 		modelMap["tags"] = tags
 		modelMap["access_tags"] = accesstags
+		modelMap["protocol_state_filtering_mode"] = protocolStateFilteringModes[*modelItem.ID]
 		mapSlice = append(mapSlice, modelMap)
 	}
 
@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isVPCDefaultSecurityRulesVpc                = "vpc"
+	isVPCDefaultSecurityRulesSecurityGroupRules = "security_group_rules"
+	isVPCDefaultSecurityRulesNetworkACLRules    = "network_acl_rules"
+	isVPCDefaultSecurityRulesRuleID             = "id"
+	isVPCDefaultSecurityRulesRuleName           = "name"
+	isVPCDefaultSecurityRulesRuleAction         = "action"
+	isVPCDefaultSecurityRulesRuleSource         = "source"
+	isVPCDefaultSecurityRulesRuleDestination    = "destination"
+	isVPCDefaultSecurityRulesRuleSourcePortMin  = "source_port_min"
+	isVPCDefaultSecurityRulesRuleSourcePortMax  = "source_port_max"
+	isVPCDefaultSecurityRulesRuleDestPortMin    = "destination_port_min"
+	isVPCDefaultSecurityRulesRuleDestPortMax    = "destination_port_max"
+)
+
+func DataSourceIBMISVPCDefaultSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPCDefaultSecurityRulesRead,
+		Schema: map[string]*schema.Schema{
+			isVPCDefaultSecurityRulesVpc: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			isVPCDefaultSecurityGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the default security group of the VPC.",
+			},
+			isVPCDefaultSecurityGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the default security group of the VPC.",
+			},
+			isVPCDefaultSecurityRulesSecurityGroupRules: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the default security group of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPCDefaultSecurityRulesRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the security group rule.",
+						},
+						isVPCSecurityGroupRuleDirection: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of traffic to match, either inbound or outbound.",
+						},
+						isVPCSecurityGroupRuleIPVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP version for this rule.",
+						},
+						isVPCSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address, CIDR block or security group identifier from which traffic is allowed.",
+						},
+						isVPCSecurityGroupRuleProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol to enforce, either all, icmp, tcp or udp.",
+						},
+						isVPCSecurityGroupRuleType: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP traffic type to allow, for icmp rules.",
+						},
+						isVPCSecurityGroupRuleCode: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP traffic code to allow, for icmp rules.",
+						},
+						isVPCSecurityGroupRulePortMin: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive lower bound of the port range, for tcp and udp rules.",
+						},
+						isVPCSecurityGroupRulePortMax: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive upper bound of the port range, for tcp and udp rules.",
+						},
+					},
+				},
+			},
+			isVPCDefaultNetworkACL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the default network ACL of the VPC.",
+			},
+			isVPCDefaultNetworkACLName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the default network ACL of the VPC.",
+			},
+			isVPCDefaultSecurityRulesNetworkACLRules: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the default network ACL of the VPC, in the order in which they are evaluated.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPCDefaultSecurityRulesRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the network ACL rule.",
+						},
+						isVPCDefaultSecurityRulesRuleName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network ACL rule.",
+						},
+						isVPCDefaultSecurityRulesRuleAction: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether to allow or deny matching traffic.",
+						},
+						isVPCSecurityGroupRuleDirection: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of traffic to match, either inbound or outbound.",
+						},
+						isVPCSecurityGroupRuleIPVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP version for this rule.",
+						},
+						isVPCDefaultSecurityRulesRuleSource: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source IP address or CIDR block to match.",
+						},
+						isVPCDefaultSecurityRulesRuleDestination: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination IP address or CIDR block to match.",
+						},
+						isVPCSecurityGroupRuleProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol to enforce, either all, icmp, tcp or udp.",
+						},
+						isVPCSecurityGroupRuleType: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP traffic type to allow, for icmp rules.",
+						},
+						isVPCSecurityGroupRuleCode: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ICMP traffic code to allow, for icmp rules.",
+						},
+						isVPCDefaultSecurityRulesRuleSourcePortMin: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive lower bound of the source port range, for tcp and udp rules.",
+						},
+						isVPCDefaultSecurityRulesRuleSourcePortMax: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive upper bound of the source port range, for tcp and udp rules.",
+						},
+						isVPCDefaultSecurityRulesRuleDestPortMin: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive lower bound of the destination port range, for tcp and udp rules.",
+						},
+						isVPCDefaultSecurityRulesRuleDestPortMax: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive upper bound of the destination port range, for tcp and udp rules.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPCDefaultSecurityRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcID := d.Get(isVPCDefaultSecurityRulesVpc).(string)
+
+	getVPCDefaultSecurityGroupOptions := sess.NewGetVPCDefaultSecurityGroupOptions(vpcID)
+	securityGroup, response, err := sess.GetVPCDefaultSecurityGroupWithContext(context, getVPCDefaultSecurityGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPCDefaultSecurityGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the default security group of VPC (%s): %s\n%s", vpcID, err, response))
+	}
+
+	getVPCDefaultNetworkACLOptions := sess.NewGetVPCDefaultNetworkACLOptions(vpcID)
+	networkACL, response, err := sess.GetVPCDefaultNetworkACLWithContext(context, getVPCDefaultNetworkACLOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPCDefaultNetworkACLWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the default network ACL of VPC (%s): %s\n%s", vpcID, err, response))
+	}
+
+	d.SetId(vpcID)
+	d.Set(isVPCDefaultSecurityGroup, securityGroup.ID)
+	d.Set(isVPCDefaultSecurityGroupName, securityGroup.Name)
+	securityGroupRules := make([]map[string]interface{}, 0, len(securityGroup.Rules))
+	for _, rule := range securityGroup.Rules {
+		securityGroupRules = append(securityGroupRules, dataSourceIBMISVPCDefaultSecurityGroupRuleToMap(rule))
+	}
+	if err = d.Set(isVPCDefaultSecurityRulesSecurityGroupRules, securityGroupRules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting security_group_rules: %s", err))
+	}
+
+	d.Set(isVPCDefaultNetworkACL, networkACL.ID)
+	d.Set(isVPCDefaultNetworkACLName, networkACL.Name)
+	networkACLRules := make([]map[string]interface{}, 0, len(networkACL.Rules))
+	for _, rule := range networkACL.Rules {
+		networkACLRules = append(networkACLRules, dataSourceIBMISVPCDefaultNetworkACLRuleToMap(rule))
+	}
+	if err = d.Set(isVPCDefaultSecurityRulesNetworkACLRules, networkACLRules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting network_acl_rules: %s", err))
+	}
+	return nil
+}
+
+func dataSourceIBMISVPCDefaultSecurityGroupRuleToMap(ruleIntf vpcv1.SecurityGroupRuleIntf) map[string]interface{} {
+	r := make(map[string]interface{})
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+		remoteIntf = rule.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+		if rule.Type != nil {
+			r[isVPCSecurityGroupRuleType] = int(*rule.Type)
+		}
+		if rule.Code != nil {
+			r[isVPCSecurityGroupRuleCode] = int(*rule.Code)
+		}
+		remoteIntf = rule.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+		if rule.PortMin != nil {
+			r[isVPCSecurityGroupRulePortMin] = int(*rule.PortMin)
+		}
+		if rule.PortMax != nil {
+			r[isVPCSecurityGroupRulePortMax] = int(*rule.PortMax)
+		}
+		remoteIntf = rule.Remote
+	}
+	if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			r[isVPCSecurityGroupRuleRemote] = remote.ID
+		} else if remote.Address != nil {
+			r[isVPCSecurityGroupRuleRemote] = remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isVPCSecurityGroupRuleRemote] = remote.CIDRBlock
+		}
+	}
+	return r
+}
+
+func dataSourceIBMISVPCDefaultNetworkACLRuleToMap(ruleIntf vpcv1.NetworkACLRuleItemIntf) map[string]interface{} {
+	r := make(map[string]interface{})
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCDefaultSecurityRulesRuleName] = rule.Name
+		r[isVPCDefaultSecurityRulesRuleAction] = rule.Action
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCDefaultSecurityRulesRuleSource] = rule.Source
+		r[isVPCDefaultSecurityRulesRuleDestination] = rule.Destination
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCDefaultSecurityRulesRuleName] = rule.Name
+		r[isVPCDefaultSecurityRulesRuleAction] = rule.Action
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCDefaultSecurityRulesRuleSource] = rule.Source
+		r[isVPCDefaultSecurityRulesRuleDestination] = rule.Destination
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+		if rule.Type != nil {
+			r[isVPCSecurityGroupRuleType] = int(*rule.Type)
+		}
+		if rule.Code != nil {
+			r[isVPCSecurityGroupRuleCode] = int(*rule.Code)
+		}
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		r[isVPCDefaultSecurityRulesRuleID] = rule.ID
+		r[isVPCDefaultSecurityRulesRuleName] = rule.Name
+		r[isVPCDefaultSecurityRulesRuleAction] = rule.Action
+		r[isVPCSecurityGroupRuleDirection] = rule.Direction
+		r[isVPCSecurityGroupRuleIPVersion] = rule.IPVersion
+		r[isVPCDefaultSecurityRulesRuleSource] = rule.Source
+		r[isVPCDefaultSecurityRulesRuleDestination] = rule.Destination
+		r[isVPCSecurityGroupRuleProtocol] = rule.Protocol
+		if rule.SourcePortMin != nil {
+			r[isVPCDefaultSecurityRulesRuleSourcePortMin] = int(*rule.SourcePortMin)
+		}
+		if rule.SourcePortMax != nil {
+			r[isVPCDefaultSecurityRulesRuleSourcePortMax] = int(*rule.SourcePortMax)
+		}
+		if rule.DestinationPortMin != nil {
+			r[isVPCDefaultSecurityRulesRuleDestPortMin] = int(*rule.DestinationPortMin)
+		}
+		if rule.DestinationPortMax != nil {
+			r[isVPCDefaultSecurityRulesRuleDestPortMax] = int(*rule.DestinationPortMax)
+		}
+	}
+	return r
+}
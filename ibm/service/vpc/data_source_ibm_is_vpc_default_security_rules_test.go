@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCDefaultSecurityRulesDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_vpc_default_security_rules.default_rules"
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityRulesDataSourceConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(node, "default_security_group", "ibm_is_vpc.test_vpc", "default_security_group"),
+					resource.TestCheckResourceAttrPair(node, "default_network_acl", "ibm_is_vpc.test_vpc", "default_network_acl"),
+					resource.TestCheckResourceAttrSet(node, "security_group_rules.0.id"),
+					resource.TestCheckResourceAttrSet(node, "security_group_rules.0.direction"),
+					resource.TestCheckResourceAttrSet(node, "network_acl_rules.0.id"),
+					resource.TestCheckResourceAttrSet(node, "network_acl_rules.0.action"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPCDefaultSecurityRulesDataSource_noSgAclRules(t *testing.T) {
+	node := "data.ibm_is_vpc_default_security_rules.default_rules"
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityRulesDataSourceNoSgAclRulesConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "security_group_rules.#", "0"),
+					resource.TestCheckResourceAttr(node, "network_acl_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultSecurityRulesDataSourceConfig(vpcname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test_vpc" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpc_default_security_rules" "default_rules" {
+		vpc = ibm_is_vpc.test_vpc.id
+	}
+	`, vpcname)
+}
+
+func testAccCheckIBMISVPCDefaultSecurityRulesDataSourceNoSgAclRulesConfig(vpcname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test_vpc" {
+		name            = "%s"
+		no_sg_acl_rules = true
+	}
+
+	data "ibm_is_vpc_default_security_rules" "default_rules" {
+		vpc = ibm_is_vpc.test_vpc.id
+	}
+	`, vpcname)
+}
@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMISVPNGatewayTunnels() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNGatewayTunnelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway identifier.",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VPN gateway connection identifier, to only return the tunnels of this connection.",
+			},
+			"connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The route mode connections of the VPN gateway and the health of their tunnels.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this VPN gateway connection.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this VPN gateway connection.",
+						},
+						"admin_state_up": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set to false, the VPN gateway connection is shut down.",
+						},
+						"peer_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the peer VPN gateway.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the VPN gateway connection.",
+						},
+						"status_reasons": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The reasons for the current status (if any).",
+							Elem:        dataSourceVPNGatewayTunnelStatusReasonSchema(),
+						},
+						"up_tunnels": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of tunnels of the connection whose status is up.",
+						},
+						"tunnels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tunnels of the VPN gateway connection, one for each member of the VPN gateway.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"public_ip_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the VPN gateway member in which the tunnel resides.",
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status of the VPN tunnel.",
+									},
+									"status_reasons": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The reasons for the current status of the tunnel (if any).",
+										Elem:        dataSourceVPNGatewayTunnelStatusReasonSchema(),
+									},
+								},
+							},
+						},
+						"ike_proposal": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IKE proposal of the connection. Empty when the proposal is auto-negotiated.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the IKE policy.",
+									},
+									"authentication_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The authentication algorithm.",
+									},
+									"encryption_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The encryption algorithm.",
+									},
+									"dh_group": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The Diffie-Hellman group.",
+									},
+									"ike_version": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The IKE protocol version.",
+									},
+									"key_lifetime": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The key lifetime in seconds.",
+									},
+								},
+							},
+						},
+						"ipsec_proposal": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IPsec proposal of the connection. Empty when the proposal is auto-negotiated.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the IPsec policy.",
+									},
+									"authentication_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The authentication algorithm.",
+									},
+									"encryption_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The encryption algorithm.",
+									},
+									"pfs": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The Perfect Forward Secrecy group.",
+									},
+									"key_lifetime": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The key lifetime in seconds.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPNGatewayTunnelStatusReasonSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A snake case string succinctly identifying the status reason.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An explanation of the status reason.",
+			},
+			"more_info": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Link to documentation about this status reason.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNGatewayTunnelsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpnGatewayID := d.Get("vpn_gateway").(string)
+	connectionID := d.Get("vpn_gateway_connection").(string)
+
+	listOptions := sess.NewListVPNGatewayConnectionsOptions(vpnGatewayID)
+	collection, response, err := sess.ListVPNGatewayConnectionsWithContext(context, listOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the connections of VPN gateway %s: %s\n%s", vpnGatewayID, err, response))
+	}
+
+	// connections commonly share their policies, which are only fetched once
+	ikeProposals := map[string][]map[string]interface{}{}
+	ipsecProposals := map[string][]map[string]interface{}{}
+	connections := make([]map[string]interface{}, 0)
+	for _, connectionIntf := range collection.Connections {
+		var connection *vpcv1.VPNGatewayConnection
+		switch data := connectionIntf.(type) {
+		case *vpcv1.VPNGatewayConnection:
+			connection = data
+		case *vpcv1.VPNGatewayConnectionRouteMode:
+			connection = &vpcv1.VPNGatewayConnection{
+				AdminStateUp:  data.AdminStateUp,
+				ID:            data.ID,
+				IkePolicy:     data.IkePolicy,
+				IpsecPolicy:   data.IpsecPolicy,
+				Mode:          data.Mode,
+				Name:          data.Name,
+				PeerAddress:   data.PeerAddress,
+				Status:        data.Status,
+				StatusReasons: data.StatusReasons,
+				Tunnels:       data.Tunnels,
+			}
+		case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode:
+			connection = &vpcv1.VPNGatewayConnection{
+				AdminStateUp:  data.AdminStateUp,
+				ID:            data.ID,
+				IkePolicy:     data.IkePolicy,
+				IpsecPolicy:   data.IpsecPolicy,
+				Mode:          data.Mode,
+				Name:          data.Name,
+				PeerAddress:   data.PeerAddress,
+				Status:        data.Status,
+				StatusReasons: data.StatusReasons,
+				Tunnels:       data.Tunnels,
+			}
+		case *vpcv1.VPNGatewayConnectionPolicyMode:
+			// policy mode connections have no tunnels of their own
+			continue
+		default:
+			return diag.FromErr(fmt.Errorf("[ERROR] Unrecognized vpcv1.VPNGatewayConnectionIntf subtype encountered"))
+		}
+		if connection.Mode == nil || *connection.Mode != "route" {
+			continue
+		}
+		if connectionID != "" && *connection.ID != connectionID {
+			continue
+		}
+
+		connectionMap := map[string]interface{}{
+			"id":             *connection.ID,
+			"name":           *connection.Name,
+			"status_reasons": resourceVPNGatewayConnectionFlattenLifecycleReasons(connection.StatusReasons),
+		}
+		if connection.AdminStateUp != nil {
+			connectionMap["admin_state_up"] = *connection.AdminStateUp
+		}
+		if connection.PeerAddress != nil {
+			connectionMap["peer_address"] = *connection.PeerAddress
+		}
+		if connection.Status != nil {
+			connectionMap["status"] = *connection.Status
+		}
+
+		tunnels := make([]map[string]interface{}, 0)
+		upTunnels := 0
+		for _, tunnel := range connection.Tunnels {
+			tunnelMap := map[string]interface{}{}
+			if tunnel.PublicIP != nil && tunnel.PublicIP.Address != nil {
+				tunnelMap["public_ip_address"] = *tunnel.PublicIP.Address
+			}
+			if tunnel.Status != nil {
+				tunnelMap["status"] = *tunnel.Status
+				if *tunnel.Status == "up" {
+					upTunnels++
+				}
+			}
+			reasons := make([]map[string]interface{}, 0)
+			for _, reason := range tunnel.StatusReasons {
+				reasonMap := map[string]interface{}{}
+				if reason.Code != nil {
+					reasonMap["code"] = *reason.Code
+				}
+				if reason.Message != nil {
+					reasonMap["message"] = *reason.Message
+				}
+				if reason.MoreInfo != nil {
+					reasonMap["more_info"] = *reason.MoreInfo
+				}
+				reasons = append(reasons, reasonMap)
+			}
+			tunnelMap["status_reasons"] = reasons
+			tunnels = append(tunnels, tunnelMap)
+		}
+		connectionMap["tunnels"] = tunnels
+		connectionMap["up_tunnels"] = upTunnels
+
+		if connection.IkePolicy != nil && connection.IkePolicy.ID != nil {
+			id := *connection.IkePolicy.ID
+			if _, ok := ikeProposals[id]; !ok {
+				ike, response, err := sess.GetIkePolicyWithContext(context, &vpcv1.GetIkePolicyOptions{ID: &id})
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response))
+				}
+				ikeProposals[id] = []map[string]interface{}{{
+					"policy":                   id,
+					"authentication_algorithm": *ike.AuthenticationAlgorithm,
+					"encryption_algorithm":     *ike.EncryptionAlgorithm,
+					"dh_group":                 *ike.DhGroup,
+					"ike_version":              *ike.IkeVersion,
+				}}
+				if ike.KeyLifetime != nil {
+					ikeProposals[id][0]["key_lifetime"] = *ike.KeyLifetime
+				}
+			}
+			connectionMap["ike_proposal"] = ikeProposals[id]
+		}
+		if connection.IpsecPolicy != nil && connection.IpsecPolicy.ID != nil {
+			id := *connection.IpsecPolicy.ID
+			if _, ok := ipsecProposals[id]; !ok {
+				ipsec, response, err := sess.GetIpsecPolicyWithContext(context, &vpcv1.GetIpsecPolicyOptions{ID: &id})
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response))
+				}
+				ipsecProposals[id] = []map[string]interface{}{{
+					"policy":                   id,
+					"authentication_algorithm": *ipsec.AuthenticationAlgorithm,
+					"encryption_algorithm":     *ipsec.EncryptionAlgorithm,
+					"pfs":                      *ipsec.Pfs,
+				}}
+				if ipsec.KeyLifetime != nil {
+					ipsecProposals[id][0]["key_lifetime"] = *ipsec.KeyLifetime
+				}
+			}
+			connectionMap["ipsec_proposal"] = ipsecProposals[id]
+		}
+		connections = append(connections, connectionMap)
+	}
+
+	if err = d.Set("connections", connections); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting connections: %s", err))
+	}
+	d.SetId(dataSourceIBMVPNGatewayConnectionsID(d))
+	return nil
+}
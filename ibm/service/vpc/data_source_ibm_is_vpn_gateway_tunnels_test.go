@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVpnGatewayTunnelsDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_vpn_gateway_tunnels.test1"
+	vpcname := fmt.Sprintf("tfvpnuat-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpnuat-subnet-%d", acctest.RandIntRange(100, 200))
+	vpngwname := fmt.Sprintf("tfvpnuat-vpngw-%d", acctest.RandIntRange(100, 200))
+	ikename := fmt.Sprintf("tfvpnuat-ike-%d", acctest.RandIntRange(100, 200))
+	ipsecname := fmt.Sprintf("tfvpnuat-ipsec-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpnuat-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVpnGatewayTunnelsDataSourceConfig(vpcname, subnetname, vpngwname, ikename, ipsecname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "connections.#", "1"),
+					resource.TestCheckResourceAttr(node, "connections.0.name", name),
+					resource.TestCheckResourceAttr(node, "connections.0.tunnels.#", "2"),
+					resource.TestCheckResourceAttrSet(node, "connections.0.tunnels.0.status"),
+					resource.TestCheckResourceAttrSet(node, "connections.0.up_tunnels"),
+					resource.TestCheckResourceAttr(node, "connections.0.ike_proposal.0.authentication_algorithm", "sha256"),
+					resource.TestCheckResourceAttr(node, "connections.0.ike_proposal.0.dh_group", "14"),
+					resource.TestCheckResourceAttr(node, "connections.0.ipsec_proposal.0.encryption_algorithm", "aes128"),
+					resource.TestCheckResourceAttr(node, "connections.0.ipsec_proposal.0.pfs", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVpnGatewayTunnelsDataSourceConfig(vpc, subnet, vpngwname, ikename, ipsecname, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_vpn_gateway" "testacc_vpnGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "route"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_ike_policy" "testacc_ike" {
+		name = "%s"
+		authentication_algorithm = "sha256"
+		encryption_algorithm = "aes128"
+		dh_group = 14
+		ike_version = 2
+	}
+	resource "ibm_is_ipsec_policy" "testacc_ipsec" {
+		name = "%s"
+		authentication_algorithm = "sha256"
+		encryption_algorithm = "aes128"
+		pfs = "disabled"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_vpnGateway.id
+		peer_address = "1.2.3.4"
+		preshared_key = "VPNDemoPassword"
+		ike_policy = ibm_is_ike_policy.testacc_ike.id
+		ipsec_policy = ibm_is_ipsec_policy.testacc_ipsec.id
+	}
+	data "ibm_is_vpn_gateway_tunnels" "test1" {
+		vpn_gateway = ibm_is_vpn_gateway.testacc_vpnGateway.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection.gateway_connection
+	}`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpngwname, ikename, ipsecname, name)
+}
@@ -71,6 +71,11 @@ func DataSourceIBMIsVPNServer() *schema.Resource {
 							Computed:    true,
 							Description: "The CRN for this certificate instance,The certificate instance used for the VPN client certificate authority (CA).",
 						},
+						"crl": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate revocation list contents, encoded in PEM format.",
+						},
 					},
 				},
 			},
@@ -503,6 +508,9 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 					if vpnServerAuthentication.ClientCa != nil && vpnServerAuthentication.ClientCa.CRN != nil {
 						vpnServerAuthenticationPrototype["client_ca"] = *vpnServerAuthentication.ClientCa.CRN
 					}
+					if vpnServerAuthentication.Crl != nil {
+						vpnServerAuthenticationPrototype["crl"] = *vpnServerAuthentication.Crl
+					}
 					if vpnServerAuthentication.IdentityProvider != nil {
 						vpnServerAuthenticationByUsernameIDProvider := vpnServerAuthentication.IdentityProvider.(*vpcv1.VPNServerAuthenticationByUsernameIDProvider)
 						vpnServerAuthenticationPrototype["identity_provider"] = *vpnServerAuthenticationByUsernameIDProvider.ProviderType
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isZoneCapabilitiesZones                    = "zones"
+	isZoneCapabilitiesInstanceProfiles         = "instance_profiles"
+	isZoneCapabilitiesGpuInstanceProfiles      = "gpu_instance_profiles"
+	isZoneCapabilitiesBareMetalServerProfiles  = "bare_metal_server_profiles"
+	isZoneCapabilitiesDedicatedHostProfiles    = "dedicated_host_profiles"
+	isZoneCapabilitiesDedicatedHostFamilies    = "dedicated_host_families"
+	isZoneCapabilitiesVirtualNetworkInterfaces = "virtual_network_interfaces"
+	isZoneCapabilitiesBareMetalServers         = "bare_metal_servers"
+	isZoneCapabilitiesDedicatedHosts           = "dedicated_hosts"
+)
+
+func DataSourceIBMISZoneCapabilities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISZoneCapabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			isZoneRegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the region, the region of the provider when not set.",
+			},
+			isZoneCapabilitiesZones: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The zones of the region.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isZoneName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone.",
+						},
+						isZoneStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the zone. Only zones in the available status offer the capabilities of the region.",
+						},
+					},
+				},
+			},
+			isZoneCapabilitiesInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the virtual server instance profiles that can be provisioned in the region.",
+			},
+			isZoneCapabilitiesGpuInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the virtual server instance profiles with GPUs that can be provisioned in the region.",
+			},
+			isZoneCapabilitiesBareMetalServerProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the bare metal server profiles that can be provisioned in the region.",
+			},
+			isZoneCapabilitiesDedicatedHostProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the dedicated host profiles that can be provisioned in the region.",
+			},
+			isZoneCapabilitiesDedicatedHostFamilies: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The families of the dedicated host profiles that can be provisioned in the region.",
+			},
+			isZoneCapabilitiesVirtualNetworkInterfaces: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether virtual network interfaces are supported in the region.",
+			},
+			isZoneCapabilitiesBareMetalServers: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether bare metal servers can be provisioned in the region.",
+			},
+			isZoneCapabilitiesDedicatedHosts: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether dedicated hosts can be provisioned in the region.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISZoneCapabilitiesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bmxSess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionName := d.Get(isZoneRegion).(string)
+	if regionName == "" {
+		regionName = bmxSess.Config.Region
+	}
+	if regionName != bmxSess.Config.Region {
+		// the profiles are listed by the regional endpoint, so another region is queried through its own
+		// endpoint
+		region, response, err := sess.GetRegionWithContext(context, &vpcv1.GetRegionOptions{Name: &regionName})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting region %s: %s\n%s", regionName, err, response))
+		}
+		sess = &vpcv1.VpcV1{
+			Service: sess.Service.Clone(),
+		}
+		sess.Service.SetServiceURL(*region.Endpoint + "/v1")
+	}
+
+	zones, response, err := sess.ListRegionZonesWithContext(context, &vpcv1.ListRegionZonesOptions{RegionName: &regionName})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the zones of region %s: %s\n%s", regionName, err, response))
+	}
+
+	capabilities, err := listIBMISRegionCapabilities(context, sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneList := make([]map[string]interface{}, 0, len(zones.Zones))
+	for _, zone := range zones.Zones {
+		zoneList = append(zoneList, map[string]interface{}{
+			isZoneName:   *zone.Name,
+			isZoneStatus: *zone.Status,
+		})
+	}
+
+	d.SetId(regionName)
+	if err = d.Set(isZoneRegion, regionName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting region: %s", err))
+	}
+	if err = d.Set(isZoneCapabilitiesZones, zoneList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting zones: %s", err))
+	}
+	for key, value := range capabilities {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", key, err))
+		}
+	}
+	return nil
+}
+
+// listIBMISRegionCapabilities lists the profiles and features offered by the region. The VPC API exposes
+// them per region, not per zone.
+func listIBMISRegionCapabilities(context context.Context, sess *vpcv1.VpcV1) (map[string]interface{}, error) {
+	instanceProfiles, response, err := sess.ListInstanceProfilesWithContext(context, &vpcv1.ListInstanceProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the instance profiles: %s\n%s", err, response)
+	}
+	instanceProfileNames := []string{}
+	gpuInstanceProfileNames := []string{}
+	for _, profile := range instanceProfiles.Profiles {
+		instanceProfileNames = append(instanceProfileNames, *profile.Name)
+		if gpu, ok := profile.GpuCount.(*vpcv1.InstanceProfileGpu); ok && (flex.IntValue(gpu.Value) > 0 || flex.IntValue(gpu.Max) > 0) {
+			gpuInstanceProfileNames = append(gpuInstanceProfileNames, *profile.Name)
+		}
+	}
+
+	bareMetalServerProfileNames := []string{}
+	start := ""
+	for {
+		listBMSProfilesOptions := &vpcv1.ListBareMetalServerProfilesOptions{}
+		if start != "" {
+			listBMSProfilesOptions.Start = &start
+		}
+		bmsProfiles, response, err := sess.ListBareMetalServerProfilesWithContext(context, listBMSProfilesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the bare metal server profiles: %s\n%s", err, response)
+		}
+		for _, profile := range bmsProfiles.Profiles {
+			bareMetalServerProfileNames = append(bareMetalServerProfileNames, *profile.Name)
+		}
+		start = flex.GetNext(bmsProfiles.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	dedicatedHostProfileNames := []string{}
+	dedicatedHostFamilies := []string{}
+	families := map[string]bool{}
+	start = ""
+	for {
+		listDedicatedHostProfilesOptions := &vpcv1.ListDedicatedHostProfilesOptions{}
+		if start != "" {
+			listDedicatedHostProfilesOptions.Start = &start
+		}
+		dhProfiles, response, err := sess.ListDedicatedHostProfilesWithContext(context, listDedicatedHostProfilesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the dedicated host profiles: %s\n%s", err, response)
+		}
+		for _, profile := range dhProfiles.Profiles {
+			dedicatedHostProfileNames = append(dedicatedHostProfileNames, *profile.Name)
+			if profile.Family != nil && !families[*profile.Family] {
+				families[*profile.Family] = true
+				dedicatedHostFamilies = append(dedicatedHostFamilies, *profile.Family)
+			}
+		}
+		start = flex.GetNext(dhProfiles.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	// virtual network interfaces are not offered by every region yet, a region without them rejects the
+	// list request as not found or invalid. Any other error, such as a missing authorization, is returned
+	// rather than reported as an unsupported feature.
+	virtualNetworkInterfaces := true
+	_, response, err = sess.ListVirtualNetworkInterfacesWithContext(context, &vpcv1.ListVirtualNetworkInterfacesOptions{Limit: core.Int64Ptr(1)})
+	if err != nil {
+		if response == nil || (response.StatusCode != 400 && response.StatusCode != 404) {
+			return nil, fmt.Errorf("[ERROR] Error listing the virtual network interfaces: %s\n%s", err, response)
+		}
+		log.Printf("[DEBUG] Virtual network interfaces are not supported: %s", err)
+		virtualNetworkInterfaces = false
+	}
+
+	sort.Strings(instanceProfileNames)
+	sort.Strings(gpuInstanceProfileNames)
+	sort.Strings(bareMetalServerProfileNames)
+	sort.Strings(dedicatedHostProfileNames)
+	sort.Strings(dedicatedHostFamilies)
+	return map[string]interface{}{
+		isZoneCapabilitiesInstanceProfiles:         instanceProfileNames,
+		isZoneCapabilitiesGpuInstanceProfiles:      gpuInstanceProfileNames,
+		isZoneCapabilitiesBareMetalServerProfiles:  bareMetalServerProfileNames,
+		isZoneCapabilitiesDedicatedHostProfiles:    dedicatedHostProfileNames,
+		isZoneCapabilitiesDedicatedHostFamilies:    dedicatedHostFamilies,
+		isZoneCapabilitiesVirtualNetworkInterfaces: virtualNetworkInterfaces,
+		isZoneCapabilitiesBareMetalServers:         len(bareMetalServerProfileNames) > 0,
+		isZoneCapabilitiesDedicatedHosts:           len(dedicatedHostProfileNames) > 0,
+	}, nil
+}
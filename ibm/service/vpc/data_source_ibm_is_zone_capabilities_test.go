@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISZoneCapabilitiesDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISZoneCapabilitiesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_zone_capabilities.testacc_ds_zone_capabilities", "region", acc.RegionName),
+					resource.TestCheckResourceAttrSet("data.ibm_is_zone_capabilities.testacc_ds_zone_capabilities", "zones.0.name"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_zone_capabilities.testacc_ds_zone_capabilities", "instance_profiles.0"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_zone_capabilities.testacc_ds_zone_capabilities", "virtual_network_interfaces"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISZoneCapabilitiesDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_zone_capabilities" "testacc_ds_zone_capabilities" {
+		region = "%s"
+	}`, acc.RegionName)
+}
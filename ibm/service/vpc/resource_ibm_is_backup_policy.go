@@ -11,6 +11,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -25,6 +26,12 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 		DeleteContext: resourceIBMIsBackupPolicyDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMIsBackupPolicyIncludedContentValidate(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"match_resource_types": &schema.Schema{
 				Type:          schema.TypeSet,
@@ -60,6 +67,13 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 				Description: "The included content for backups created using this policy",
 				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", "included_content")},
 			},
+			"include_boot_volume": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"included_content"},
+				Description:   "Whether the backups of the instances matched by the policy include the boot volume along with the data volumes, in a crash-consistent snapshot consistency group. Applies only when match_resource_type is instance.",
+			},
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
@@ -229,6 +243,8 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 	}
 	if _, ok := d.GetOk("included_content"); ok {
 		backupPolicyPrototype.IncludedContent = flex.ExpandStringList((d.Get("included_content").(*schema.Set)).List())
+	} else if includeBootVolume, ok := d.GetOkExists("include_boot_volume"); ok {
+		backupPolicyPrototype.IncludedContent = backupPolicyIncludedContent(includeBootVolume.(bool))
 	}
 
 	if _, ok := d.GetOk("match_user_tags"); ok {
@@ -303,6 +319,15 @@ func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceDa
 		if err = d.Set("included_content", backupPolicy.IncludedContent); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting included_content: %s", err))
 		}
+		includeBootVolume := false
+		for _, content := range backupPolicy.IncludedContent {
+			if content == "boot_volume" {
+				includeBootVolume = true
+			}
+		}
+		if err = d.Set("include_boot_volume", includeBootVolume); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting include_boot_volume: %s", err))
+		}
 	}
 
 	if backupPolicy.MatchUserTags != nil {
@@ -423,6 +448,9 @@ func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.Resource
 	if d.HasChange("included_content") {
 		patchVals.IncludedContent = (flex.ExpandStringList((d.Get("included_content").(*schema.Set)).List()))
 		hasChange = true
+	} else if d.HasChange("include_boot_volume") {
+		patchVals.IncludedContent = backupPolicyIncludedContent(d.Get("include_boot_volume").(bool))
+		hasChange = true
 	}
 	updateBackupPolicyOptions.SetIfMatch(d.Get("version").(string))
 	if hasChange {
@@ -453,3 +481,30 @@ func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.Resource
 	d.SetId("")
 	return nil
 }
+
+// backupPolicyIncludedContent returns the content included in the backups of an instance, the data
+// volumes and optionally the boot volume.
+func backupPolicyIncludedContent(includeBootVolume bool) []string {
+	if includeBootVolume {
+		return []string{"boot_volume", "data_volumes"}
+	}
+	return []string{"data_volumes"}
+}
+
+// resourceIBMIsBackupPolicyIncludedContentValidate refuses the content of instance backups on a policy
+// that does not match instances, volume backups are single volume snapshots.
+func resourceIBMIsBackupPolicyIncludedContentValidate(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("match_resource_type") || diff.Get("match_resource_type").(string) == "instance" {
+		return nil
+	}
+	raw := diff.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+	for _, key := range []string{"included_content", "include_boot_volume"} {
+		if value := raw.GetAttr(key); !value.IsNull() {
+			return fmt.Errorf("%s is supported only by backup policies with match_resource_type instance", key)
+		}
+	}
+	return nil
+}
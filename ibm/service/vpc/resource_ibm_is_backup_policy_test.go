@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -217,6 +218,47 @@ func testAccCheckIBMIsBackupPolicyConfigMatchResourceType(backupPolicyName strin
 	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, volName, acc.ISZoneName, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, backupPolicyName)
 }
 
+func TestAccIBMIsBackupPolicyInstanceIncludeBootVolume(t *testing.T) {
+	backupPolicyName := fmt.Sprintf("tfbakuppolicyname%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsBackupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIsBackupPolicyConfigIncludeBootVolume(backupPolicyName, "volume", true),
+				ExpectError: regexp.MustCompile("include_boot_volume is supported only by backup policies with match_resource_type instance"),
+			},
+			{
+				Config: testAccCheckIBMIsBackupPolicyConfigIncludeBootVolume(backupPolicyName, "instance", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "match_resource_type", "instance"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "include_boot_volume", "true"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "included_content.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsBackupPolicyConfigIncludeBootVolume(backupPolicyName, "instance", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "include_boot_volume", "false"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "included_content.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPolicyConfigIncludeBootVolume(backupPolicyName, matchResourceType string, includeBootVolume bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_backup_policy" "is_backup_policy" {
+		match_user_tags     = ["tag-0"]
+		match_resource_type = "%s"
+		include_boot_volume = %t
+		name                = "%s"
+	}`, matchResourceType, includeBootVolume, backupPolicyName)
+}
+
 func testAccCheckIBMIsBackupPolicyDestroy(s *terraform.State) error {
 	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 
@@ -640,7 +640,6 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 										Optional:      true,
 										Computed:      true,
 										ConflictsWith: []string{"primary_network_attachment.0.virtual_network_interface.0.id"},
-										ForceNew:      true,
 										Elem:          &schema.Schema{Type: schema.TypeString},
 										Set:           schema.HashString,
 										Description:   "The security groups for this virtual network interface.",
@@ -1866,16 +1865,14 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
-		oldList, newList := d.GetChange(isBareMetalServerTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isBareMetalServerTags), meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
-		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isBareMetalServerAccessTags), meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource bare metal server (%s) access tags: %s", d.Id(), err)
@@ -2535,7 +2532,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		listToRemove, listToAdd, serverToStop, listToUpdate := findNetworkAttachmentDifferences(otsIntf, ntsIntf, d.Id(), sess, d)
 
 		if listToUpdate != nil {
-			return fmt.Errorf("[ERROR] Error while updating network attachment BareMetalServer(%s) \n%s", d.Id(), err)
+			return fmt.Errorf("[ERROR] Error while updating network attachment BareMetalServer(%s) \n%s", d.Id(), listToUpdate)
 		}
 		serverStopped := false
 		if serverToStop {
@@ -2886,7 +2883,42 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					oldIpSpoofing := oldPack[isBareMetalServerNicAllowIPSpoofing].(bool)
 					oldInfraNat := oldPack[isBareMetalServerNicEnableInfraNAT].(bool)
 
-					if oldAllowedVlans.Difference(newAllowedVlans).Len() > 0 || newAllowedVlans.Difference(oldAllowedVlans).Len() > 0 || newInfraNat != oldInfraNat || newIpSpoofing != oldIpSpoofing {
+					newSecurityGroups := newPack[isBareMetalServerNicSecurityGroups].(*schema.Set)
+					oldSecurityGroups := oldPack[isBareMetalServerNicSecurityGroups].(*schema.Set)
+					if newSecurityGroups.Len() > 0 && !oldSecurityGroups.Equal(newSecurityGroups) {
+						remove := flex.ExpandStringList(oldSecurityGroups.Difference(newSecurityGroups).List())
+						add := flex.ExpandStringList(newSecurityGroups.Difference(oldSecurityGroups).List())
+						for i := range add {
+							createsgnicoptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{
+								SecurityGroupID: &add[i],
+								ID:              &networkId,
+							}
+							_, response, err := sess.CreateSecurityGroupTargetBinding(createsgnicoptions)
+							if err != nil {
+								return fmt.Errorf("[ERROR] Error while creating security group %q for network interface %s of bare metal server %s\n%s: %q", add[i], networkId, d.Id(), err, response)
+							}
+							_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+							if err != nil {
+								return err
+							}
+						}
+						for i := range remove {
+							deletesgnicoptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
+								SecurityGroupID: &remove[i],
+								ID:              &networkId,
+							}
+							response, err := sess.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+							if err != nil {
+								return fmt.Errorf("[ERROR] Error while removing security group %q for network interface %s of bare metal server %s\n%s: %q", remove[i], networkId, d.Id(), err, response)
+							}
+							_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+							if err != nil {
+								return err
+							}
+						}
+					}
+
+					if oldAllowedVlans.Difference(newAllowedVlans).Len() > 0 || newAllowedVlans.Difference(oldAllowedVlans).Len() > 0 || newInfraNat != oldInfraNat || newIpSpoofing != oldIpSpoofing || (newNicName != "" && newNicName != oldNicName) {
 
 						updatepnicfoptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
 							BareMetalServerID: &id,
@@ -2894,7 +2926,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						}
 
 						bmsPatchModel := &vpcv1.BareMetalServerNetworkInterfacePatch{}
-						if strings.Compare(newNicName, oldNicName) != 0 {
+						if newNicName != "" && strings.Compare(newNicName, oldNicName) != 0 {
 							bmsPatchModel.Name = &newNicName
 						}
 
@@ -4338,15 +4370,17 @@ func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalS
 	var listToDelete []vpcv1.DeleteBareMetalServerNetworkAttachmentOptions
 	var listToAdd []vpcv1.CreateBareMetalServerNetworkAttachmentOptions
 	var err error
-	var serverRestartNeeded bool
+	// pci attachments can only be added or removed while the server is stopped, vlan attachments and
+	// in place updates (allowed_vlans, security groups) do not need a stop
+	var removeNeedsStop, addNeedsStop bool
 
 	go func() {
-		listToDelete, serverRestartNeeded = compareRemovedNacs(oldList, newList, bareMetalServerId)
+		listToDelete, removeNeedsStop = compareRemovedNacs(oldList, newList, bareMetalServerId)
 		wg.Done()
 	}()
 
 	go func() {
-		listToAdd, serverRestartNeeded = compareAddedNacs(oldList, newList, bareMetalServerId)
+		listToAdd, addNeedsStop = compareAddedNacs(oldList, newList, bareMetalServerId)
 		wg.Done()
 	}()
 
@@ -4356,7 +4390,7 @@ func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalS
 	}()
 
 	wg.Wait()
-	return listToDelete, listToAdd, serverRestartNeeded, err
+	return listToDelete, listToAdd, removeNeedsStop || addNeedsStop, err
 }
 func compareRemovedNacs(oldList, newList []interface{}, bareMetalServerId string) ([]vpcv1.DeleteBareMetalServerNetworkAttachmentOptions, bool) {
 	var removed []vpcv1.DeleteBareMetalServerNetworkAttachmentOptions
@@ -4634,11 +4668,14 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 					}
 				}
 				if hasChanged {
-					bmsNacPatch, _ := bmsNacPatchModel.AsPatch()
+					bmsNacPatch, err := bmsNacPatchModel.AsPatch()
+					if err != nil {
+						return fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerNetworkAttachmentPatch(%s): %s", id, err)
+					}
 					modilfiedNac.BareMetalServerNetworkAttachmentPatch = bmsNacPatch
 					_, res, err := sess.UpdateBareMetalServerNetworkAttachment(modilfiedNac)
 					if err != nil {
-						return fmt.Errorf("%s/n%v", err, res)
+						return fmt.Errorf("[ERROR] Error updating network attachment(%s): %s\n%v", id, err, res)
 					}
 				}
 				if s1Vni != nil && s2Vni != nil {
@@ -4679,9 +4716,14 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 						hasChanged = true
 					}
 					if hasChanged {
+						vniPatchAsPatch, err := vniPatch.AsPatch()
+						if err != nil {
+							return fmt.Errorf("[ERROR] Error calling asPatch for VirtualNetworkInterfacePatch of network attachment(%s): %s", id, err)
+						}
+						vniUpdateOptions.VirtualNetworkInterfacePatch = vniPatchAsPatch
 						_, res, err := sess.UpdateVirtualNetworkInterface(vniUpdateOptions)
 						if err != nil {
-							return fmt.Errorf("%s/n%v", err, res)
+							return fmt.Errorf("[ERROR] Error updating virtual network interface(%s) of network attachment(%s): %s\n%v", vniId, id, err, res)
 						}
 					}
 					if s1vniMapIPS != nil && s2vniMapIPS != nil && !s1vniMapIPS.(*schema.Set).Equal(s2vniMapIPS.(*schema.Set)) {
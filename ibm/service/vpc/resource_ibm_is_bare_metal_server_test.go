@@ -359,6 +359,60 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISBareMetalServer_networkAttachmentUpdate(t *testing.T) {
+	var server string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-server-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfip-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-sshname-%d", acctest.RandIntRange(10, 100))
+	serverID := ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISBareMetalServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkAttachmentUpdateConfig(vpcname, subnetname, sshname, publicKey, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerExists("ibm_is_bare_metal_server.testacc_bms", server),
+					resource.TestCheckResourceAttrWith("ibm_is_bare_metal_server.testacc_bms", "id", func(value string) error {
+						serverID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "primary_network_attachment.0.allowed_vlans.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "primary_network_attachment.0.virtual_network_interface.0.security_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkAttachmentUpdateConfig(vpcname, subnetname, sshname, publicKey, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerExists("ibm_is_bare_metal_server.testacc_bms", server),
+					resource.TestCheckResourceAttrWith("ibm_is_bare_metal_server.testacc_bms", "id", func(value string) error {
+						if value != serverID {
+							return fmt.Errorf("bare metal server was recreated: %s replaced %s", value, serverID)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "primary_network_attachment.0.allowed_vlans.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "primary_network_attachment.0.virtual_network_interface.0.security_groups.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "network_attachments.0.interface_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "network_attachments.0.vlan", "104"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISBareMetalServerDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -748,3 +802,64 @@ func testAccCheckIBMISBareMetalServerReservedIpConfig(vpcname, subnetname, sshna
 		}
 `, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, acc.IsBareMetalServerProfileName, name, acc.IsBareMetalServerImage, acc.ISZoneName)
 }
+
+func testAccCheckIBMISBareMetalServerNetworkAttachmentUpdateConfig(vpcname, subnetname, sshname, publicKey, name string, updated bool) string {
+	allowedVlans := "[100, 102]"
+	securityGroups := "[ibm_is_security_group.testacc_sg1.id]"
+	networkAttachments := ""
+	if updated {
+		allowedVlans = "[100, 102, 104]"
+		securityGroups = "[ibm_is_security_group.testacc_sg1.id, ibm_is_security_group.testacc_sg2.id]"
+		networkAttachments = `
+			network_attachments {
+				name = "test-vlan-104"
+				vlan = 104
+				virtual_network_interface {
+					subnet = ibm_is_subnet.testacc_subnet.id
+				}
+			}`
+	}
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+	  
+		resource "ibm_is_subnet" "testacc_subnet" {
+			name            			= "%s"
+			vpc             			= ibm_is_vpc.testacc_vpc.id
+			zone            			= "%s"
+			total_ipv4_address_count 	= 16
+		}
+	  
+		resource "ibm_is_ssh_key" "testacc_sshkey" {
+			name       			= "%s"
+			public_key 			= "%s"
+		}
+
+		resource "ibm_is_security_group" "testacc_sg1" {
+			name = "test-security-group1"
+			vpc  = ibm_is_vpc.testacc_vpc.id
+		}
+		resource "ibm_is_security_group" "testacc_sg2" {
+			name = "test-security-group2"
+			vpc  = ibm_is_vpc.testacc_vpc.id
+		}
+
+		resource "ibm_is_bare_metal_server" "testacc_bms" {
+			profile 			= "%s"
+			name 				= "%s"
+			image 				= "%s"
+			zone 				= "%s"
+			keys 				= [ibm_is_ssh_key.testacc_sshkey.id]
+			primary_network_attachment {
+				name = "test-pci"
+				virtual_network_interface {
+					subnet          = ibm_is_subnet.testacc_subnet.id
+					security_groups = %s
+				}
+				allowed_vlans = %s
+			}%s
+			vpc 				= ibm_is_vpc.testacc_vpc.id
+		}
+`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, acc.IsBareMetalServerProfileName, name, acc.IsBareMetalServerImage, acc.ISZoneName, securityGroups, allowedVlans, networkAttachments)
+}
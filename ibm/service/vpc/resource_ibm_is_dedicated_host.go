@@ -481,8 +481,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 
 	d.SetId(*dedicatedHost.ID)
 	if _, ok := d.GetOk(isDedicatedHostAccessTags); ok {
-		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isDedicatedHostAccessTags), meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource dedicated host (%s) access tags: %s", d.Id(), err)
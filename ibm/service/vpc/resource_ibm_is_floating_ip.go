@@ -29,6 +29,7 @@ const (
 	isFloatingIPTarget        = "target"
 	isFloatingIPResourceGroup = "resource_group"
 	isFloatingIPTags          = "tags"
+	isFloatingIPStandby       = "standby"
 
 	isFloatingIPPending   = "pending"
 	isFloatingIPAvailable = "available"
@@ -65,24 +66,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 			),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-
-					if diff.HasChange(isFloatingIPTarget) {
-						if !diff.NewValueKnown(isFloatingIPTarget) {
-							diff.ForceNew(isFloatingIPTarget)
-							return nil
-						}
-						old, new := diff.GetChange(isFloatingIPTarget)
-						if old != "" || new != "" {
-							sess, err := vpcClient(v)
-							if err != nil {
-								return err
-							}
-							if checkIfZoneChanged(old.(string), new.(string), diff.Get(isFloatingIPZone).(string), sess) {
-								diff.ForceNew(isFloatingIPTarget)
-							}
-						}
-					}
-					return nil
+					return resourceIBMISFloatingIPTargetDiff(diff, v)
 				},
 			),
 		),
@@ -109,23 +93,31 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 			},
 
 			isFloatingIPZone: {
-				Type:          schema.TypeString,
-				ForceNew:      true,
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{isFloatingIPTarget},
-				ExactlyOneOf:  []string{isFloatingIPTarget, isFloatingIPZone},
-				Description:   "Zone name",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{isFloatingIPTarget, isFloatingIPZone},
+				Description:  "Zone name. When set together with target, the floating IP is pinned to the zone and can only be moved to targets in it.",
 			},
 
 			isFloatingIPTarget: {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{isFloatingIPZone},
-				ExactlyOneOf:  []string{isFloatingIPTarget, isFloatingIPZone},
+				AtLeastOneOf:  []string{isFloatingIPTarget, isFloatingIPZone},
+				ConflictsWith: []string{isFloatingIPStandby},
 				Description:   "Target info",
 			},
+
+			isFloatingIPStandby: {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				RequiredWith:  []string{isFloatingIPZone},
+				ConflictsWith: []string{isFloatingIPTarget},
+				Description:   "Keep the floating IP unbound from any target, reserving the address in its zone.",
+			},
 			floatingIPTargets: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -348,6 +340,8 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		floatingIPPrototype.Target = &vpcv1.FloatingIPTargetPrototypeNetworkInterfaceIdentity{
 			ID: &target,
 		}
+		// the zone of a floating IP created for a target is the zone of the target
+		floatingIPPrototype.Zone = nil
 	}
 
 	if zone == "" && target == "" {
@@ -377,8 +371,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFloatingIPTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isFloatingIPTags), meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc Floating IP (%s) tags: %s", d.Id(), err)
@@ -386,8 +379,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 
 	if _, ok := d.GetOk(isFloatingIPAccessTags); ok {
-		oldList, newList := d.GetChange(isFloatingIPAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isFloatingIPAccessTags), meta, *floatingip.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource Floating IP (%s) access tags: %s", d.Id(), err)
@@ -533,14 +525,20 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 	if d.HasChange(isFloatingIPTarget) {
 		target := d.Get(isFloatingIPTarget).(string)
-		floatingIPPatchModel.Target = &vpcv1.FloatingIPTargetPatch{
-			ID: &target,
+		if target != "" {
+			floatingIPPatchModel.Target = &vpcv1.FloatingIPTargetPatch{
+				ID: &target,
+			}
 		}
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for floatingIPPatch: %s", err)
 		}
+		// an empty target unbinds the floating IP, keeping its address
+		if target == "" {
+			floatingIPPatch["target"] = nil
+		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
 	if hasChanged {
@@ -682,44 +680,75 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 	}
 }
 
-func checkIfZoneChanged(oldNic, newNic, currentZone string, floatingipC *vpcv1.VpcV1) bool {
-	var oldZone, newZone string
+// resourceIBMISFloatingIPTargetDiff plans the target of a floating IP. A floating IP is moved to a
+// target in its zone, or unbound in standby, in place. Moving it to a target in another zone replaces
+// it, unless the floating IP is pinned to its zone, in which case the plan fails.
+func resourceIBMISFloatingIPTargetDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get(isFloatingIPStandby).(bool) {
+		if diff.Get(isFloatingIPTarget).(string) != "" {
+			return diff.SetNew(isFloatingIPTarget, "")
+		}
+		return nil
+	}
+	if diff.Id() == "" || !diff.HasChange(isFloatingIPTarget) {
+		return nil
+	}
+	if !diff.NewValueKnown(isFloatingIPTarget) {
+		return diff.ForceNew(isFloatingIPTarget)
+	}
+	newTarget := diff.Get(isFloatingIPTarget).(string)
+	if newTarget == "" {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	currentZone, _ := diff.GetChange(isFloatingIPZone)
+	newZone := floatingIPTargetZone(sess, newTarget)
+	if newZone == "" || newZone == currentZone.(string) {
+		return nil
+	}
+	if !diff.GetRawConfig().GetAttr(isFloatingIPZone).IsNull() {
+		return fmt.Errorf("[ERROR] target %s is in zone %s, but the floating IP is pinned to zone %s", newTarget, newZone, currentZone)
+	}
+	return diff.ForceNew(isFloatingIPTarget)
+}
+
+// floatingIPTargetZone returns the zone of a virtual network interface or instance network interface,
+// or an empty string if the target cannot be found.
+func floatingIPTargetZone(floatingipC *vpcv1.VpcV1, target string) string {
+	vni, _, err := floatingipC.GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{
+		ID: &target,
+	})
+	if err == nil && vni.Zone != nil {
+		return *vni.Zone.Name
+	}
+
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
 	start := ""
-	allrecs := []vpcv1.Instance{}
 	for {
-
 		if start != "" {
 			listInstancesOptions.Start = &start
 		}
 
 		instances, _, err := floatingipC.ListInstances(listInstancesOptions)
 		if err != nil {
-			return false
+			return ""
+		}
+		for _, instance := range instances.Instances {
+			for _, nic := range instance.NetworkInterfaces {
+				if target == *nic.ID {
+					return *instance.Zone.Name
+				}
+			}
 		}
 		start = flex.GetNext(instances.Next)
-		allrecs = append(allrecs, instances.Instances...)
 		if start == "" {
 			break
 		}
 	}
-	for _, instance := range allrecs {
-		for _, nic := range instance.NetworkInterfaces {
-			if oldNic == *nic.ID {
-				oldZone = *instance.Zone.Name
-			}
-			if newNic == *nic.ID {
-				newZone = *instance.Zone.Name
-			}
-		}
-	}
-	if newZone != oldZone {
-		if oldZone == "" && newZone == currentZone {
-			return false
-		}
-		return true
-	}
-	return false
+	return ""
 }
 
 func floatingIPCollectionFloatingIpTargetToMap(targetItemIntf vpcv1.FloatingIPTargetIntf) (targetId string, targetMap map[string]interface{}) {
@@ -97,6 +97,45 @@ func TestAccIBMISFloatingIP_NoTarget(t *testing.T) {
 	})
 }
 
+func TestAccIBMISFloatingIP_failover(t *testing.T) {
+	var ip string
+	vpcname := fmt.Sprintf("tfip-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfip-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfip-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISFloatingIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISFloatingIPFailoverConfig(vpcname, subnetname, name, "target = ibm_is_virtual_network_interface.testacc_vni1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISFloatingIPExists("ibm_is_floating_ip.testacc_floatingip", ip),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_floating_ip.testacc_floatingip", "target", "ibm_is_virtual_network_interface.testacc_vni1", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISFloatingIPFailoverConfig(vpcname, subnetname, name, "target = ibm_is_virtual_network_interface.testacc_vni2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_floating_ip.testacc_floatingip", "target", "ibm_is_virtual_network_interface.testacc_vni2", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISFloatingIPFailoverConfig(vpcname, subnetname, name, "standby = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_floating_ip.testacc_floatingip", "target", ""),
+					resource.TestCheckResourceAttr(
+						"ibm_is_floating_ip.testacc_floatingip", "standby", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISFloatingIPDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -187,3 +226,34 @@ func testAccCheckIBMISFloatingIPNoTargetConfig(name string) string {
 	  }
 `, name, acc.ISZoneName)
 }
+
+func testAccCheckIBMISFloatingIPFailoverConfig(vpcname, subnetname, name, binding string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni1" {
+		name   = "%s-vni1"
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni2" {
+		name   = "%s-vni2"
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_floating_ip" "testacc_floatingip" {
+		name = "%s"
+		zone = "%s"
+		%s
+	}
+`, vpcname, subnetname, acc.ISZoneName, name, name, name, acc.ISZoneName, binding)
+}
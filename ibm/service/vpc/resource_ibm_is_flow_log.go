@@ -264,16 +264,14 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFlowLogTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isFlowLogTags), meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isFlowLogAccessTags); ok {
-		oldList, newList := d.GetChange(isFlowLogAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isFlowLogAccessTags), meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource VPC Flow Log (%s) access tags: %s", d.Id(), err)
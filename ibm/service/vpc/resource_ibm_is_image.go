@@ -370,16 +370,14 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isImageTags), meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isImageAccessTags); ok {
-		oldList, newList := d.GetChange(isImageAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isImageAccessTags), meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
@@ -485,16 +483,14 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isImageTags), meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isImageAccessTags); ok {
-		oldList, newList := d.GetChange(isImageAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isImageAccessTags), meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
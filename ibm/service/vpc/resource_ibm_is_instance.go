@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -118,6 +119,8 @@ const (
 	isInstanceDefaultTrustedProfileAutoLink = "default_trusted_profile_auto_link"
 	isInstanceDefaultTrustedProfileTarget   = "default_trusted_profile_target"
 	isInstanceMetadataServiceEnabled        = "metadata_service_enabled"
+	isInstanceConfidentialComputeMode       = "confidential_compute_mode"
+	isInstanceEnableSecureBoot              = "enable_secure_boot"
 
 	isInstanceAccessTags                  = "access_tags"
 	isInstanceUserTagType                 = "user"
@@ -126,6 +129,12 @@ const (
 	isInstanceMetadataServiceEnabled1     = "enabled"
 	isInstanceMetadataServiceProtocol     = "protocol"
 	isInstanceMetadataServiceRespHopLimit = "response_hop_limit"
+
+	isInstanceDefaultTrustedProfile          = "default_trusted_profile"
+	isInstanceDefaultTrustedProfileTarget1   = "target"
+	isInstanceDefaultTrustedProfileAutoLink1 = "auto_link"
+	isInstanceDefaultTrustedProfileTargetCrn = "target_crn"
+	isInstanceDefaultTrustedProfileTargetId  = "target_id"
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -183,6 +192,14 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsInstanceProfileCapabilitiesCustomizeDiff(diff, v)
+				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsInstanceImageLifecycleCustomizeDiff(diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -193,6 +210,21 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description: "The availability policy to use for this virtual server instance",
 			},
 
+			isInstanceConfidentialComputeMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceConfidentialComputeMode),
+				Description:  "The confidential compute mode to use for this virtual server instance. If unspecified, the default confidential compute mode from the profile will be used.",
+			},
+
+			isInstanceEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled for this virtual server instance. If unspecified, the default secure boot mode from the profile will be used.",
+			},
+
 			isInstanceName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -237,18 +269,59 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description: "Profile info",
 			},
 			isInstanceDefaultTrustedProfileAutoLink: {
-				Type:         schema.TypeBool,
-				Optional:     true,
-				ForceNew:     true,
-				Computed:     true,
-				RequiredWith: []string{isInstanceDefaultTrustedProfileTarget},
-				Description:  "If set to `true`, the system will create a link to the specified `target` trusted profile during instance creation. Regardless of whether a link is created by the system or manually using the IAM Identity service, it will be automatically deleted when the instance is deleted.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				RequiredWith:  []string{isInstanceDefaultTrustedProfileTarget},
+				ConflictsWith: []string{isInstanceDefaultTrustedProfile},
+				Deprecated:    "Use default_trusted_profile instead",
+				Description:   "If set to `true`, the system will create a link to the specified `target` trusted profile during instance creation. Regardless of whether a link is created by the system or manually using the IAM Identity service, it will be automatically deleted when the instance is deleted.",
 			},
 			isInstanceDefaultTrustedProfileTarget: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The unique identifier or CRN of the default IAM trusted profile to use for this virtual server instance.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{isInstanceDefaultTrustedProfile},
+				Deprecated:    "Use default_trusted_profile instead",
+				Description:   "The unique identifier or CRN of the default IAM trusted profile to use for this virtual server instance.",
+			},
+			isInstanceDefaultTrustedProfile: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{isInstanceDefaultTrustedProfileTarget, isInstanceDefaultTrustedProfileAutoLink},
+				Description:   "The default IAM trusted profile to use for this virtual server instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceDefaultTrustedProfileTarget1: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The unique identifier or CRN of the default IAM trusted profile to use for this virtual server instance.",
+						},
+						isInstanceDefaultTrustedProfileAutoLink1: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "If set to `true`, the system will create a link to the specified `target` trusted profile during instance creation. Regardless of whether a link is created by the system or manually using the IAM Identity service, it will be automatically deleted when the instance is deleted.",
+						},
+						isInstanceDefaultTrustedProfileTargetId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the default IAM trusted profile.",
+						},
+						isInstanceDefaultTrustedProfileTargetCrn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the default IAM trusted profile.",
+						},
+					},
+				},
 			},
 			isPlacementTargetDedicatedHost: {
 				Type:          schema.TypeString,
@@ -1681,6 +1754,14 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              host_failure})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceConfidentialComputeMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, sgx"})
+
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "accesstag",
@@ -1715,23 +1796,8 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 			ID: &vpcID,
 		},
 	}
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
+	if defaultTrustedProfile := GetInstanceDefaultTrustedProfileOptions(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
 	}
 	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
 		hostFailure := availablePolicyItem.(string)
@@ -1839,29 +1905,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		}
 		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachmentModel
 	}
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -2085,7 +2129,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := createInstanceWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot))
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2102,16 +2146,14 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceTags), meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceAccessTags), meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -2154,23 +2196,8 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		}
 		instanceproto.CatalogOffering = versionPrototype
 	}
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
+	if defaultTrustedProfile := GetInstanceDefaultTrustedProfileOptions(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
 	}
 	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
 		hostFailure := availablePolicyItem.(string)
@@ -2266,29 +2293,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		}
 		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachmentModel
 	}
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -2512,7 +2517,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := createInstanceWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot))
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2529,8 +2534,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceTags), meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
@@ -2551,23 +2555,8 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		Name: &name,
 	}
 
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
+	if defaultTrustedProfile := GetInstanceDefaultTrustedProfileOptions(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
 	}
 	if profile != "" {
 		instanceproto.Profile = &vpcv1.InstanceProfileIdentity{
@@ -2688,29 +2677,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		}
 		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachmentModel
 	}
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -2933,7 +2900,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := createInstanceWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot))
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2950,16 +2917,14 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceTags), meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceAccessTags), meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -2986,23 +2951,8 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		},
 	}
 
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
+	if defaultTrustedProfile := GetInstanceDefaultTrustedProfileOptions(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
 	}
 
 	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
@@ -3078,29 +3028,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		}
 	}
 
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -3359,7 +3287,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := createInstanceWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot))
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -3376,16 +3304,14 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceTags), meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceAccessTags), meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -3412,23 +3338,8 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		},
 	}
 
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
+	if defaultTrustedProfile := GetInstanceDefaultTrustedProfileOptions(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
 	}
 
 	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
@@ -3470,29 +3381,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		instanceproto.BootVolumeAttachment = bootVolAttachment
 	}
 
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -3752,7 +3641,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := createInstanceWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot))
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -3769,16 +3658,14 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceTags), meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceAccessTags), meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -3962,13 +3849,10 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &id,
-	}
 	getinsIniOptions := &vpcv1.GetInstanceInitializationOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, rawInstance, response, err := getInstanceWithCapabilities(instanceC, id)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -3980,16 +3864,40 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
 	}
-	if instanceInitialization.DefaultTrustedProfile != nil && instanceInitialization.DefaultTrustedProfile.AutoLink != nil {
-		d.Set(isInstanceDefaultTrustedProfileAutoLink, *instanceInitialization.DefaultTrustedProfile.AutoLink)
-	}
-	if instanceInitialization.DefaultTrustedProfile != nil && instanceInitialization.DefaultTrustedProfile.Target != nil {
-		d.Set(isInstanceDefaultTrustedProfileTarget, *instanceInitialization.DefaultTrustedProfile.Target.ID)
+	if instanceInitialization.DefaultTrustedProfile != nil {
+		defaultTrustedProfile := instanceInitialization.DefaultTrustedProfile
+		defaultTrustedProfileMap := map[string]interface{}{}
+		if defaultTrustedProfile.AutoLink != nil {
+			d.Set(isInstanceDefaultTrustedProfileAutoLink, *defaultTrustedProfile.AutoLink)
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileAutoLink1] = *defaultTrustedProfile.AutoLink
+		}
+		if defaultTrustedProfile.Target != nil {
+			// the target can be configured with either the id or the crn of the trusted profile, keep whichever was used
+			target := *defaultTrustedProfile.Target.ID
+			if configured := d.Get(isInstanceDefaultTrustedProfileTarget).(string); defaultTrustedProfile.Target.CRN != nil && configured == *defaultTrustedProfile.Target.CRN {
+				target = configured
+			}
+			d.Set(isInstanceDefaultTrustedProfileTarget, target)
+
+			blockTarget := *defaultTrustedProfile.Target.ID
+			if configured, ok := d.GetOk(isInstanceDefaultTrustedProfile + ".0." + isInstanceDefaultTrustedProfileTarget1); ok && defaultTrustedProfile.Target.CRN != nil && configured.(string) == *defaultTrustedProfile.Target.CRN {
+				blockTarget = configured.(string)
+			}
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTarget1] = blockTarget
+			defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTargetId] = *defaultTrustedProfile.Target.ID
+			if defaultTrustedProfile.Target.CRN != nil {
+				defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTargetCrn] = *defaultTrustedProfile.Target.CRN
+			}
+		}
+		d.Set(isInstanceDefaultTrustedProfile, []map[string]interface{}{defaultTrustedProfileMap})
 	}
 
 	if instance.AvailabilityPolicy != nil && instance.AvailabilityPolicy.HostFailure != nil {
 		d.Set(isInstanceAvailablePolicyHostFailure, *instance.AvailabilityPolicy.HostFailure)
 	}
+	if err = setInstanceCapabilities(d, rawInstance, isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot); err != nil {
+		return err
+	}
 
 	// catalog
 	if instance.CatalogOffering != nil {
@@ -5620,7 +5528,8 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange(isInstanceProfile) && !d.IsNewResource() {
+	// profile, confidential compute mode and secure boot can only be changed while the instance is stopped
+	if (d.HasChange(isInstanceProfile) || d.HasChange(isInstanceConfidentialComputeMode) || d.HasChange(isInstanceEnableSecureBoot)) && !d.IsNewResource() {
 
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
@@ -5657,17 +5566,26 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			ID: &id,
 		}
 
-		instanceProfile := d.Get(isInstanceProfile).(string)
-		profile := &vpcv1.InstancePatchProfile{
-			Name: &instanceProfile,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{
-			Profile: profile,
+		instancePatchModel := &vpcv1.InstancePatch{}
+		if d.HasChange(isInstanceProfile) {
+			instanceProfile := d.Get(isInstanceProfile).(string)
+			instancePatchModel.Profile = &vpcv1.InstancePatchProfile{
+				Name: &instanceProfile,
+			}
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
 		}
+		// the confidential compute mode and secure boot are not modelled by the vpc-go-sdk yet
+		if d.HasChange(isInstanceConfidentialComputeMode) {
+			if confidentialComputeMode, ok := d.GetOk(isInstanceConfidentialComputeMode); ok {
+				instancePatch["confidential_compute_mode"] = confidentialComputeMode.(string)
+			}
+		}
+		if d.HasChange(isInstanceEnableSecureBoot) {
+			instancePatch["enable_secure_boot"] = d.Get(isInstanceEnableSecureBoot).(bool)
+		}
 		updnetoptions.InstancePatch = instancePatch
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
@@ -6112,6 +6030,40 @@ func resourceIbmIsInstanceDedicatedHostGroupReferenceDeletedToMap(dedicatedHostG
 	return dedicatedHostGroupReferenceDeletedMap
 }
 
+func GetInstanceDefaultTrustedProfileOptions(d *schema.ResourceData) (defaultTrustedProfile *vpcv1.InstanceDefaultTrustedProfilePrototype) {
+	target := ""
+	var autoLink *bool
+	if defaultTrustedProfileIntf, ok := d.GetOk(isInstanceDefaultTrustedProfile); ok {
+		defaultTrustedProfileMap := defaultTrustedProfileIntf.([]interface{})[0].(map[string]interface{})
+		target = defaultTrustedProfileMap[isInstanceDefaultTrustedProfileTarget1].(string)
+		if autoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfile + ".0." + isInstanceDefaultTrustedProfileAutoLink1); ok {
+			autoLinkValue := autoLinkIntf.(bool)
+			autoLink = &autoLinkValue
+		}
+	} else if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
+		target = defaultTrustedProfileTargetIntf.(string)
+		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
+			autoLinkValue := defaultTrustedProfileAutoLinkIntf.(bool)
+			autoLink = &autoLinkValue
+		}
+	}
+	if target == "" {
+		return nil
+	}
+
+	targetIdentity := &vpcv1.TrustedProfileIdentity{}
+	if strings.HasPrefix(target, "crn") {
+		targetIdentity.CRN = &target
+	} else {
+		targetIdentity.ID = &target
+	}
+	defaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
+		Target:   targetIdentity,
+		AutoLink: autoLink,
+	}
+	return
+}
+
 func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService *vpcv1.InstanceMetadataServicePrototype) {
 
 	if metadataServiceIntf, ok := d.GetOk(isInstanceMetadataService); ok {
@@ -6365,3 +6317,332 @@ func containsNacId(s []string, e string) bool {
 	}
 	return false
 }
+
+// isInstanceReservationAffinityPrototype returns the configured reservation affinity of an instance or an
+// instance template, or nil when it is not configured.
+func isInstanceReservationAffinityPrototype(d *schema.ResourceData) *vpcv1.InstanceReservationAffinityPrototype {
+	resAffinityIntf, ok := d.GetOk(isReservationAffinity)
+	if !ok {
+		return nil
+	}
+	resAff, ok := resAffinityIntf.([]interface{})[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	resAffinity := &vpcv1.InstanceReservationAffinityPrototype{}
+	if policyStr, ok := resAff["policy"].(string); ok && policyStr != "" {
+		resAffinity.Policy = &policyStr
+	}
+	if pools, ok := resAff[isReservationAffinityPool].([]interface{}); ok && len(pools) > 0 && pools[0] != nil {
+		pool := pools[0].(map[string]interface{})
+		if idStr, ok := pool["id"].(string); ok && idStr != "" {
+			resAffinity.Pool = []vpcv1.ReservationIdentityIntf{
+				&vpcv1.ReservationIdentity{
+					ID: &idStr,
+				},
+			}
+		}
+	}
+	return resAffinity
+}
+
+func resourceIBMIsInstanceProfileCapabilitiesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange(isInstanceProfile) && !diff.HasChange(isInstanceConfidentialComputeMode) && !diff.HasChange(isInstanceEnableSecureBoot) && !diff.HasChange(isInstanceImage) {
+		return nil
+	}
+	// the GPUs of the instance are those of its profile, they change with the profile
+	if diff.Id() != "" && diff.HasChange(isInstanceProfile) {
+		if err := diff.SetNewComputed(isInstanceGpu); err != nil {
+			return err
+		}
+	}
+	profile := diff.Get(isInstanceProfile).(string)
+	confidentialComputeMode := diff.Get(isInstanceConfidentialComputeMode).(string)
+	var enableSecureBoot *bool
+	if raw := diff.GetRawConfig().GetAttr(isInstanceEnableSecureBoot); raw.IsKnown() && !raw.IsNull() {
+		enableSecureBoot = core.BoolPtr(diff.Get(isInstanceEnableSecureBoot).(bool))
+	}
+	var image string
+	if raw := diff.GetRawConfig().GetAttr(isInstanceImage); raw.IsKnown() && !raw.IsNull() {
+		image = diff.Get(isInstanceImage).(string)
+	}
+	return validateInstanceProfileCapabilities(meta, profile, confidentialComputeMode, enableSecureBoot, image)
+}
+
+func resourceIBMIsInstanceImageLifecycleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isInstanceImage) {
+		return nil
+	}
+	if raw := diff.GetRawConfig().GetAttr(isInstanceImage); !raw.IsKnown() || raw.IsNull() {
+		return nil
+	}
+	return validateImageLifecycle(meta, diff.Get(isInstanceImage).(string))
+}
+
+// validateImageLifecycle fails the plan when the image is obsolete. When it is deprecated or scheduled
+// for obsolescence, it logs a warning or fails the plan depending on the deprecated_image_policy
+// provider argument, so that teams can migrate before the image can no longer be used.
+func validateImageLifecycle(meta interface{}, image string) error {
+	if image == "" {
+		return nil
+	}
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	getImageOptions := &vpcv1.GetImageOptions{
+		ID: &image,
+	}
+	imageDetails, response, err := instanceC.GetImage(getImageOptions)
+	if err != nil {
+		// images that can't be read here, such as images of other accounts, are checked by the API on create
+		log.Printf("[DEBUG] Skipping the lifecycle validation of image (%s): %s\n%s", image, err, response)
+		return nil
+	}
+	name := image
+	if imageDetails.Name != nil {
+		name = fmt.Sprintf("%s (%s)", *imageDetails.Name, image)
+	}
+	status := ""
+	if imageDetails.Status != nil {
+		status = *imageDetails.Status
+	}
+	if status == "obsolete" {
+		return fmt.Errorf("[ERROR] Image %s is obsolete and can no longer be used to provision virtual server instances", name)
+	}
+
+	var lifecycle string
+	switch {
+	case status == "deprecated" && imageDetails.ObsolescenceAt != nil:
+		lifecycle = fmt.Sprintf("is deprecated and becomes obsolete at %s", imageDetails.ObsolescenceAt.String())
+	case status == "deprecated":
+		lifecycle = "is deprecated"
+	case imageDetails.ObsolescenceAt != nil:
+		lifecycle = fmt.Sprintf("is scheduled to become obsolete at %s", imageDetails.ObsolescenceAt.String())
+	case imageDetails.DeprecationAt != nil:
+		log.Printf("[WARN] Image %s is scheduled to be deprecated at %s", name, imageDetails.DeprecationAt.String())
+		return nil
+	default:
+		return nil
+	}
+	if meta.(conns.ClientSession).DeprecatedImagePolicy() == conns.DeprecatedImagePolicyError {
+		return fmt.Errorf("[ERROR] Image %s %s, use a newer image or set the deprecated_image_policy provider argument to %s", name, lifecycle, conns.DeprecatedImagePolicyWarn)
+	}
+	log.Printf("[WARN] Image %s %s, migrate to a newer image before it can no longer be used", name, lifecycle)
+	return nil
+}
+
+// validateInstanceProfileCapabilities checks that the requested confidential compute mode and secure boot
+// setting are supported by the instance profile, and that the operating system of the image runs on it, so
+// that unsupported combinations, such as an image for another architecture on a GPU profile, fail at plan time.
+func validateInstanceProfileCapabilities(meta interface{}, profile, confidentialComputeMode string, enableSecureBoot *bool, image string) error {
+	if profile == "" || (confidentialComputeMode == "" && enableSecureBoot == nil && image == "") {
+		return nil
+	}
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	getInstanceProfileOptions := &vpcv1.GetInstanceProfileOptions{
+		Name: &profile,
+	}
+	instanceProfile, response, err := instanceC.GetInstanceProfile(getInstanceProfileOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return fmt.Errorf("[ERROR] Instance profile (%s) not found", profile)
+		}
+		return fmt.Errorf("[ERROR] Error getting instance profile (%s): %s\n%s", profile, err, response)
+	}
+	profileKind := "instance profile"
+	if instanceProfile.GpuCount != nil {
+		profileKind = "GPU instance profile"
+	}
+	if confidentialComputeMode != "" || enableSecureBoot != nil {
+		// the confidential compute and secure boot modes are not modelled by the vpc-go-sdk yet
+		var rawProfile map[string]interface{}
+		response, err := vpcRawRequest(context.Background(), instanceC, core.GET, "/instance/profiles/{name}", map[string]string{"name": profile}, nil, &rawProfile)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting instance profile (%s): %s\n%s", profile, err, response)
+		}
+		confidentialComputeModes := flattenInstanceProfileRawEnum(rawProfile["confidential_compute_modes"])
+		if confidentialComputeMode != "" && len(confidentialComputeModes) > 0 && !instanceProfileRawEnumHas(confidentialComputeModes, confidentialComputeMode) {
+			return fmt.Errorf("[ERROR] %s %q is not supported by the %s %s, supported values are %v", isInstanceConfidentialComputeMode, confidentialComputeMode, profileKind, profile, confidentialComputeModes[0]["values"])
+		}
+		secureBootModes := flattenInstanceProfileRawEnum(rawProfile["secure_boot_modes"])
+		if enableSecureBoot != nil && len(secureBootModes) > 0 && !instanceProfileRawEnumHas(secureBootModes, *enableSecureBoot) {
+			return fmt.Errorf("[ERROR] %s %t is not supported by the %s %s, supported values are %v", isInstanceEnableSecureBoot, *enableSecureBoot, profileKind, profile, secureBootModes[0]["values"])
+		}
+	}
+	if image != "" && instanceProfile.OsArchitecture != nil && len(instanceProfile.OsArchitecture.Values) > 0 {
+		getImageOptions := &vpcv1.GetImageOptions{
+			ID: &image,
+		}
+		imageDetails, response, err := instanceC.GetImage(getImageOptions)
+		if err != nil {
+			// images that can't be read here, such as images of other accounts, are checked by the API on create
+			log.Printf("[DEBUG] Skipping the architecture validation of image (%s): %s\n%s", image, err, response)
+			return nil
+		}
+		if imageDetails.OperatingSystem != nil && imageDetails.OperatingSystem.Architecture != nil {
+			supported := false
+			for _, architecture := range instanceProfile.OsArchitecture.Values {
+				if architecture == *imageDetails.OperatingSystem.Architecture {
+					supported = true
+					break
+				}
+			}
+			if !supported {
+				return fmt.Errorf("[ERROR] The %s architecture of image %s is not supported by the %s %s, supported architectures are %v", *imageDetails.OperatingSystem.Architecture, image, profileKind, profile, instanceProfile.OsArchitecture.Values)
+			}
+		}
+	}
+	return nil
+}
+
+// instanceCapabilitiesPrototype returns the confidential compute mode and secure boot properties to add to an
+// instance or instance template prototype. The vpc-go-sdk in use does not model them yet.
+func instanceCapabilitiesPrototype(d *schema.ResourceData, confidentialComputeModeKey, enableSecureBootKey string) map[string]interface{} {
+	capabilities := map[string]interface{}{}
+	if confidentialComputeMode, ok := d.GetOk(confidentialComputeModeKey); ok {
+		capabilities["confidential_compute_mode"] = confidentialComputeMode.(string)
+	}
+	if enableSecureBoot, ok := d.GetOkExists(enableSecureBootKey); ok {
+		capabilities["enable_secure_boot"] = enableSecureBoot.(bool)
+	}
+	return capabilities
+}
+
+// instancePrototypeWithCapabilities merges the capabilities into the JSON form of a vpcv1 prototype.
+func instancePrototypeWithCapabilities(prototype interface{}, capabilities map[string]interface{}) (map[string]interface{}, error) {
+	buf, err := json.Marshal(prototype)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(buf, &body); err != nil {
+		return nil, err
+	}
+	for key, value := range capabilities {
+		body[key] = value
+	}
+	return body, nil
+}
+
+// createInstanceWithCapabilities creates the instance with the SDK, or with a plain request when a
+// confidential compute mode or secure boot setting has to be sent along with the prototype.
+func createInstanceWithCapabilities(sess *vpcv1.VpcV1, options *vpcv1.CreateInstanceOptions, capabilities map[string]interface{}) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	if len(capabilities) == 0 {
+		return sess.CreateInstance(options)
+	}
+	body, err := instancePrototypeWithCapabilities(options.InstancePrototype, capabilities)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(context.Background(), sess, core.POST, "/instances", nil, body, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	var instance *vpcv1.Instance
+	if err = core.UnmarshalModel(rawResponse, "", &instance, vpcv1.UnmarshalInstance); err != nil {
+		return nil, response, err
+	}
+	response.Result = instance
+	return instance, response, nil
+}
+
+// createInstanceTemplateWithCapabilities is createInstanceWithCapabilities for instance templates.
+func createInstanceTemplateWithCapabilities(sess *vpcv1.VpcV1, options *vpcv1.CreateInstanceTemplateOptions, capabilities map[string]interface{}) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	if len(capabilities) == 0 {
+		return sess.CreateInstanceTemplate(options)
+	}
+	body, err := instancePrototypeWithCapabilities(options.InstanceTemplatePrototype, capabilities)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(context.Background(), sess, core.POST, "/instance/templates", nil, body, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	var instanceTemplate vpcv1.InstanceTemplateIntf
+	if err = core.UnmarshalModel(rawResponse, "", &instanceTemplate, vpcv1.UnmarshalInstanceTemplate); err != nil {
+		return nil, response, err
+	}
+	response.Result = instanceTemplate
+	return instanceTemplate, response, nil
+}
+
+// getInstanceWithCapabilities gets an instance with a plain request and decodes it with the vpc-go-sdk, so that
+// the confidential compute mode and secure boot setting, which the vpc-go-sdk in use does not model yet, are read
+// from the same response rather than with a second request.
+func getInstanceWithCapabilities(sess *vpcv1.VpcV1, id string) (*vpcv1.Instance, map[string]json.RawMessage, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(context.Background(), sess, core.GET, "/instances/{id}", map[string]string{"id": id}, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var instance *vpcv1.Instance
+	if err = core.UnmarshalModel(rawResponse, "", &instance, vpcv1.UnmarshalInstance); err != nil {
+		return nil, nil, response, err
+	}
+	response.Result = instance
+	return instance, rawResponse, response, nil
+}
+
+// getInstanceByNameWithCapabilities is getInstanceWithCapabilities for the first instance listed with the
+// name. It returns a nil instance when no instance has the name.
+func getInstanceByNameWithCapabilities(sess *vpcv1.VpcV1, name string) (*vpcv1.Instance, map[string]json.RawMessage, *core.DetailedResponse, error) {
+	var rawCollection map[string]json.RawMessage
+	response, err := vpcRawRequestWithQuery(context.Background(), sess, core.GET, "/instances", nil, map[string]string{"name": name}, nil, &rawCollection)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var rawInstances []map[string]json.RawMessage
+	if err = json.Unmarshal(rawCollection["instances"], &rawInstances); err != nil {
+		return nil, nil, response, err
+	}
+	if len(rawInstances) == 0 {
+		return nil, nil, response, nil
+	}
+	var instance *vpcv1.Instance
+	if err = core.UnmarshalModel(rawInstances[0], "", &instance, vpcv1.UnmarshalInstance); err != nil {
+		return nil, nil, response, err
+	}
+	return instance, rawInstances[0], response, nil
+}
+
+// getInstanceTemplateWithCapabilities is getInstanceWithCapabilities for instance templates.
+func getInstanceTemplateWithCapabilities(sess *vpcv1.VpcV1, id string) (vpcv1.InstanceTemplateIntf, map[string]json.RawMessage, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(context.Background(), sess, core.GET, "/instance/templates/{id}", map[string]string{"id": id}, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var instanceTemplate vpcv1.InstanceTemplateIntf
+	if err = core.UnmarshalModel(rawResponse, "", &instanceTemplate, vpcv1.UnmarshalInstanceTemplate); err != nil {
+		return nil, nil, response, err
+	}
+	response.Result = instanceTemplate
+	return instanceTemplate, rawResponse, response, nil
+}
+
+// setInstanceCapabilities sets the confidential compute mode and secure boot setting from the raw JSON of an
+// instance or instance template.
+func setInstanceCapabilities(d *schema.ResourceData, rawInstance map[string]json.RawMessage, confidentialComputeModeKey, enableSecureBootKey string) error {
+	if raw, ok := rawInstance["confidential_compute_mode"]; ok {
+		var confidentialComputeMode string
+		if err := json.Unmarshal(raw, &confidentialComputeMode); err != nil {
+			return fmt.Errorf("[ERROR] Error reading the confidential compute mode: %s", err)
+		}
+		d.Set(confidentialComputeModeKey, confidentialComputeMode)
+	}
+	if raw, ok := rawInstance["enable_secure_boot"]; ok {
+		var enableSecureBoot bool
+		if err := json.Unmarshal(raw, &enableSecureBoot); err != nil {
+			return fmt.Errorf("[ERROR] Error reading the secure boot setting: %s", err)
+		}
+		d.Set(enableSecureBootKey, enableSecureBoot)
+	}
+	return nil
+}
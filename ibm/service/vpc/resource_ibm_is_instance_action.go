@@ -6,12 +6,15 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -21,6 +24,7 @@ const (
 	isInstanceStopType        = "stop_type"
 	isInstanceID              = "instance"
 	isInstanceActionForce     = "force_action"
+	isInstanceActionGrace     = "shutdown_grace_period"
 )
 
 func ResourceIBMISInstanceAction() *schema.Resource {
@@ -34,6 +38,7 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -57,6 +62,13 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 				Default:     false,
 				Description: "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
 			},
+			isInstanceActionGrace: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{isInstanceActionForce},
+				Description:   "The time in seconds the operating system is given to shut down on a stop action. The stop is forced when the instance has not stopped by then.",
+			},
 			isInstanceStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -113,53 +125,12 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	instanceId := ""
-	if insId, ok := d.GetOk(isInstanceID); ok {
-		instanceId = insId.(string)
-	}
-
-	actiontypeIntf := d.Get(isInstanceAction)
-	actiontype := actiontypeIntf.(string)
+	instanceId := d.Get(isInstanceID).(string)
+	actiontype := d.Get(isInstanceAction).(string)
 
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &instanceId,
-	}
-	instance, response, err := sess.GetInstance(getinsOptions)
+	err = instanceActionApply(sess, d, instanceId, actiontype, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", instanceId, err, response))
-	}
-	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
-		d.Set(isInstanceAction, nil)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state"))
-	} else if actiontype == "start" && *instance.Status != isInstanceActionStatusStopped {
-		d.Set(isInstanceAction, nil)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state"))
-	}
-	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-		InstanceID: &instanceId,
-		Type:       &actiontype,
-	}
-	if instanceActionForceIntf, ok := d.GetOk(isInstanceActionForce); ok {
-		force := instanceActionForceIntf.(bool)
-		createinsactoptions.Force = &force
-	}
-	_, response, err = sess.CreateInstanceAction(createinsactoptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return nil
-		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
-	}
-	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
@@ -186,6 +157,8 @@ func resourceIBMISInstanceActionRead(context context.Context, d *schema.Resource
 	}
 
 	d.Set(isInstanceStatus, *instance.Status)
+	// an instance stopped or started out of band shows the action that restores its power state as a change
+	d.Set(isInstanceAction, instanceActionObserved(d.Get(isInstanceAction).(string), *instance.Status))
 	statusReasonsList := make([]map[string]interface{}, 0)
 	if instance.StatusReasons != nil {
 		for _, sr := range instance.StatusReasons {
@@ -209,47 +182,93 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	_, actiontypeIntf := d.GetChange(isInstanceAction)
-	actiontype := actiontypeIntf.(string)
-	id := d.Id()
+	if d.HasChange(isInstanceAction) {
+		err = instanceActionApply(sess, d, d.Id(), d.Get(isInstanceAction).(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
+	return resourceIBMISInstanceActionRead(context, d, meta)
+}
+
+// instanceActionApply brings the instance to the power state of the action. A stop or start of an
+// instance that already is in the resulting state is not sent again, so the action can be kept in the
+// configuration as the desired power state.
+func instanceActionApply(sess *vpcv1.VpcV1, d *schema.ResourceData, id, actiontype string, timeout time.Duration) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
 	instance, response, err := sess.GetInstance(getinsOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response))
+		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+	}
+	if (actiontype == "stop" && *instance.Status == isInstanceActionStatusStopped) || (actiontype == "start" && *instance.Status == isInstanceStatusRunning) {
+		log.Printf("[DEBUG] Instance (%s) is already %s, skipping the %s action", id, *instance.Status, actiontype)
+		return nil
 	}
 	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 		d.Set(isInstanceAction, nil)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state"))
+		return fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state")
 	} else if actiontype == "start" && *instance.Status != isInstanceActionStatusStopped {
 		d.Set(isInstanceAction, nil)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state"))
+		return fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state")
 	}
+
+	force := d.Get(isInstanceActionForce).(bool)
+	grace := d.Get(isInstanceActionGrace).(int)
+	err = createInstanceAction(sess, id, actiontype, force)
+	if err != nil {
+		return err
+	}
+	if actiontype == "stop" {
+		if grace > 0 {
+			_, err = isWaitForInstanceActionStop(sess, time.Duration(grace)*time.Second, id, d)
+			if _, ok := err.(*resource.TimeoutError); !ok {
+				return err
+			}
+			// the operating system did not shut down in time, the stop is forced
+			log.Printf("[DEBUG] Instance (%s) did not stop within %d seconds, forcing the stop", id, grace)
+			err = createInstanceAction(sess, id, actiontype, true)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = isWaitForInstanceActionStop(sess, timeout, id, d)
+	} else if actiontype == "start" || actiontype == "reboot" {
+		_, err = isWaitForInstanceActionStart(sess, timeout, id, d)
+	}
+	return err
+}
+
+// instanceActionObserved returns the action matching the power state of the instance. A stop or start action
+// that no longer matches a stopped or running instance is replaced by the action that led to its current
+// state. A reboot, or an instance that is still changing state, leaves the action unchanged.
+func instanceActionObserved(action, status string) string {
+	switch {
+	case status == isInstanceStatusRunning && (action == "stop" || action == ""):
+		return "start"
+	case status == isInstanceActionStatusStopped && (action == "start" || action == ""):
+		return "stop"
+	}
+	return action
+}
+
+func createInstanceAction(sess *vpcv1.VpcV1, id, actiontype string, force bool) error {
 	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
-	_, response, err = sess.CreateInstanceAction(createinsactoptions)
+	if force {
+		createinsactoptions.Force = &force
+	}
+	_, response, err := sess.CreateInstanceAction(createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
-	}
-	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 	}
-
 	return nil
 }
 
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceActionObserved(t *testing.T) {
+	testcases := []struct {
+		name     string
+		action   string
+		status   string
+		expected string
+	}{
+		{name: "stopped as requested", action: "stop", status: "stopped", expected: "stop"},
+		{name: "started out of band", action: "stop", status: "running", expected: "start"},
+		{name: "running as requested", action: "start", status: "running", expected: "start"},
+		{name: "stopped out of band", action: "start", status: "stopped", expected: "stop"},
+		{name: "still stopping", action: "stop", status: "stopping", expected: "stop"},
+		{name: "still starting", action: "start", status: "starting", expected: "start"},
+		{name: "rebooted", action: "reboot", status: "running", expected: "reboot"},
+		{name: "stopped after reboot", action: "reboot", status: "stopped", expected: "reboot"},
+		{name: "imported running", action: "", status: "running", expected: "start"},
+		{name: "imported stopped", action: "", status: "stopped", expected: "stop"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, instanceActionObserved(tc.action, tc.status))
+		})
+	}
+}
@@ -284,8 +284,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get("tags"), meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of instance group (%s) tags: %s", d.Id(), err)
@@ -293,8 +292,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, ok := d.GetOk(isInstanceGroupAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isInstanceGroupAccessTags), meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of instance group (%s) tags: %s", d.Id(), err)
@@ -5,10 +5,13 @@ package vpc
 
 import (
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,6 +29,10 @@ const (
 	isInstanceGroupMembershipVirtualServerInstance           = "virtual_server_instance"
 	isInstanceGroupMembershipLoadBalancerPoolMember          = "load_balancer_pool_member"
 	isInstanceGroupMembershipStatus                          = "status"
+	isInstanceGroupMembershipReplaceTrigger                  = "replace_trigger"
+	isInstanceGroupMembershipReplacedMemberships             = "replaced_memberships"
+	isInstanceGroupMembershipStatusHealthy                   = "healthy"
+	isInstanceGroupMembershipStatusFailed                    = "failed"
 )
 
 func ResourceIBMISInstanceGroupMembership() *schema.Resource {
@@ -36,6 +43,11 @@ func ResourceIBMISInstanceGroupMembership() *schema.Resource {
 		Delete:   resourceIBMISInstanceGroupMembershipDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			isInstanceGroup: {
@@ -49,7 +61,27 @@ func ResourceIBMISInstanceGroupMembership() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_membership", isInstanceGroupMembership),
-				Description:  "The unique identifier for this instance group membership.",
+				// after a replace_trigger change the resource tracks the replacement of the configured membership
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					for _, replaced := range d.Get(isInstanceGroupMembershipReplacedMemberships).([]interface{}) {
+						if replaced.(string) == n {
+							return true
+						}
+					}
+					return false
+				},
+				Description: "The unique identifier for this instance group membership.",
+			},
+			isInstanceGroupMembershipReplaceTrigger: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing this value replaces the membership: it is deleted, and the resource waits for the healthy membership the instance group creates from its instance template in its place.",
+			},
+			isInstanceGroupMembershipReplacedMemberships: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The memberships replaced by this resource, oldest first.",
 			},
 			isInstanceGroupMembershipName: {
 				Type:         schema.TypeString,
@@ -182,6 +214,14 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, instanceGroupMembershipID))
 
+	if d.HasChange(isInstanceGroupMembershipReplaceTrigger) && !d.IsNewResource() {
+		instanceGroupMembership, err = replaceInstanceGroupMembership(d, sess, instanceGroupID, instanceGroupMembership)
+		if err != nil {
+			return err
+		}
+		instanceGroupMembershipID = *instanceGroupMembership.ID
+	}
+
 	if v, ok := d.GetOk(isInstanceGroupMemershipActionDelete); ok {
 		actionDelete := v.(bool)
 		if actionDelete {
@@ -213,6 +253,115 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 	return resourceIBMISInstanceGroupMembershipRead(d, meta)
 }
 
+// replaceInstanceGroupMembership deletes a membership, together with its instance when
+// delete_instance_on_membership_delete is set, and returns the membership created by the instance group
+// to restore its membership count.
+func replaceInstanceGroupMembership(d *schema.ResourceData, sess *vpcv1.VpcV1, instanceGroupID string, membership *vpcv1.InstanceGroupMembership) (*vpcv1.InstanceGroupMembership, error) {
+	existing, err := listInstanceGroupMembershipIDs(sess, instanceGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteInstanceGroupMembershipOptions := vpcv1.DeleteInstanceGroupMembershipOptions{
+		ID:              membership.ID,
+		InstanceGroupID: &instanceGroupID,
+	}
+	response, err := sess.DeleteInstanceGroupMembership(&deleteInstanceGroupMembershipOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return nil, fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Membership %s for replacement: %s\n%s", *membership.ID, err, response)
+	}
+
+	if membership.Instance != nil && membership.Instance.ID != nil {
+		if membership.DeleteInstanceOnMembershipDelete != nil && *membership.DeleteInstanceOnMembershipDelete {
+			_, err = isWaitForInstanceDelete(sess, d, *membership.Instance.ID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			log.Printf("[INFO] Instance %s of the replaced InstanceGroup Membership %s is kept, delete_instance_on_membership_delete is false", *membership.Instance.ID, *membership.ID)
+		}
+	}
+
+	replacement, err := isWaitForInstanceGroupMembershipReplacement(sess, instanceGroupID, existing, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return nil, err
+	}
+	newMembership := replacement.(*vpcv1.InstanceGroupMembership)
+
+	replaced := append(d.Get(isInstanceGroupMembershipReplacedMemberships).([]interface{}), *membership.ID)
+	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, *newMembership.ID))
+	d.Set(isInstanceGroupMembership, *newMembership.ID)
+	d.Set(isInstanceGroupMembershipReplacedMemberships, replaced)
+	return newMembership, nil
+}
+
+func listInstanceGroupMembershipIDs(sess *vpcv1.VpcV1, instanceGroupID string) (map[string]bool, error) {
+	ids := map[string]bool{}
+	start := ""
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+		}
+		for _, membership := range instanceGroupMembershipCollection.Memberships {
+			ids[*membership.ID] = true
+		}
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// isWaitForInstanceGroupMembershipReplacement waits for a healthy membership that is not in existing.
+func isWaitForInstanceGroupMembershipReplacement(sess *vpcv1.VpcV1, instanceGroupID string, existing map[string]bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for a replacement membership of InstanceGroup (%s).", instanceGroupID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"waiting", "pending", "unhealthy"},
+		Target:  []string{isInstanceGroupMembershipStatusHealthy},
+		Refresh: func() (interface{}, string, error) {
+			start := ""
+			for {
+				listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+					InstanceGroupID: &instanceGroupID,
+				}
+				if start != "" {
+					listInstanceGroupMembershipsOptions.Start = &start
+				}
+				instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+				}
+				for i, membership := range instanceGroupMembershipCollection.Memberships {
+					if existing[*membership.ID] {
+						continue
+					}
+					if *membership.Status == isInstanceGroupMembershipStatusFailed {
+						return nil, "", fmt.Errorf("[ERROR] The replacement InstanceGroup Membership %s failed", *membership.ID)
+					}
+					return &instanceGroupMembershipCollection.Memberships[i], *membership.Status, nil
+				}
+				start = flex.GetNext(instanceGroupMembershipCollection.Next)
+				if start == "" {
+					break
+				}
+			}
+			return instanceGroupID, "waiting", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
 func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -57,6 +57,8 @@ const (
 	isInstanceTemplateVolumeDeleteOnInstanceDelete = "delete_volume_on_instance_delete"
 	isInstanceTemplateMetadataServiceEnabled       = "metadata_service_enabled"
 	isInstanceTemplateAvailablePolicyHostFailure   = "availability_policy_host_failure"
+	isInstanceTemplateConfidentialComputeMode      = "confidential_compute_mode"
+	isInstanceTemplateEnableSecureBoot             = "enable_secure_boot"
 	isInstanceTemplateHostFailure                  = "host_failure"
 	isInstanceTemplateNicPrimaryIP                 = "primary_ip"
 	isInstanceTemplateNicReservedIpAddress         = "address"
@@ -90,6 +92,16 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeAttachmentValidate(diff)
 				}),
+
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsInstanceTemplateProfileCapabilitiesCustomizeDiff(diff, v)
+				}),
+
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsInstanceTemplateImageLifecycleCustomizeDiff(diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +113,23 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				Description: "The availability policy to use for this virtual server instance",
 			},
 
+			isInstanceTemplateConfidentialComputeMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateConfidentialComputeMode),
+				Description:  "The confidential compute mode to use for the virtual server instances created from this template. If unspecified, the default confidential compute mode from the profile will be used.",
+			},
+
+			isInstanceTemplateEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled for the virtual server instances created from this template. If unspecified, the default secure boot mode from the profile will be used.",
+			},
+
 			isInstanceTemplateName: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1123,6 +1152,13 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Optional:                   true,
 			AllowedValues:              host_failure})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceTemplateConfidentialComputeMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, sgx"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "tags",
@@ -1315,6 +1351,10 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 		instanceproto.PlacementTarget = dHostGrpPlaementTarget
 	}
 
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
+		instanceproto.ReservationAffinity = resAffinity
+	}
+
 	if placementGroupInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
 		placementGrpStr := placementGroupInf.(string)
 		placementGrp := &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
@@ -1655,7 +1695,7 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
+	instanceIntf, response, err := createInstanceTemplateWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceTemplateConfidentialComputeMode, isInstanceTemplateEnableSecureBoot))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
 	}
@@ -1765,29 +1805,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 		}
 		instanceproto.PlacementTarget = dHostGrpPlaementTarget
 	}
-	if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-		resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-		var resAffinity = &vpcv1.InstanceReservationAffinityPrototype{}
-		policy, ok := resAff["policy"]
-		policyStr := policy.(string)
-		if policyStr != "" && ok {
-			resAffinity.Policy = &policyStr
-		}
-		poolIntf, okPool := resAff[isReservationAffinityPool]
-		if okPool {
-			pool := poolIntf.([]interface{})[0].(map[string]interface{})
-			id, okId := pool["id"]
-			if okId {
-				idStr, ok := id.(string)
-				if idStr != "" && ok {
-					var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-					resAffPool[0] = &vpcv1.ReservationIdentity{
-						ID: &idStr,
-					}
-					resAffinity.Pool = resAffPool
-				}
-			}
-		}
+	if resAffinity := isInstanceReservationAffinityPrototype(d); resAffinity != nil {
 		instanceproto.ReservationAffinity = resAffinity
 	}
 
@@ -2131,7 +2149,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
+	instanceIntf, response, err := createInstanceTemplateWithCapabilities(sess, options, instanceCapabilitiesPrototype(d, isInstanceTemplateConfidentialComputeMode, isInstanceTemplateEnableSecureBoot))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
 	}
@@ -2145,10 +2163,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	if err != nil {
 		return err
 	}
-	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
-		ID: &ID,
-	}
-	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
+	instanceIntf, rawInstance, response, err := getInstanceTemplateWithCapabilities(instanceC, ID)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
 	}
@@ -2158,6 +2173,9 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	if instance.AvailabilityPolicy != nil && instance.AvailabilityPolicy.HostFailure != nil {
 		d.Set(isInstanceTemplateAvailablePolicyHostFailure, instance.AvailabilityPolicy.HostFailure)
 	}
+	if err = setInstanceCapabilities(d, rawInstance, isInstanceTemplateConfidentialComputeMode, isInstanceTemplateEnableSecureBoot); err != nil {
+		return err
+	}
 
 	// vni if any
 	if !core.IsNil(instance.NetworkAttachments) {
@@ -2788,3 +2806,30 @@ func hashIpsListForInstanceTemplate(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", a["address"].(string)))
 	return conns.String(buf.String())
 }
+
+func resourceIBMIsInstanceTemplateProfileCapabilitiesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	profile := diff.Get(isInstanceTemplateProfile).(string)
+	confidentialComputeMode := diff.Get(isInstanceTemplateConfidentialComputeMode).(string)
+	var enableSecureBoot *bool
+	if raw := diff.GetRawConfig().GetAttr(isInstanceTemplateEnableSecureBoot); raw.IsKnown() && !raw.IsNull() {
+		enableSecureBoot = core.BoolPtr(diff.Get(isInstanceTemplateEnableSecureBoot).(bool))
+	}
+	var image string
+	if raw := diff.GetRawConfig().GetAttr(isInstanceTemplateImage); raw.IsKnown() && !raw.IsNull() {
+		image = diff.Get(isInstanceTemplateImage).(string)
+	}
+	return validateInstanceProfileCapabilities(meta, profile, confidentialComputeMode, enableSecureBoot, image)
+}
+
+func resourceIBMIsInstanceTemplateImageLifecycleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	if raw := diff.GetRawConfig().GetAttr(isInstanceTemplateImage); !raw.IsKnown() || raw.IsNull() {
+		return nil
+	}
+	return validateImageLifecycle(meta, diff.Get(isInstanceTemplateImage).(string))
+}
@@ -42,6 +42,7 @@ const (
 	isLBLogging                 = "logging"
 	isLBSecurityGroups          = "security_groups"
 	isLBSecurityGroupsSupported = "security_group_supported"
+	isLBIsPrivatePath           = "is_private_path"
 
 	isLBAccessTags = "access_tags"
 )
@@ -251,6 +252,12 @@ func ResourceIBMISLB() *schema.Resource {
 				Description: "Indicates whether this load balancer supports UDP.",
 			},
 
+			isLBIsPrivatePath: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether this is a private path load balancer.",
+			},
+
 			isLBHostName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -294,7 +301,7 @@ func ResourceIBMISLBValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
 	lbtype := "public, private"
-	isLBProfileAllowedValues := "network-fixed"
+	isLBProfileAllowedValues := "network-fixed, network-private-path"
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -469,8 +476,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isLBTags); ok || v != "" {
-		oldList, newList := d.GetChange(isLBTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isLBTags), meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
@@ -478,8 +484,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	}
 
 	if _, ok := d.GetOk(isLBAccessTags); ok {
-		oldList, newList := d.GetChange(isLBAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isLBAccessTags), meta, *lb.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource load balancer (%s) access tags: %s", d.Id(), err)
@@ -605,8 +610,10 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 		profile := lb.Profile
 		if profile.Name != nil {
 			d.Set(isLBProfile, *lb.Profile.Name)
+			d.Set(isLBIsPrivatePath, *lb.Profile.Name == "network-private-path")
 		}
 	} else {
+		d.Set(isLBIsPrivatePath, false)
 		if lb.Logging != nil && lb.Logging.Datapath != nil && lb.Logging.Datapath.Active != nil {
 			d.Set(isLBLogging, *lb.Logging.Datapath.Active)
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -57,6 +58,12 @@ func ResourceIBMISLBListener() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBListenerFamilyValidate(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isLBListenerLBID: {
@@ -70,7 +77,7 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				Description:  "idle connection timeout of listener",
+				Description:  "idle connection timeout of listener, supported only by load balancers in the `application` family",
 				ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener", isLBListenerIdleConnectionTimeout),
 			},
 
@@ -114,7 +121,7 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Computed:    true,
-				Description: "Listener will forward proxy protocol",
+				Description: "Listener will forward proxy protocol, supported only by load balancers in the `application` family",
 			},
 
 			isLBListenerHTTPSRedirectStatusCode: {
@@ -272,6 +279,70 @@ func ResourceIBMISLBListenerValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerResourceValidator
 }
 
+// resourceIBMISLBListenerFamilyValidate rejects listener settings that the profile family
+// of the load balancer does not support, so they fail at plan instead of at apply.
+func resourceIBMISLBListenerFamilyValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	isNew := diff.Id() == ""
+	checkIdleTimeout := (isNew || diff.HasChange(isLBListenerIdleConnectionTimeout)) && diff.NewValueKnown(isLBListenerIdleConnectionTimeout)
+	checkProxyProtocol := (isNew || diff.HasChange(isLBListenerAcceptProxyProtocol)) && diff.Get(isLBListenerAcceptProxyProtocol).(bool)
+	checkUDP := (isNew || diff.HasChange(isLBListenerProtocol)) && diff.Get(isLBListenerProtocol).(string) == "udp"
+	if !checkIdleTimeout && !checkProxyProtocol && !checkUDP {
+		return nil
+	}
+	if checkIdleTimeout {
+		if _, ok := diff.GetOk(isLBListenerIdleConnectionTimeout); !ok {
+			checkIdleTimeout = false
+		}
+	}
+
+	family, _, err := isLBProfileFamily(diff, meta)
+	if err != nil || family == "" {
+		return err
+	}
+	if strings.EqualFold(family, "application") {
+		if checkUDP {
+			return fmt.Errorf("[ERROR] '%s' udp is supported only by load balancers in the network family, the load balancer is in the %s family", isLBListenerProtocol, family)
+		}
+		return nil
+	}
+	if checkIdleTimeout {
+		return fmt.Errorf("[ERROR] '%s' is supported only by load balancers in the application family, the load balancer is in the %s family", isLBListenerIdleConnectionTimeout, family)
+	}
+	if checkProxyProtocol {
+		return fmt.Errorf("[ERROR] '%s' is supported only by load balancers in the application family, the load balancer is in the %s family", isLBListenerAcceptProxyProtocol, family)
+	}
+	return nil
+}
+
+// isLBProfileFamily returns the profile family and profile name of the load balancer referenced by
+// the "lb" argument of diff. Both are empty when the load balancer is not known yet.
+func isLBProfileFamily(diff *schema.ResourceDiff, meta interface{}) (string, string, error) {
+	if !diff.NewValueKnown(isLBListenerLBID) {
+		return "", "", nil
+	}
+	lbID := diff.Get(isLBListenerLBID).(string)
+	if lbID == "" {
+		return "", "", nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return "", "", err
+	}
+	lb, response, err := sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+	}
+	if lb.Profile == nil || lb.Profile.Family == nil {
+		return "application", "", nil
+	}
+	return *lb.Profile.Family, flex.StringValue(lb.Profile.Name), nil
+}
+
 func resourceIBMISLBListenerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] LB Listener create")
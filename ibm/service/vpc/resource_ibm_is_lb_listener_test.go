@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -147,6 +148,24 @@ func TestAccIBMISLBListener_connTimeout(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISNLBListener_connTimeoutUnsupported(t *testing.T) {
+	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflblis-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tfnlblis%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISNLBListenerConfigIdleConnTimeout(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, "8080", "tcp", "900"),
+				ExpectError: regexp.MustCompile("supported only by load balancers in the application family"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISNLBRouteModeListener_basic(t *testing.T) {
 	var lb string
 	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
@@ -797,6 +816,33 @@ func testAccCheckIBMISNLBPortRangeListenerConfig(vpcname, subnetname, zone, cidr
 
 }
 
+func testAccCheckIBMISNLBListenerConfigIdleConnTimeout(vpcname, subnetname, zone, cidr, lbname, port, protocol, timeout string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name 			= "%s"
+		vpc 			= "${ibm_is_vpc.testacc_vpc.id}"
+		zone 			= "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name			= "%s"
+		subnets 		= ["${ibm_is_subnet.testacc_subnet.id}"]
+		profile 		= "network-fixed"
+		type 			= "public"
+	}
+	resource "ibm_is_lb_listener" "testacc_lb_listener" {
+		lb 						= "${ibm_is_lb.testacc_LB.id}"
+		port 					= %s
+		protocol 				= "%s"
+		idle_connection_timeout = %s
+}`, vpcname, subnetname, zone, cidr, lbname, port, protocol, timeout)
+
+}
+
 func testAccCheckIBMISLBListenerConfigUpdate(vpcname, subnetname, zone, cidr, lbname, port, protocol, connLimit string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
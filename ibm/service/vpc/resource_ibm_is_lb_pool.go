@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -63,6 +64,15 @@ func ResourceIBMISLBPool() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceIBMISLBPoolCookieValidate(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBPoolProxyProtocolValidate(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBPoolUDPValidate(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBPoolSessionPersistenceValidate(diff, v)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -164,7 +174,7 @@ func ResourceIBMISLBPool() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolProxyProtocol),
-				Description:  "PROXY protocol setting for this pool",
+				Description:  "PROXY protocol setting for this pool, `v1` and `v2` are not supported by load balancers with the `network-fixed` profile",
 			},
 
 			isLBPool: {
@@ -187,6 +197,7 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	algorithm := "round_robin, weighted_round_robin, least_connections"
 	protocol := "http, tcp, https, udp"
+	healthType := "http, tcp, https"
 	persistanceType := "source_ip, app_cookie, http_cookie"
 	proxyProtocol := "disabled, v1, v2"
 	validateSchema = append(validateSchema,
@@ -218,7 +229,7 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              protocol})
+			AllowedValues:              healthType})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isLBPoolProxyProtocol,
@@ -247,6 +258,65 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 	return &ibmISLBPoolResourceValidator
 }
 
+// resourceIBMISLBPoolProxyProtocolValidate rejects enabling the PROXY protocol on pools of
+// network load balancers that do not support it.
+func resourceIBMISLBPoolProxyProtocolValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isLBPoolProxyProtocol) {
+		return nil
+	}
+	proxyProtocol, ok := diff.GetOk(isLBPoolProxyProtocol)
+	if !ok || proxyProtocol.(string) == "disabled" {
+		return nil
+	}
+
+	family, profile, err := isLBProfileFamily(diff, meta)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(family, "network") && profile != "network-private-path" {
+		return fmt.Errorf("[ERROR] '%s' %s is not supported by load balancers with the %s profile", isLBPoolProxyProtocol, proxyProtocol.(string), profile)
+	}
+	return nil
+}
+
+// resourceIBMISLBPoolSessionPersistenceValidate rejects the cookie based session persistence types on pools of
+// network load balancers, including the route mode and private path ones, which persist sessions by source IP only.
+func resourceIBMISLBPoolSessionPersistenceValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isLBPoolSessPersistenceType) {
+		return nil
+	}
+	sessionPersistenceType := diff.Get(isLBPoolSessPersistenceType).(string)
+	if sessionPersistenceType == "" || sessionPersistenceType == "source_ip" {
+		return nil
+	}
+
+	family, profile, err := isLBProfileFamily(diff, meta)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(family, "network") {
+		return fmt.Errorf("[ERROR] '%s' %s is not supported by load balancers with the %s profile, only source_ip is supported", isLBPoolSessPersistenceType, sessionPersistenceType, profile)
+	}
+	return nil
+}
+
+// resourceIBMISLBPoolUDPValidate rejects udp pools on load balancers outside of the network
+// family, application load balancers do not support udp.
+func resourceIBMISLBPoolUDPValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	if (diff.Id() != "" && !diff.HasChange(isLBPoolProtocol)) || diff.Get(isLBPoolProtocol).(string) != "udp" {
+		return nil
+	}
+
+	family, _, err := isLBProfileFamily(diff, meta)
+	if err != nil || family == "" {
+		return err
+	}
+	if !strings.EqualFold(family, "network") {
+		return fmt.Errorf("[ERROR] '%s' udp is supported only by load balancers in the network family, the load balancer is in the %s family", isLBPoolProtocol, family)
+	}
+	return nil
+}
+
 func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] LB Pool create")
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -164,6 +165,54 @@ func TestAccIBMISLBPool_basic_udp(t *testing.T) {
 	})
 }
 
+func TestAccIBMISLBPool_udpUnsupported(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbp-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, "round_robin", "http", "5", "2", "2", "http"),
+			},
+			{
+				Config:      testAccCheckIBMISLBPoolConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, "round_robin", "udp", "5", "2", "2", "http"),
+				ExpectError: regexp.MustCompile("udp is supported only by load balancers in the network family"),
+			},
+		},
+	})
+}
+
+func TestAccIBMISLBPool_networkSessionPersistence(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbp-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolNetworkSessionPersistenceConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, "source_ip"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool.testacc_lb_pool", "session_persistence_type", "source_ip"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISLBPoolNetworkSessionPersistenceConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, "http_cookie"),
+				ExpectError: regexp.MustCompile("only source_ip is supported"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISLBPool_port(t *testing.T) {
 	var lb string
 	vpcname := fmt.Sprintf("tflbp-vpc-%d", acctest.RandIntRange(10, 100))
@@ -524,6 +573,38 @@ func testAccCheckIBMISLBPoolUdpConfig(vpcname, subnetname, zone, cidr, name, poo
 
 }
 
+func testAccCheckIBMISLBPoolNetworkSessionPersistenceConfig(vpcname, subnetname, zone, cidr, name, poolName, sessionPersistenceType string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name 			= "%s"
+		vpc 			= "${ibm_is_vpc.testacc_vpc.id}"
+		zone 			= "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name 	= "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+		profile = "network-fixed"
+		type 	= "public"
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name 						= "%s"
+		lb 							= "${ibm_is_lb.testacc_LB.id}"
+		algorithm          			= "round_robin"
+		protocol           			= "tcp"
+		health_delay       			= 5
+		health_retries     			= 2
+		health_timeout     			= 2
+		health_type        			= "tcp"
+		session_persistence_type 	= "%s"
+}`, vpcname, subnetname, zone, cidr, name, poolName, sessionPersistenceType)
+
+}
+
 func testAccCheckIBMISLBPoolPortConfig(vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, port string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -473,16 +473,14 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
-		oldList, newList := d.GetChange(isNetworkACLTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isNetworkACLTags), meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isNetworkACLAccessTags); ok {
-		oldList, newList := d.GetChange(isNetworkACLAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isNetworkACLAccessTags), meta, *nwacl.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource network acl (%s) access tags: %s", d.Id(), err)
@@ -690,13 +688,8 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			return err
 		}
-		//Delete all existing rules
-		err = clearRules(sess, id)
-		if err != nil {
-			return err
-		}
-		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		oldRules, _ := d.GetChange(isNetworkACLRules)
+		err = reconcileInlineRules(sess, id, oldRules.([]interface{}), rules)
 		if err != nil {
 			return err
 		}
@@ -845,112 +838,287 @@ func validateInlineRules(rules []interface{}) error {
 }
 
 func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
-	before := ""
-
 	for i := 0; i <= len(rules)-1; i++ {
 		rulex := rules[i].(map[string]interface{})
+		createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+			NetworkACLID:            &nwaclid,
+			NetworkACLRulePrototype: inlineRulePrototype(rulex, ""),
+		}
+		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+		}
+	}
+	return nil
+}
 
-		name := rulex[isNetworkACLRuleName].(string)
-		source := rulex[isNetworkACLRuleSource].(string)
-		destination := rulex[isNetworkACLRuleDestination].(string)
-		action := rulex[isNetworkACLRuleAction].(string)
-		direction := rulex[isNetworkACLRuleDirection].(string)
-		icmp := rulex[isNetworkACLRuleICMP].([]interface{})
-		tcp := rulex[isNetworkACLRuleTCP].([]interface{})
-		udp := rulex[isNetworkACLRuleUDP].([]interface{})
-		icmptype := int64(-1)
-		icmpcode := int64(-1)
-		minport := int64(-1)
-		maxport := int64(-1)
-		sourceminport := int64(-1)
-		sourcemaxport := int64(-1)
-		protocol := "all"
-
-		ruleTemplate := &vpcv1.NetworkACLRulePrototype{
-			Action:      &action,
-			Destination: &destination,
-			Direction:   &direction,
-			Source:      &source,
-			Name:        &name,
-		}
-
-		if before != "" {
-			ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
-				ID: &before,
-			}
+// inlineRulePrototype builds the prototype of an inline rule, placed before the rule with ID before
+// or at the end of the ACL when before is empty.
+func inlineRulePrototype(rulex map[string]interface{}, before string) *vpcv1.NetworkACLRulePrototype {
+	name := rulex[isNetworkACLRuleName].(string)
+	source := rulex[isNetworkACLRuleSource].(string)
+	destination := rulex[isNetworkACLRuleDestination].(string)
+	action := rulex[isNetworkACLRuleAction].(string)
+	direction := rulex[isNetworkACLRuleDirection].(string)
+	icmp := rulex[isNetworkACLRuleICMP].([]interface{})
+	tcp := rulex[isNetworkACLRuleTCP].([]interface{})
+	udp := rulex[isNetworkACLRuleUDP].([]interface{})
+	icmptype := int64(-1)
+	icmpcode := int64(-1)
+	minport := int64(-1)
+	maxport := int64(-1)
+	sourceminport := int64(-1)
+	sourcemaxport := int64(-1)
+	protocol := "all"
+
+	ruleTemplate := &vpcv1.NetworkACLRulePrototype{
+		Action:      &action,
+		Destination: &destination,
+		Direction:   &direction,
+		Source:      &source,
+		Name:        &name,
+	}
+
+	if before != "" {
+		ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+			ID: &before,
 		}
+	}
 
-		if len(icmp) > 0 {
-			protocol = "icmp"
-			ruleTemplate.Protocol = &protocol
-			if !isNil(icmp[0]) {
-				icmpval := icmp[0].(map[string]interface{})
-				if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
-					icmptype = int64(val.(int))
-					ruleTemplate.Type = &icmptype
-				}
-				if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
-					icmpcode = int64(val.(int))
-					ruleTemplate.Code = &icmpcode
-				}
+	if len(icmp) > 0 {
+		protocol = "icmp"
+		ruleTemplate.Protocol = &protocol
+		if !isNil(icmp[0]) {
+			icmpval := icmp[0].(map[string]interface{})
+			if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
+				icmptype = int64(val.(int))
+				ruleTemplate.Type = &icmptype
 			}
-		} else if len(tcp) > 0 {
-			protocol = "tcp"
-			ruleTemplate.Protocol = &protocol
-			tcpval := tcp[0].(map[string]interface{})
-			if val, ok := tcpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
+			if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
+				icmpcode = int64(val.(int))
+				ruleTemplate.Code = &icmpcode
+			}
+		}
+	} else if len(tcp) > 0 {
+		protocol = "tcp"
+		ruleTemplate.Protocol = &protocol
+		tcpval := tcp[0].(map[string]interface{})
+		if val, ok := tcpval[isNetworkACLRulePortMin]; ok {
+			minport = int64(val.(int))
+			ruleTemplate.DestinationPortMin = &minport
+		}
+		if val, ok := tcpval[isNetworkACLRulePortMax]; ok {
+			maxport = int64(val.(int))
+			ruleTemplate.DestinationPortMax = &maxport
+		}
+		if val, ok := tcpval[isNetworkACLRuleSourcePortMin]; ok {
+			sourceminport = int64(val.(int))
+			ruleTemplate.SourcePortMin = &sourceminport
+		}
+		if val, ok := tcpval[isNetworkACLRuleSourcePortMax]; ok {
+			sourcemaxport = int64(val.(int))
+			ruleTemplate.SourcePortMax = &sourcemaxport
+		}
+	} else if len(udp) > 0 {
+		protocol = "udp"
+		ruleTemplate.Protocol = &protocol
+		udpval := udp[0].(map[string]interface{})
+		if val, ok := udpval[isNetworkACLRulePortMin]; ok {
+			minport = int64(val.(int))
+			ruleTemplate.DestinationPortMin = &minport
+		}
+		if val, ok := udpval[isNetworkACLRulePortMax]; ok {
+			maxport = int64(val.(int))
+			ruleTemplate.DestinationPortMax = &maxport
+		}
+		if val, ok := udpval[isNetworkACLRuleSourcePortMin]; ok {
+			sourceminport = int64(val.(int))
+			ruleTemplate.SourcePortMin = &sourceminport
+		}
+		if val, ok := udpval[isNetworkACLRuleSourcePortMax]; ok {
+			sourcemaxport = int64(val.(int))
+			ruleTemplate.SourcePortMax = &sourcemaxport
+		}
+	}
+	if protocol == "all" {
+		ruleTemplate.Protocol = &protocol
+	}
+	return ruleTemplate
+}
+
+func isNil(i interface{}) bool {
+	return i == nil || reflect.ValueOf(i).IsNil()
+}
+
+// reconcileInlineRules moves the rules of an ACL from oldRules to rules without deleting the rules
+// that are unchanged, so they keep filtering traffic during the update. Unchanged rules are only
+// moved when their relative order changed, new and changed rules are created in their position and
+// removed rules are deleted once the new rules are in place.
+func reconcileInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, oldRules, rules []interface{}) error {
+	oldByKey := map[string][]int{}
+	for i, rule := range oldRules {
+		rulex := rule.(map[string]interface{})
+		if id, ok := rulex[isNetworkACLRuleID].(string); ok && id != "" {
+			key := inlineRuleKey(rulex)
+			oldByKey[key] = append(oldByKey[key], i)
+		}
+	}
+
+	// matched[i] is the index in oldRules of the rule kept for rules[i], or -1 if it has to be created
+	matched := make([]int, len(rules))
+	kept := map[int]bool{}
+	newNames := map[string]bool{}
+	for i, rule := range rules {
+		rulex := rule.(map[string]interface{})
+		matched[i] = -1
+		key := inlineRuleKey(rulex)
+		if idx := oldByKey[key]; len(idx) > 0 {
+			matched[i] = idx[0]
+			oldByKey[key] = idx[1:]
+			kept[idx[0]] = true
+		} else {
+			newNames[rulex[isNetworkACLRuleName].(string)] = true
+		}
+	}
+
+	// Rule names are unique in an ACL, so a changed rule is deleted before its replacement is created.
+	deleteLast := []string{}
+	for i, rule := range oldRules {
+		rulex := rule.(map[string]interface{})
+		id, _ := rulex[isNetworkACLRuleID].(string)
+		if kept[i] || id == "" {
+			continue
+		}
+		if newNames[rulex[isNetworkACLRuleName].(string)] {
+			err := deleteInlineRule(nwaclC, nwaclid, id)
+			if err != nil {
+				return err
 			}
-			if val, ok := tcpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
+		} else {
+			deleteLast = append(deleteLast, id)
+		}
+	}
+
+	// Walk the rules backwards placing each one before the rule that follows it. Kept rules in the
+	// longest run that is already in order are left alone.
+	inOrder := inlineRulesInOrder(matched)
+	next := ""
+	for i := len(rules) - 1; i >= 0; i-- {
+		if matched[i] == -1 {
+			createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+				NetworkACLID:            &nwaclid,
+				NetworkACLRulePrototype: inlineRulePrototype(rules[i].(map[string]interface{}), next),
 			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+			rule, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+			if err != nil || rule == nil {
+				return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
 			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+			next = networkACLRuleID(rule)
+			continue
+		}
+		id := oldRules[matched[i]].(map[string]interface{})[isNetworkACLRuleID].(string)
+		if !inOrder[i] {
+			networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{}
+			if next != "" {
+				networkACLRulePatchModel.Before = &vpcv1.NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID{
+					ID: &next,
+				}
 			}
-		} else if len(udp) > 0 {
-			protocol = "udp"
-			ruleTemplate.Protocol = &protocol
-			udpval := udp[0].(map[string]interface{})
-			if val, ok := udpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
+			networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch : %s", err)
 			}
-			if val, ok := udpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
+			if next == "" {
+				networkACLRulePatch["before"] = nil
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+			updateNetworkACLRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+				NetworkACLID:        &nwaclid,
+				ID:                  &id,
+				NetworkACLRulePatch: networkACLRulePatch,
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+			_, response, err := nwaclC.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error moving network ACL rule (%s) : %s\n%s", id, err, response)
 			}
 		}
-		if protocol == "all" {
-			ruleTemplate.Protocol = &protocol
-		}
+		next = id
+	}
 
-		createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
-			NetworkACLID:            &nwaclid,
-			NetworkACLRulePrototype: ruleTemplate,
-		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+	for _, id := range deleteLast {
+		err := deleteInlineRule(nwaclC, nwaclid, id)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			return err
 		}
 	}
 	return nil
 }
 
-func isNil(i interface{}) bool {
-	return i == nil || reflect.ValueOf(i).IsNil()
+// inlineRulesInOrder returns the positions of the kept rules that form the longest run already in
+// their old relative order, that is the longest increasing subsequence of matched. Every other kept
+// rule has to be moved, so keeping the longest run moves the fewest rules.
+func inlineRulesInOrder(matched []int) map[int]bool {
+	positions := []int{}
+	for i, old := range matched {
+		if old != -1 {
+			positions = append(positions, i)
+		}
+	}
+	length := make([]int, len(positions))
+	prev := make([]int, len(positions))
+	best := -1
+	for j, pj := range positions {
+		length[j], prev[j] = 1, -1
+		for k := 0; k < j; k++ {
+			if matched[positions[k]] < matched[pj] && length[k]+1 > length[j] {
+				length[j], prev[j] = length[k]+1, k
+			}
+		}
+		if best == -1 || length[j] > length[best] {
+			best = j
+		}
+	}
+	inOrder := map[int]bool{}
+	for j := best; j != -1; j = prev[j] {
+		inOrder[positions[j]] = true
+	}
+	return inOrder
+}
+
+// inlineRuleKey identifies an inline rule by its name and everything it matches on, so that rules
+// that did not change between two applies are recognised.
+func inlineRuleKey(rulex map[string]interface{}) string {
+	rule := inlineRulePrototype(rulex, "")
+	key := fmt.Sprintf("%s|%s|%s|%s|%s|%s", *rule.Name, *rule.Action, *rule.Source, *rule.Destination, *rule.Direction, *rule.Protocol)
+	for _, v := range []*int64{rule.Type, rule.Code, rule.DestinationPortMin, rule.DestinationPortMax, rule.SourcePortMin, rule.SourcePortMax} {
+		if v != nil {
+			key = fmt.Sprintf("%s|%d", key, *v)
+		} else {
+			key = key + "|"
+		}
+	}
+	return key
+}
+
+func networkACLRuleID(rule vpcv1.NetworkACLRuleIntf) string {
+	switch rulex := rule.(type) {
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		return *rulex.ID
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		return *rulex.ID
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		return *rulex.ID
+	}
+	return ""
+}
+
+func deleteInlineRule(nwaclC *vpcv1.VpcV1, nwaclid, id string) error {
+	deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+		NetworkACLID: &nwaclid,
+		ID:           &id,
+	}
+	response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+	}
+	return nil
 }
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineRulesInOrder(t *testing.T) {
+	testcases := []struct {
+		name     string
+		matched  []int
+		expected map[int]bool
+	}{
+		{name: "no rules", matched: []int{}, expected: map[int]bool{}},
+		{name: "only new rules", matched: []int{-1, -1}, expected: map[int]bool{}},
+		{name: "unchanged order", matched: []int{0, 1, 2}, expected: map[int]bool{0: true, 1: true, 2: true}},
+		{name: "rule inserted", matched: []int{0, -1, 1}, expected: map[int]bool{0: true, 2: true}},
+		{name: "first rule moved to the end", matched: []int{1, 2, 3, 0}, expected: map[int]bool{0: true, 1: true, 2: true}},
+		{name: "last rule moved to the front", matched: []int{3, 0, 1, 2}, expected: map[int]bool{1: true, 2: true, 3: true}},
+		{name: "reversed", matched: []int{2, 1, 0}, expected: map[int]bool{0: true}},
+		{name: "swapped before a new rule", matched: []int{1, 0, -1}, expected: map[int]bool{0: true}},
+		{name: "longest run in the middle", matched: []int{4, 0, 1, 2, -1}, expected: map[int]bool{1: true, 2: true, 3: true}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, inlineRulesInOrder(tc.matched))
+		})
+	}
+}
+
+// fakeNetworkACL serves the rule calls of a single network ACL and records the calls made.
+type fakeNetworkACL struct {
+	t       *testing.T
+	ids     []string
+	names   map[string]string
+	created int
+	creates []string
+	moves   []string
+	deletes []string
+}
+
+func (acl *fakeNetworkACL) index(id string) int {
+	for i, ruleID := range acl.ids {
+		if ruleID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// insert places the rule id before the rule before, or at the end of the ACL when before is empty.
+func (acl *fakeNetworkACL) insert(id, before string) {
+	if i := acl.index(before); before != "" && i != -1 {
+		acl.ids = append(acl.ids[:i], append([]string{id}, acl.ids[i:]...)...)
+		return
+	}
+	acl.ids = append(acl.ids, id)
+}
+
+func (acl *fakeNetworkACL) remove(id string) {
+	if i := acl.index(id); i != -1 {
+		acl.ids = append(acl.ids[:i], acl.ids[i+1:]...)
+	}
+}
+
+func (acl *fakeNetworkACL) order() []string {
+	names := []string{}
+	for _, id := range acl.ids {
+		names = append(names, acl.names[id])
+	}
+	return names
+}
+
+func (acl *fakeNetworkACL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/network_acls/acl/rules"), "/")
+	body := map[string]interface{}{}
+	if r.Method != http.MethodDelete {
+		assert.NoError(acl.t, json.NewDecoder(r.Body).Decode(&body))
+	}
+	before := ""
+	if b, ok := body["before"].(map[string]interface{}); ok {
+		before = b["id"].(string)
+	}
+	switch r.Method {
+	case http.MethodPost:
+		name := body["name"].(string)
+		for _, existing := range acl.names {
+			if existing == name {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+		}
+		acl.created++
+		id = fmt.Sprintf("new-%d", acl.created)
+		acl.names[id] = name
+		acl.insert(id, before)
+		acl.creates = append(acl.creates, name)
+	case http.MethodPatch:
+		acl.remove(id)
+		acl.insert(id, before)
+		acl.moves = append(acl.moves, acl.names[id])
+	case http.MethodDelete:
+		acl.remove(id)
+		acl.deletes = append(acl.deletes, acl.names[id])
+		delete(acl.names, id)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"id": %q, "name": %q, "protocol": "all"}`, id, acl.names[id])
+}
+
+func TestReconcileInlineRules(t *testing.T) {
+	rule := func(name, source string) map[string]interface{} {
+		return map[string]interface{}{
+			isNetworkACLRuleName:        name,
+			isNetworkACLRuleAction:      "allow",
+			isNetworkACLRuleSource:      source,
+			isNetworkACLRuleDestination: "0.0.0.0/0",
+			isNetworkACLRuleDirection:   "inbound",
+			isNetworkACLRuleICMP:        []interface{}{},
+			isNetworkACLRuleTCP:         []interface{}{},
+			isNetworkACLRuleUDP:         []interface{}{},
+		}
+	}
+	rules := func(names ...string) []interface{} {
+		list := []interface{}{}
+		for _, name := range names {
+			source := "0.0.0.0/0"
+			if strings.HasSuffix(name, "*") {
+				name, source = strings.TrimSuffix(name, "*"), "10.0.0.0/8"
+			}
+			list = append(list, rule(name, source))
+		}
+		return list
+	}
+
+	testcases := []struct {
+		name    string
+		old     []string
+		new     []string
+		creates []string
+		moves   []string
+		deletes []string
+	}{
+		{name: "unchanged", old: []string{"a", "b", "c"}, new: []string{"a", "b", "c"}},
+		{name: "insert", old: []string{"a", "b", "c"}, new: []string{"a", "x", "b", "c"}, creates: []string{"x"}},
+		{name: "append", old: []string{"a", "b"}, new: []string{"a", "b", "x"}, creates: []string{"x"}},
+		{name: "delete", old: []string{"a", "b", "c"}, new: []string{"a", "c"}, deletes: []string{"b"}},
+		{name: "change", old: []string{"a", "b", "c"}, new: []string{"a", "b*", "c"}, creates: []string{"b"}, deletes: []string{"b"}},
+		{name: "move first to the end", old: []string{"a", "b", "c", "d"}, new: []string{"b", "c", "d", "a"}, moves: []string{"a"}},
+		{name: "move last to the front", old: []string{"a", "b", "c"}, new: []string{"c", "a", "b"}, moves: []string{"c"}},
+		{name: "reorder", old: []string{"a", "b", "c"}, new: []string{"c", "b", "a"}, moves: []string{"a", "b"}},
+		{name: "reorder with insert and delete", old: []string{"a", "b", "c", "d"}, new: []string{"c", "x", "a", "d"}, creates: []string{"x"}, moves: []string{"a"}, deletes: []string{"b"}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			acl := &fakeNetworkACL{t: t, names: map[string]string{}}
+			oldRules := rules(tc.old...)
+			for i, r := range oldRules {
+				id := fmt.Sprintf("old-%d", i)
+				r.(map[string]interface{})[isNetworkACLRuleID] = id
+				acl.ids = append(acl.ids, id)
+				acl.names[id] = tc.old[i]
+			}
+			server := httptest.NewServer(acl)
+			defer server.Close()
+			client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			assert.NoError(t, err)
+
+			err = reconcileInlineRules(client, "acl", oldRules, rules(tc.new...))
+			assert.NoError(t, err)
+			expected := []string{}
+			for _, name := range tc.new {
+				expected = append(expected, strings.TrimSuffix(name, "*"))
+			}
+			assert.Equal(t, expected, acl.order())
+			assert.ElementsMatch(t, tc.creates, acl.creates, "created rules")
+			assert.ElementsMatch(t, tc.moves, acl.moves, "moved rules")
+			assert.ElementsMatch(t, tc.deletes, acl.deletes, "deleted rules")
+		})
+	}
+}
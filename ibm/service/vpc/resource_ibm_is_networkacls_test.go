@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -108,6 +109,41 @@ func TestNetworkACLGen2(t *testing.T) {
 	})
 }
 
+func TestNetworkACLRulesReorder(t *testing.T) {
+	var nwACL string
+	name := fmt.Sprintf("tf-nwacl-reorder-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRulesOrderConfig(name, []string{"first", "second", "third"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLExists("ibm_is_network_acl.testacc_nwacl", nwACL),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.0.name", "first"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLRulesOrderConfig(name, []string{"third", "first", "fourth"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.0.name", "third"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.1.name", "first"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.testacc_nwacl", "rules.2.name", "fourth"),
+				),
+			},
+		},
+	})
+}
+
 func checkNetworkACLDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -280,3 +316,32 @@ func testAccCheckIBMISNetworkACLResourceGroupConfig(resourceGroupSelect bool) st
 	  }
 	`, acc.ISZoneName, resourceGroupSelect, acc.IsResourceGroupID)
 }
+
+func testAccCheckIBMISNetworkACLRulesOrderConfig(name string, rules []string) string {
+	config := fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s-vpc"
+	}
+
+	resource "ibm_is_network_acl" "testacc_nwacl" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	`, name, name)
+	for _, rule := range rules {
+		config += fmt.Sprintf(`
+		rules {
+			name        = "%s"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		`, rule)
+	}
+	return config + `
+	}`
+}
@@ -194,8 +194,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
 	if _, ok := d.GetOk(isPlacementGroupTags); ok {
-		oldList, newList := d.GetChange(isPlacementGroupTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isPlacementGroupTags), meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) tags: %s", d.Id(), err)
@@ -204,8 +203,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	}
 
 	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
-		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isPlacementGroupAccessTags), meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) access tags: %s", d.Id(), err)
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// API version used for VPC operations that are not available in the vpcv1 client yet.
+const vpcRawRequestVersion = "2024-11-12"
+
+// vpcRawRequest issues a request against the VPC API through the service of the
+// given vpcv1 client, so authentication, endpoints and retries are shared with it.
+func vpcRawRequest(ctx context.Context, sess *vpcv1.VpcV1, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	return vpcRawRequestWithQuery(ctx, sess, method, path, pathParams, nil, body, result)
+}
+
+// vpcRawRequestWithQuery is vpcRawRequest with additional query parameters.
+func vpcRawRequestWithQuery(ctx context.Context, sess *vpcv1.VpcV1, method, path string, pathParams, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(sess.Service.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", vpcRawRequestVersion)
+	builder.AddQuery("generation", "2")
+	for key, value := range query {
+		builder.AddQuery(key, value)
+	}
+	if body != nil {
+		contentType := "application/json"
+		if method == core.PATCH {
+			contentType = "application/merge-patch+json"
+		}
+		builder.AddHeader("Content-Type", contentType)
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return sess.Service.Request(request, result)
+}
+
+// vpcReference is the generic reference model returned by the VPC API.
+type vpcReference struct {
+	ID   string `json:"id,omitempty"`
+	CRN  string `json:"crn,omitempty"`
+	Href string `json:"href,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// PrivatePathServiceGateway is the private path service gateway model of the VPC API.
+type PrivatePathServiceGateway struct {
+	ID                   string        `json:"id"`
+	CRN                  string        `json:"crn"`
+	Href                 string        `json:"href"`
+	Name                 string        `json:"name"`
+	CreatedAt            string        `json:"created_at"`
+	DefaultAccessPolicy  string        `json:"default_access_policy"`
+	EndpointGatewayCount int64         `json:"endpoint_gateway_count"`
+	LifecycleState       string        `json:"lifecycle_state"`
+	LoadBalancer         *vpcReference `json:"load_balancer"`
+	Published            bool          `json:"published"`
+	ResourceGroup        *vpcReference `json:"resource_group"`
+	ResourceType         string        `json:"resource_type"`
+	ServiceEndpoints     []string      `json:"service_endpoints"`
+	VPC                  *vpcReference `json:"vpc"`
+	ZonalAffinity        bool          `json:"zonal_affinity"`
+}
+
+func ResourceIBMIsPrivatePathServiceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway", "name"),
+				Description:  "The name for this private path service gateway. The name must not be used by another private path service gateway in the VPC.",
+			},
+			"load_balancer": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the load balancer for this private path service gateway. The load balancer must have `network-private-path` as its profile.",
+			},
+			"service_endpoints": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The fully qualified domain names for this private path service gateway.",
+			},
+			"default_access_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "deny",
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway", "default_access_policy"),
+				Description:  "The policy to use for bindings from accounts without an explicit account policy.",
+			},
+			"zonal_affinity": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether this private path service gateway has zonal affinity.",
+			},
+			"resource_group": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group for this private path service gateway.",
+			},
+			"published": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates the availability of this private path service gateway. Use `ibm_is_private_path_service_gateway_operations` to publish or unpublish it.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this private path service gateway.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this private path service gateway.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the private path service gateway was created.",
+			},
+			"endpoint_gateway_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of endpoint gateways using this private path service gateway.",
+			},
+			"lifecycle_state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the private path service gateway.",
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"vpc": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VPC this private path service gateway resides in.",
+			},
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this private path service gateway.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "default_access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "deny, permit, review",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype := map[string]interface{}{
+		"load_balancer": map[string]interface{}{
+			"id": d.Get("load_balancer").(string),
+		},
+		"service_endpoints":     flex.ExpandStringList(d.Get("service_endpoints").(*schema.Set).List()),
+		"default_access_policy": d.Get("default_access_policy").(string),
+		"zonal_affinity":        d.Get("zonal_affinity").(bool),
+	}
+	if name, ok := d.GetOk("name"); ok {
+		prototype["name"] = name.(string)
+	}
+	if rg, ok := d.GetOk("resource_group"); ok {
+		prototype["resource_group"] = map[string]interface{}{
+			"id": rg.(string),
+		}
+	}
+
+	ppsg := &PrivatePathServiceGateway{}
+	response, err := vpcRawRequest(context, vpcClient, core.POST, "/private_path_service_gateways", nil, prototype, ppsg)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(ppsg.ID)
+
+	_, err = isWaitForPrivatePathServiceGatewayAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayRead(context, d, meta)
+}
+
+func isWaitForPrivatePathServiceGatewayAvailable(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for private path service gateway (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "updating"},
+		Target:     []string{"stable", "failed"},
+		Refresh:    isPrivatePathServiceGatewayRefreshFunc(context, sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isPrivatePathServiceGatewayRefreshFunc(context context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ppsg := &PrivatePathServiceGateway{}
+		response, err := vpcRawRequest(context, sess, core.GET, "/private_path_service_gateways/{id}", map[string]string{"id": id}, nil, ppsg)
+		if err != nil {
+			return nil, "failed", fmt.Errorf("[ERROR] Error getting private path service gateway : %s\n%s", err, response)
+		}
+
+		if ppsg.LifecycleState == "failed" {
+			return ppsg, ppsg.LifecycleState, fmt.Errorf("[ERROR] Private path service gateway (%s) went into failed state during the operation", id)
+		}
+		return ppsg, ppsg.LifecycleState, nil
+	}
+}
+
+func resourceIBMIsPrivatePathServiceGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsg := &PrivatePathServiceGateway{}
+	response, err := vpcRawRequest(context, vpcClient, core.GET, "/private_path_service_gateways/{id}", map[string]string{"id": d.Id()}, nil, ppsg)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", ppsg.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if ppsg.LoadBalancer != nil {
+		if err = d.Set("load_balancer", ppsg.LoadBalancer.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting load_balancer: %s", err))
+		}
+	}
+	if err = d.Set("service_endpoints", ppsg.ServiceEndpoints); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_endpoints: %s", err))
+	}
+	if err = d.Set("default_access_policy", ppsg.DefaultAccessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting default_access_policy: %s", err))
+	}
+	if err = d.Set("zonal_affinity", ppsg.ZonalAffinity); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting zonal_affinity: %s", err))
+	}
+	if ppsg.ResourceGroup != nil {
+		if err = d.Set("resource_group", ppsg.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+		}
+	}
+	if ppsg.VPC != nil {
+		if err = d.Set("vpc", ppsg.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc: %s", err))
+		}
+	}
+	if err = d.Set("published", ppsg.Published); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting published: %s", err))
+	}
+	if err = d.Set("crn", ppsg.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("href", ppsg.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("created_at", ppsg.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_count", ppsg.EndpointGatewayCount); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoint_gateway_count: %s", err))
+	}
+	if err = d.Set("lifecycle_state", ppsg.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", ppsg.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if err = d.Set("private_path_service_gateway", ppsg.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_path_service_gateway: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange("name") {
+		patch["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("service_endpoints") {
+		patch["service_endpoints"] = flex.ExpandStringList(d.Get("service_endpoints").(*schema.Set).List())
+	}
+	if d.HasChange("default_access_policy") {
+		patch["default_access_policy"] = d.Get("default_access_policy").(string)
+	}
+	if d.HasChange("zonal_affinity") {
+		patch["zonal_affinity"] = d.Get("zonal_affinity").(bool)
+	}
+
+	if len(patch) > 0 {
+		response, err := vpcRawRequest(context, vpcClient, core.PATCH, "/private_path_service_gateways/{id}", map[string]string{"id": d.Id()}, patch, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+		}
+		_, err = isWaitForPrivatePathServiceGatewayAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRawRequest(context, vpcClient, core.DELETE, "/private_path_service_gateways/{id}", map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeletePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForPrivatePathServiceGatewayDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func isWaitForPrivatePathServiceGatewayDeleted(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for private path service gateway (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "updating"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			ppsg := &PrivatePathServiceGateway{}
+			response, err := vpcRawRequest(context, sess, core.GET, "/private_path_service_gateways/{id}", map[string]string{"id": id}, nil, ppsg)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return ppsg, "deleted", nil
+				}
+				return nil, "failed", fmt.Errorf("[ERROR] The private path service gateway %s failed to delete: %s\n%s", id, err, response)
+			}
+			return ppsg, ppsg.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
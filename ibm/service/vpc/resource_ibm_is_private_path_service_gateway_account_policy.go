@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PrivatePathServiceGatewayAccountPolicy is the account policy model of the VPC API.
+type PrivatePathServiceGatewayAccountPolicy struct {
+	ID           string        `json:"id"`
+	Href         string        `json:"href"`
+	AccessPolicy string        `json:"access_policy"`
+	Account      *vpcReference `json:"account"`
+	CreatedAt    string        `json:"created_at"`
+	ResourceType string        `json:"resource_type"`
+	UpdatedAt    string        `json:"updated_at"`
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayAccountPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"account": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the account for this access policy.",
+			},
+			"access_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway_account_policy", "access_policy"),
+				Description:  "The access policy for the account. When `permit` or `deny`, pending endpoint gateway bindings from the account are permitted or denied accordingly.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the account policy was created.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the account policy was updated.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this account policy.",
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"account_policy": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this account policy.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayAccountPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "deny, permit, review",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway_account_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsgID := d.Get("private_path_service_gateway").(string)
+	prototype := map[string]interface{}{
+		"access_policy": d.Get("access_policy").(string),
+		"account": map[string]interface{}{
+			"id": d.Get("account").(string),
+		},
+	}
+
+	policy := &PrivatePathServiceGatewayAccountPolicy{}
+	response, err := vpcRawRequest(context, vpcClient, core.POST, "/private_path_service_gateways/{id}/account_policies", map[string]string{"id": ppsgID}, prototype, policy)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", ppsgID, policy.ID))
+
+	return resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &PrivatePathServiceGatewayAccountPolicy{}
+	pathParams := map[string]string{"private_path_service_gateway_id": parts[0], "id": parts[1]}
+	response, err := vpcRawRequest(context, vpcClient, core.GET, "/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}", pathParams, nil, policy)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("private_path_service_gateway", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_path_service_gateway: %s", err))
+	}
+	if policy.Account != nil {
+		if err = d.Set("account", policy.Account.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting account: %s", err))
+		}
+	}
+	if err = d.Set("access_policy", policy.AccessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting access_policy: %s", err))
+	}
+	if err = d.Set("created_at", policy.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", policy.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+	if err = d.Set("href", policy.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("resource_type", policy.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if err = d.Set("account_policy", policy.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_policy: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("access_policy") {
+		patch := map[string]interface{}{
+			"access_policy": d.Get("access_policy").(string),
+		}
+		pathParams := map[string]string{"private_path_service_gateway_id": parts[0], "id": parts[1]}
+		response, err := vpcRawRequest(context, vpcClient, core.PATCH, "/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}", pathParams, patch, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParams := map[string]string{"private_path_service_gateway_id": parts[0], "id": parts[1]}
+	response, err := vpcRawRequest(context, vpcClient, core.DELETE, "/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}", pathParams, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeletePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
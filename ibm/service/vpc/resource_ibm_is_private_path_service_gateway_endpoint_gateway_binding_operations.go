@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PrivatePathServiceGatewayEndpointGatewayBinding is the endpoint gateway binding model of the VPC API.
+type PrivatePathServiceGatewayEndpointGatewayBinding struct {
+	ID             string        `json:"id"`
+	Href           string        `json:"href"`
+	Account        *vpcReference `json:"account"`
+	CreatedAt      string        `json:"created_at"`
+	Expiration     string        `json:"expiration_at"`
+	LifecycleState string        `json:"lifecycle_state"`
+	ResourceType   string        `json:"resource_type"`
+	Status         string        `json:"status"`
+	UpdatedAt      string        `json:"updated_at"`
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsRead,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"endpoint_gateway_binding": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The endpoint gateway binding identifier.",
+			},
+			"access_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway_endpoint_gateway_binding_operations", "access_policy"),
+				Description:  "Permit or deny the pending endpoint gateway binding.",
+			},
+			"set_account_policy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Indicates whether this will become the access policy for any pending and future endpoint gateway bindings from the same account.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the endpoint gateway binding.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "deny, permit",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway_endpoint_gateway_binding_operations", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsgID := d.Get("private_path_service_gateway").(string)
+	bindingID := d.Get("endpoint_gateway_binding").(string)
+	accessPolicy := d.Get("access_policy").(string)
+
+	body := map[string]interface{}{
+		"set_account_policy": d.Get("set_account_policy").(bool),
+	}
+	pathParams := map[string]string{"private_path_service_gateway_id": ppsgID, "id": bindingID}
+	response, err := vpcRawRequest(context, vpcClient, core.POST, "/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings/{id}/"+accessPolicy, pathParams, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] %s endpoint gateway binding %s failed %s\n%s", accessPolicy, bindingID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] %s endpoint gateway binding %s failed %s\n%s", accessPolicy, bindingID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", ppsgID, bindingID))
+
+	return resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	binding := &PrivatePathServiceGatewayEndpointGatewayBinding{}
+	pathParams := map[string]string{"private_path_service_gateway_id": parts[0], "id": parts[1]}
+	response, err := vpcRawRequest(context, vpcClient, core.GET, "/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings/{id}", pathParams, nil, binding)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayEndpointGatewayBindingWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayEndpointGatewayBindingWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("private_path_service_gateway", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_path_service_gateway: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_binding", binding.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoint_gateway_binding: %s", err))
+	}
+	if err = d.Set("status", binding.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+// Removing the operations resource leaves the endpoint gateway binding in its current state.
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
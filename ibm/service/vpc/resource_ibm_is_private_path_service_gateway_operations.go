@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsPrivatePathServiceGatewayOperations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayOperationsCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayOperationsRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayOperationsUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayOperationsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"published": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Publish or unpublish the private path service gateway. Publishing allows any account to request access to it.",
+			},
+		},
+	}
+}
+
+func resourceIBMIsPrivatePathServiceGatewayOperationsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsgID := d.Get("private_path_service_gateway").(string)
+	if err = isPrivatePathServiceGatewaySetPublished(context, vpcClient, ppsgID, d.Get("published").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ppsgID)
+
+	return resourceIBMIsPrivatePathServiceGatewayOperationsRead(context, d, meta)
+}
+
+func isPrivatePathServiceGatewaySetPublished(context context.Context, sess *vpcv1.VpcV1, id string, published bool) error {
+	path := "/private_path_service_gateways/{id}/unpublish"
+	if published {
+		path = "/private_path_service_gateways/{id}/publish"
+	}
+	response, err := vpcRawRequest(context, sess, core.POST, path, map[string]string{"id": id}, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Publishing (%t) private path service gateway %s failed %s\n%s", published, id, err, response)
+		return fmt.Errorf("[ERROR] Publishing (%t) private path service gateway %s failed %s\n%s", published, id, err, response)
+	}
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ppsg := &PrivatePathServiceGateway{}
+	response, err := vpcRawRequest(context, vpcClient, core.GET, "/private_path_service_gateways/{id}", map[string]string{"id": d.Id()}, nil, ppsg)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("private_path_service_gateway", ppsg.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_path_service_gateway: %s", err))
+	}
+	if err = d.Set("published", ppsg.Published); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting published: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayOperationsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("published") {
+		if err = isPrivatePathServiceGatewaySetPublished(context, vpcClient, d.Id(), d.Get("published").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayOperationsRead(context, d, meta)
+}
+
+// Removing the operations resource leaves the private path service gateway in its current state.
+func resourceIBMIsPrivatePathServiceGatewayOperationsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
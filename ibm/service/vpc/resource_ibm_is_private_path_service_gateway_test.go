@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAccIBMIsPrivatePathServiceGatewayBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ppsg-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-ppsg-update-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsPrivatePathServiceGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb.testacc_lb", "is_private_path", "true"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.testacc_ppsg", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.testacc_ppsg", "default_access_policy", "deny"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.testacc_ppsg", "published", "false"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway.testacc_ppsg", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway.testacc_ppsg", "vpc"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway.testacc_ppsg", "lifecycle_state"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, nameUpdate, "review"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.testacc_ppsg", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.testacc_ppsg", "default_access_policy", "review"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsPrivatePathServiceGatewayPublish(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ppsg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsPrivatePathServiceGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayPublishConfig(vpcname, subnetname, lbname, name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_operations.testacc_ppsg_ops", "published", "true"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_account_policy.testacc_ppsg_policy", "access_policy", "permit"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway_account_policy.testacc_ppsg_policy", "account_policy"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_private_path_service_gateway_endpoint_gateway_bindings.testacc_bindings", "endpoint_gateway_bindings.#"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayPublishConfig(vpcname, subnetname, lbname, name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_operations.testacc_ppsg_ops", "published", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, defaultAccessPolicy string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_lb" "testacc_lb" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+		profile = "network-private-path"
+		type    = "private"
+	}
+
+	resource "ibm_is_private_path_service_gateway" "testacc_ppsg" {
+		name                  = "%s"
+		load_balancer         = ibm_is_lb.testacc_lb.id
+		default_access_policy = "%s"
+		service_endpoints     = ["myexample.com"]
+		zonal_affinity        = false
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, name, defaultAccessPolicy)
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayPublishConfig(vpcname, subnetname, lbname, name string, published bool) string {
+	return testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, "review") + fmt.Sprintf(`
+	data "ibm_iam_account_settings" "testacc_account" {
+	}
+
+	resource "ibm_is_private_path_service_gateway_operations" "testacc_ppsg_ops" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.testacc_ppsg.id
+		published                    = %t
+	}
+
+	resource "ibm_is_private_path_service_gateway_account_policy" "testacc_ppsg_policy" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.testacc_ppsg.id
+		account                      = data.ibm_iam_account_settings.testacc_account.account_id
+		access_policy                = "permit"
+	}
+
+	data "ibm_is_private_path_service_gateway_endpoint_gateway_bindings" "testacc_bindings" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.testacc_ppsg.id
+	}
+	`, published)
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_private_path_service_gateway" {
+			continue
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		builder := core.NewRequestBuilder(core.GET)
+		_, err = builder.ResolveRequestURL(vpcClient.Service.GetServiceURL(), "/private_path_service_gateways/{id}", map[string]string{"id": rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		builder.AddQuery("version", "2024-11-12")
+		builder.AddQuery("generation", "2")
+		request, err := builder.Build()
+		if err != nil {
+			return err
+		}
+
+		response, err := vpcClient.Service.Request(request, nil)
+		if err == nil {
+			return fmt.Errorf("is_private_path_service_gateway still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for is_private_path_service_gateway (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
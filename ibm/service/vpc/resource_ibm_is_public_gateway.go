@@ -77,6 +77,8 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
+				ValidateFunc:     validatePublicGatewayFloatingIP,
+				Description:      "The floating IP of the public gateway, an existing unbound floating IP of the zone is reused when its id or address is set, otherwise a new one is allocated",
 			},
 
 			isPublicGatewayStatus: {
@@ -234,6 +236,11 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		}
 		options.FloatingIP = fip
 	}
+	if floatingipID != "" {
+		if err = checkPublicGatewayFloatingIPReusable(sess, floatingipID, zone); err != nil {
+			return err
+		}
+	}
 	if grp, ok := d.GetOk(isPublicGatewayResourceGroup); ok {
 		rg := grp.(string)
 		options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
@@ -255,8 +262,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isPublicGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isPublicGatewayTags), meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
@@ -264,8 +270,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, ok := d.GetOk(isPublicGatewayAccessTags); ok {
-		oldList, newList := d.GetChange(isPublicGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isPublicGatewayAccessTags), meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) access tags: %s", d.Id(), err)
@@ -275,6 +280,42 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	return resourceIBMISPublicGatewayRead(d, meta)
 }
 
+// validatePublicGatewayFloatingIP checks that floating_ip identifies the floating IP to reuse either by its id or by
+// its address.
+func validatePublicGatewayFloatingIP(v interface{}, k string) (ws []string, errors []error) {
+	floatingIP := v.(map[string]interface{})
+	for key := range floatingIP {
+		if key != "id" && key != isPublicGatewayFloatingIPAddress {
+			errors = append(errors, fmt.Errorf("%q has an unsupported key %q, supported keys are id and %s", k, key, isPublicGatewayFloatingIPAddress))
+		}
+	}
+	if id, ok := floatingIP["id"]; ok && id != "" {
+		if address, ok := floatingIP[isPublicGatewayFloatingIPAddress]; ok && address != "" {
+			errors = append(errors, fmt.Errorf("%q can set only one of id and %s", k, isPublicGatewayFloatingIPAddress))
+		}
+	}
+	return
+}
+
+// checkPublicGatewayFloatingIPReusable fails early with a clear error when the floating IP to reuse is bound to a
+// target or is in another zone than the public gateway.
+func checkPublicGatewayFloatingIPReusable(sess *vpcv1.VpcV1, id, zone string) error {
+	getFloatingIPOptions := &vpcv1.GetFloatingIPOptions{
+		ID: &id,
+	}
+	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the floating IP (%s) of the Public Gateway: %s\n%s", id, err, response)
+	}
+	if floatingip.Target != nil {
+		return fmt.Errorf("[ERROR] The floating IP (%s) is bound to a target, unbind it before using it for a Public Gateway", id)
+	}
+	if floatingip.Zone != nil && floatingip.Zone.Name != nil && *floatingip.Zone.Name != zone {
+		return fmt.Errorf("[ERROR] The floating IP (%s) is in zone %s, the Public Gateway is in zone %s", id, *floatingip.Zone.Name, zone)
+	}
+	return nil
+}
+
 func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -90,6 +91,21 @@ func TestAccIBMISPublicGateway_floatingip(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISPublicGateway_floatingipInvalid(t *testing.T) {
+	vpcname := fmt.Sprintf("tfpgw-vpc-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tfpgw-pg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISPublicGatewayFloatingIpInvalidConfig(vpcname, name1),
+				ExpectError: regexp.MustCompile("can set only one of id and address"),
+			},
+		},
+	})
+}
 func TestAccIBMISPublicGateway_resource_group_change(t *testing.T) {
 	var publicgw string
 	vpcname := fmt.Sprintf("tfpgw-vpc-%d", acctest.RandIntRange(10, 100))
@@ -228,3 +244,20 @@ func testAccCheckIBMISPublicGatewayRgChangeConfig(vpcname, subnetname, name stri
 		`, vpcname, subnetname, acc.ISZoneName, name, flag, acc.IsResourceGroupID, acc.ISZoneName)
 
 }
+
+func testAccCheckIBMISPublicGatewayFloatingIpInvalidConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+
+		resource "ibm_is_public_gateway" "testacc_public_gateway" {
+			name = "%s"
+			vpc  = ibm_is_vpc.testacc_vpc.id
+			zone = "%s"
+			floating_ip = {
+				id      = "r006-00000000-0000-0000-0000-000000000000"
+				address = "169.0.0.1"
+			}
+		}`, vpcname, name, acc.ISZoneName)
+}
@@ -6,12 +6,14 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -52,6 +54,16 @@ const (
 	isReservationZoneHref                 = "href"
 	isReservationZoneName                 = "name"
 	isReservationStatus                   = "status"
+
+	isReservationLifecycleStable   = "stable"
+	isReservationLifecycleFailed   = "failed"
+	isReservationLifecyclePending  = "pending"
+	isReservationLifecycleUpdating = "updating"
+	isReservationLifecycleDeleting = "deleting"
+	isReservationDeleteDone        = "done"
+	isReservationStatusActivating  = "activating"
+	isReservationStatusActive      = "active"
+	isReservationStatusFailed      = "failed"
 )
 
 func ResourceIBMISReservation() *schema.Resource {
@@ -62,6 +74,12 @@ func ResourceIBMISReservation() *schema.Resource {
 		Delete:   resourceIBMISReservationDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			isReservationAffinityPolicy: &schema.Schema{
 				Type:         schema.TypeString,
@@ -386,6 +404,9 @@ func resourceIBMISReservationCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
 
 	reservation, response, err := sess.CreateReservation(createReservationOptions)
 	if err != nil {
@@ -395,6 +416,11 @@ func resourceIBMISReservationCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(*reservation.ID)
 	log.Printf("[INFO] Reservation : %s", *reservation.ID)
 
+	_, err = isWaitForReservationStable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
 	return resourceIBMISReservationRead(d, meta)
 }
 
@@ -593,6 +619,7 @@ func resourceIBMISReservationUpdate(d *schema.ResourceData, meta interface{}) er
 				reservationPatchModel.Capacity = &vpcv1.ReservationCapacityPatch{
 					Total: core.Int64Ptr(int64(totalIntf.(int))),
 				}
+				hasChanged = true
 			}
 		}
 	}
@@ -613,6 +640,7 @@ func resourceIBMISReservationUpdate(d *schema.ResourceData, meta interface{}) er
 			}
 		}
 		reservationPatchModel.CommittedUse = cuPatch
+		hasChanged = true
 	}
 	if d.HasChange(isReservationProfile) {
 		profileIntf := d.Get(isReservationProfile)
@@ -633,6 +661,7 @@ func resourceIBMISReservationUpdate(d *schema.ResourceData, meta interface{}) er
 			}
 		}
 		reservationPatchModel.Profile = profPatch
+		hasChanged = true
 	}
 	if hasChanged {
 		reservationPatch, err := reservationPatchModel.AsPatch()
@@ -646,6 +675,10 @@ func resourceIBMISReservationUpdate(d *schema.ResourceData, meta interface{}) er
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Reservation : %s\n%s", err, response)
 		}
+		_, err = isWaitForReservationStable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
 	}
 	return resourceIBMISReservationRead(d, meta)
 }
@@ -660,10 +693,122 @@ func resourceIBMISReservationDelete(d *schema.ResourceData, meta interface{}) er
 	deleteReservationOptions := &vpcv1.DeleteReservationOptions{
 		ID: &id,
 	}
-	_, _, err = sess.DeleteReservation(deleteReservationOptions)
+	_, response, err := sess.DeleteReservation(deleteReservationOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Reservation : %s", err)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Deleting Reservation : %s\n%s", err, response)
+	}
+	_, err = isWaitForReservationDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
 	}
 	d.SetId("")
 	return nil
 }
+
+func isWaitForReservationStable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Reservation (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isReservationLifecyclePending, isReservationLifecycleUpdating},
+		Target:     []string{isReservationLifecycleStable},
+		Refresh:    isReservationRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isReservationRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getReservationOptions := &vpcv1.GetReservationOptions{
+			ID: &id,
+		}
+		reservation, response, err := sess.GetReservation(getReservationOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting Reservation (%s): %s\n%s", id, err, response)
+		}
+		if *reservation.LifecycleState == isReservationLifecycleFailed {
+			return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] Reservation (%s) went into failed state: %s", id, flattenReservationStatusReasons(reservation.StatusReasons))
+		}
+		return reservation, *reservation.LifecycleState, nil
+	}
+}
+
+func isWaitForReservationDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Reservation (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isReservationLifecycleDeleting, isReservationLifecycleStable},
+		Target:     []string{isReservationDeleteDone, ""},
+		Refresh:    isReservationDeleteRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isReservationDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getReservationOptions := &vpcv1.GetReservationOptions{
+			ID: &id,
+		}
+		reservation, response, err := sess.GetReservation(getReservationOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return reservation, isReservationDeleteDone, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting Reservation (%s): %s\n%s", id, err, response)
+		}
+		if *reservation.LifecycleState == isReservationLifecycleFailed {
+			return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] Reservation (%s) failed to delete: %s", id, flattenReservationStatusReasons(reservation.StatusReasons))
+		}
+		return reservation, *reservation.LifecycleState, nil
+	}
+}
+
+// isWaitForReservationActive waits for an activated reservation to leave the activating status.
+func isWaitForReservationActive(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Reservation (%s) to be active.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isReservationStatusActivating},
+		Target:  []string{isReservationStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			getReservationOptions := &vpcv1.GetReservationOptions{
+				ID: &id,
+			}
+			reservation, response, err := sess.GetReservation(getReservationOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting Reservation (%s): %s\n%s", id, err, response)
+			}
+			if *reservation.Status == isReservationStatusFailed {
+				return reservation, *reservation.Status, fmt.Errorf("[ERROR] Reservation (%s) failed to activate: %s", id, flattenReservationStatusReasons(reservation.StatusReasons))
+			}
+			return reservation, *reservation.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func flattenReservationStatusReasons(statusReasons []vpcv1.ReservationStatusReason) string {
+	reasons := ""
+	for _, reason := range statusReasons {
+		if reasons != "" {
+			reasons += ", "
+		}
+		reasons += fmt.Sprintf("%s: %s", flex.StringValue(reason.Code), flex.StringValue(reason.Message))
+	}
+	return reasons
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"runtime/debug"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 
@@ -22,6 +23,10 @@ func ResourceIBMISReservationActivate() *schema.Resource {
 		Delete:   resourceIBMISReservationActivateDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			isReservation: &schema.Schema{
 				Type:        schema.TypeString,
@@ -236,6 +241,11 @@ func resourceIBMISReservationActivateCreate(d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Reservation activated: %s", id)
 	d.SetId(id)
 
+	_, err = isWaitForReservationActive(sess, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
 	return resourceIBMISReservationActivateRead(d, meta)
 }
 
@@ -207,16 +207,14 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
-		oldList, newList := d.GetChange(isSecurityGroupTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isSecurityGroupTags), meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
 	if _, ok := d.GetOk(isSecurityGroupAccessTags); ok {
-		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isSecurityGroupAccessTags), meta, *sg.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of Security Group (%s) access tags: %s", d.Id(), err)
@@ -232,10 +230,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	id := d.Id()
 
-	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
-		ID: &id,
-	}
-	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	group, descriptions, response, err := isSecurityGroupGet(context.Background(), sess, id)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -273,6 +268,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 						r[isSecurityGroupRuleType] = int(*rule.Type)
 					}
 					r[isSecurityGroupRuleDirection] = *rule.Direction
+					r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
 					if rule.Protocol != nil {
 						r[isSecurityGroupRuleProtocol] = *rule.Protocol
@@ -306,6 +302,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
 					r := make(map[string]interface{})
 					r[isSecurityGroupRuleDirection] = *rule.Direction
+					r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
 					if rule.Protocol != nil {
 						r[isSecurityGroupRuleProtocol] = *rule.Protocol
@@ -345,6 +342,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 						r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
 					}
 					r[isSecurityGroupRuleDirection] = *rule.Direction
+					r[isSecurityGroupRuleDescription] = descriptions[*rule.ID]
 					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
 					if rule.Protocol != nil {
 						r[isSecurityGroupRuleProtocol] = *rule.Protocol
@@ -584,6 +582,12 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 			Description: "Security group local ip: an IP address, a CIDR block",
 		},
 
+		isSecurityGroupRuleDescription: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The description of the security group rule",
+		},
+
 		isSecurityGroupRuleType: {
 			Type:     schema.TypeInt,
 			Computed: true,
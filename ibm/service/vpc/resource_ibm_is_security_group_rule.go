@@ -4,6 +4,8 @@
 package vpc
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,6 +31,7 @@ const (
 	isSecurityGroupRuleProtocol         = "protocol"
 	isSecurityGroupRuleRemote           = "remote"
 	isSecurityGroupRuleLocal            = "local"
+	isSecurityGroupRuleDescription      = "description"
 	isSecurityGroupRuleType             = "type"
 	isSecurityGroupID                   = "group"
 	isSecurityGroupRuleID               = "rule_id"
@@ -77,14 +81,23 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Security group local ip: an IP address, a CIDR block",
+				Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
 			},
 
 			isSecurityGroupRuleLocal: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSecurityGroupRuleLocal,
+				Description:  "Security group local ip: an IP address, a CIDR block",
+			},
+
+			isSecurityGroupRuleDescription: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDescription),
+				DiffSuppressFunc: securityGroupRuleDescriptionDiffSuppress,
+				Description:      "The description of the security group rule, explaining why the rule exists",
 			},
 
 			isSecurityGroupRuleProtocolICMP: {
@@ -231,6 +244,15 @@ func ResourceIBMISSecurityGroupRuleValidator() *validate.ResourceValidator {
 			MinValue:                   "1",
 			MaxValue:                   "65535"})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isSecurityGroupRuleDescription,
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			MinValueLength:             0,
+			MaxValueLength:             250})
+
 	ibmISSecurityGroupRuleResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group_rule", Schema: validateSchema}
 	return &ibmISSecurityGroupRuleResourceValidator
 }
@@ -281,6 +303,12 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 			d.SetId(tfID)
 		}
 	}
+	if description, ok := d.GetOk(isSecurityGroupRuleDescription); ok {
+		err = isSecurityGroupRuleUpdateDescription(sess, parsed.secgrpID, d.Get(isSecurityGroupRuleID).(string), description.(string))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISSecurityGroupRuleRead(d, meta)
 }
 
@@ -294,11 +322,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
-		SecurityGroupID: &secgrpID,
-		ID:              &ruleID,
-	}
-	sgrule, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	sgrule, description, response, err := isSecurityGroupRuleGet(context.Background(), sess, secgrpID, ruleID)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -315,6 +339,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
+	d.Set(isSecurityGroupRuleDescription, description)
 	switch reflect.TypeOf(sgrule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
 		{
@@ -457,6 +482,9 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
 
 	updateSecurityGroupRuleOptions := sgTemplate
+	if d.HasChange(isSecurityGroupRuleDescription) {
+		updateSecurityGroupRuleOptions.SecurityGroupRulePatch[isSecurityGroupRuleDescription] = strings.TrimSpace(d.Get(isSecurityGroupRuleDescription).(string))
+	}
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
@@ -583,6 +611,16 @@ func inferLocalSecurityGroup(s string) (address, cidr string, err error) {
 		cidr = s
 		return
 	}
+	err = fmt.Errorf("[ERROR] Invalid security group rule local %q, it must be an IP address or a CIDR block", s)
+	return
+}
+
+// validateSecurityGroupRuleLocal rejects at plan time the local values that are neither an IP address nor
+// a CIDR block. They used to be left out of the request, and the rule then showed 0.0.0.0/0 as a diff.
+func validateSecurityGroupRuleLocal(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := inferLocalSecurityGroup(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IP address or a CIDR block, got %q", k, v.(string)))
+	}
 	return
 }
 
@@ -774,3 +812,148 @@ func makeTerraformRuleID(id1, id2 string) string {
 	// we can extract the group id as needed for API calls such as READ.
 	return id1 + "." + id2
 }
+
+// securityGroupRuleDescription holds the description of a security group rule, which the
+// vpcv1 security group rule models do not carry yet.
+type securityGroupRuleDescription struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// securityGroupRuleDescriptionDiffSuppress ignores leading and trailing whitespace in rule descriptions.
+func securityGroupRuleDescriptionDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// securityGroupRuleDescriptions returns the descriptions of the given raw rules keyed by rule id.
+func securityGroupRuleDescriptions(rawRules json.RawMessage, descriptions map[string]string) error {
+	if len(rawRules) == 0 {
+		return nil
+	}
+	rules := []securityGroupRuleDescription{}
+	if err := json.Unmarshal(rawRules, &rules); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		descriptions[rule.ID] = rule.Description
+	}
+	return nil
+}
+
+// isSecurityGroupRuleGet retrieves a security group rule and its description with a single request,
+// decoding the rule with the vpcv1 models.
+func isSecurityGroupRuleGet(ctx context.Context, sess *vpcv1.VpcV1, secgrpID, ruleID string) (vpcv1.SecurityGroupRuleIntf, string, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	pathParams := map[string]string{"security_group_id": secgrpID, "id": ruleID}
+	response, err := vpcRawRequest(ctx, sess, core.GET, "/security_groups/{security_group_id}/rules/{id}", pathParams, nil, &rawResponse)
+	if err != nil {
+		return nil, "", response, err
+	}
+	var rule vpcv1.SecurityGroupRuleIntf
+	if err = core.UnmarshalModel(rawResponse, "", &rule, vpcv1.UnmarshalSecurityGroupRule); err != nil {
+		return nil, "", response, err
+	}
+	description := ""
+	if rawDescription, ok := rawResponse[isSecurityGroupRuleDescription]; ok {
+		if err = json.Unmarshal(rawDescription, &description); err != nil {
+			return nil, "", response, err
+		}
+	}
+	return rule, description, response, nil
+}
+
+// isSecurityGroupGet retrieves a security group and the descriptions of its rules, keyed by rule id,
+// with a single request.
+func isSecurityGroupGet(ctx context.Context, sess *vpcv1.VpcV1, id string) (*vpcv1.SecurityGroup, map[string]string, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(ctx, sess, core.GET, "/security_groups/{id}", map[string]string{"id": id}, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var group *vpcv1.SecurityGroup
+	if err = core.UnmarshalModel(rawResponse, "", &group, vpcv1.UnmarshalSecurityGroup); err != nil {
+		return nil, nil, response, err
+	}
+	descriptions := map[string]string{}
+	if err = securityGroupRuleDescriptions(rawResponse["rules"], descriptions); err != nil {
+		return nil, nil, response, err
+	}
+	return group, descriptions, response, nil
+}
+
+// isSecurityGroupsList retrieves a page of security groups and the descriptions of their rules,
+// keyed by rule id, with a single request.
+func isSecurityGroupsList(ctx context.Context, sess *vpcv1.VpcV1, options *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, map[string]string, *core.DetailedResponse, error) {
+	query := map[string]string{}
+	if options.Start != nil {
+		query["start"] = *options.Start
+	}
+	if options.ResourceGroupID != nil {
+		query["resource_group.id"] = *options.ResourceGroupID
+	}
+	if options.VPCID != nil {
+		query["vpc.id"] = *options.VPCID
+	}
+	if options.VPCCRN != nil {
+		query["vpc.crn"] = *options.VPCCRN
+	}
+	if options.VPCName != nil {
+		query["vpc.name"] = *options.VPCName
+	}
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequestWithQuery(ctx, sess, core.GET, "/security_groups", nil, query, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var collection *vpcv1.SecurityGroupCollection
+	if err = core.UnmarshalModel(rawResponse, "", &collection, vpcv1.UnmarshalSecurityGroupCollection); err != nil {
+		return nil, nil, response, err
+	}
+	descriptions := map[string]string{}
+	rawGroups := []map[string]json.RawMessage{}
+	if err = json.Unmarshal(rawResponse["security_groups"], &rawGroups); err != nil {
+		return nil, nil, response, err
+	}
+	for _, rawGroup := range rawGroups {
+		if err = securityGroupRuleDescriptions(rawGroup["rules"], descriptions); err != nil {
+			return nil, nil, response, err
+		}
+	}
+	return collection, descriptions, response, nil
+}
+
+// isSecurityGroupRulesList retrieves the rules of a security group and their descriptions, keyed by
+// rule id, with a single request.
+func isSecurityGroupRulesList(ctx context.Context, sess *vpcv1.VpcV1, secgrpID string) (*vpcv1.SecurityGroupRuleCollection, map[string]string, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRawRequest(ctx, sess, core.GET, "/security_groups/{security_group_id}/rules", map[string]string{"security_group_id": secgrpID}, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var collection *vpcv1.SecurityGroupRuleCollection
+	if err = core.UnmarshalModel(rawResponse, "", &collection, vpcv1.UnmarshalSecurityGroupRuleCollection); err != nil {
+		return nil, nil, response, err
+	}
+	descriptions := map[string]string{}
+	if err = securityGroupRuleDescriptions(rawResponse["rules"], descriptions); err != nil {
+		return nil, nil, response, err
+	}
+	return collection, descriptions, response, nil
+}
+
+// isSecurityGroupRuleUpdateDescription sets the description of a security group rule. The vpcv1
+// rule patch is a merge patch, so the description is added to it next to the modeled fields.
+func isSecurityGroupRuleUpdateDescription(sess *vpcv1.VpcV1, secgrpID, ruleID, description string) error {
+	updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+		SecurityGroupID: &secgrpID,
+		ID:              &ruleID,
+		SecurityGroupRulePatch: map[string]interface{}{
+			isSecurityGroupRuleDescription: strings.TrimSpace(description),
+		},
+	}
+	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating Security Group Rule (%s) description: %s\n%s", ruleID, err, response)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -42,6 +43,81 @@ func TestAccIBMISSecurityGroupRule_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMISSecurityGroupRule_description(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-desc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "allow ssh from the bastion"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_desc", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_desc", "description", "allow ssh from the bastion"),
+				),
+			},
+			{
+				Config:   testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "  allow ssh from the bastion "),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "allow ssh from the jump host"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_desc", "description", "allow ssh from the jump host"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_rule.testacc_security_group_rule_desc", "description", "allow ssh from the jump host"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISSecurityGroupRule_local(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-local-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, "10.240.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_local", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_local", "local", "10.240.0.0/24"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_rule.testacc_security_group_rule_local", "local.0.cidr_block", "10.240.0.0/24"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, "10.240.0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_local", "local", "10.240.0.5"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_rule.testacc_security_group_rule_local", "local.0.address", "10.240.0.5"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, "r006-6d2ae7f7-1b51-4b4e-8a5e-2e8f1f0e9a47"),
+				ExpectError: regexp.MustCompile("must be an IP address or a CIDR block"),
+			},
+		},
+	})
+}
+
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
@@ -211,3 +287,60 @@ func testAccCheckIBMISsecurityGroupRuleConfig(vpcname, name string) string {
  `, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_desc" {
+		group       = ibm_is_security_group.testacc_security_group.id
+		direction   = "inbound"
+		remote      = "127.0.0.1"
+		description = "%s"
+		tcp {
+			port_min = 22
+			port_max = 22
+		}
+	}
+
+	data "ibm_is_security_group_rule" "testacc_security_group_rule_desc" {
+		security_group      = ibm_is_security_group.testacc_security_group.id
+		security_group_rule = ibm_is_security_group_rule.testacc_security_group_rule_desc.rule_id
+	}`, vpcname, name, description)
+}
+
+func testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, local string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_local" {
+		group       = ibm_is_security_group.testacc_security_group.id
+		direction   = "inbound"
+		remote      = "127.0.0.1"
+		local       = "%s"
+		description = "allow https to the load balancer subnet"
+		tcp {
+			port_min = 443
+			port_max = 443
+		}
+	}
+
+	data "ibm_is_security_group_rule" "testacc_security_group_rule_local" {
+		security_group      = ibm_is_security_group.testacc_security_group.id
+		security_group_rule = ibm_is_security_group_rule.testacc_security_group_rule_local.rule_id
+	}`, vpcname, name, local)
+}
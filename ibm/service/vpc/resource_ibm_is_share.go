@@ -979,8 +979,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 		}
 		replicaShareAccessTagsSchema := "replica_share.0.access_tags"
 		if _, ok := d.GetOk(replicaShareAccessTagsSchema); ok {
-			oldList, newList := d.GetChange(replicaShareAccessTagsSchema)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.ReplicaShare.CRN, "", isAccessTagType)
+			err = flex.AttachGlobalTagsUsingCRN(d.Get(replicaShareAccessTagsSchema), meta, *share.ReplicaShare.CRN, "", isAccessTagType)
 			if err != nil {
 				log.Printf(
 					"Error creating replica file share (%s) access tags: %s", d.Id(), err)
@@ -990,8 +989,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 	d.SetId(*share.ID)
 
 	if _, ok := d.GetOk(isFileShareAccessTags); ok {
-		oldList, newList := d.GetChange(isFileShareAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isFileShareAccessTags), meta, *share.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating file share (%s) access tags: %s", d.Id(), err)
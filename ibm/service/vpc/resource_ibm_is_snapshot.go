@@ -49,6 +49,7 @@ const (
 	isSnapshotWaiting           = "waiting"
 	isSnapshotCapturedAt        = "captured_at"
 	isSnapshotBackupPolicyPlan  = "backup_policy_plan"
+	isSnapshotCloneAvailability = "clone_availability"
 )
 
 func ResourceIBMSnapshot() *schema.Resource {
@@ -383,6 +384,13 @@ func ResourceIBMSnapshot() *schema.Resource {
 				Description: "Zones for creating the snapshot clone",
 			},
 
+			isSnapshotCloneAvailability: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Whether the clone of the snapshot in each zone is available for restoring volumes, by zone name",
+			},
+
 			isSnapshotUserTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -593,9 +601,17 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if clones, ok := d.GetOk(isSnapshotClones); ok {
+		for _, clone := range clones.(*schema.Set).List() {
+			_, err = isWaitForCloneAvailable(sess, d.Id(), clone.(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if _, ok := d.GetOk(isSnapshotAccessTags); ok {
-		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isSubnetAccessTags), meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource snapshot (%s) access tags: %s", d.Id(), err)
@@ -757,6 +773,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	d.Set(isSnapshotClones, flex.NewStringSet(schema.HashString, clones))
+	d.Set(isSnapshotCloneAvailability, snapshotCloneAvailability(snapshot.Clones))
 
 	backupPolicyPlanList := []map[string]interface{}{}
 	if snapshot.BackupPolicyPlan != nil {
@@ -900,7 +917,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating snapshot (%s) clone(%s) : %q", d.Id(), add[i], err)
 				}
-				_, err = isWaitForCloneAvailable(sess, d, id, add[i])
+				_, err = isWaitForCloneAvailable(sess, id, add[i], d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -969,20 +986,31 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		return snapshot, isSnapshotUpdating, nil
 	}
 }
-func isWaitForCloneAvailable(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
+func isWaitForCloneAvailable(sess *vpcv1.VpcV1, id, zoneName string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be available.", id, zoneName)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"false"},
 		Target:     []string{"true", "deleted"},
 		Refresh:    isSnapshotCloneRefreshFunc(sess, id, zoneName),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 	return stateConf.WaitForState()
 }
 
+// snapshotCloneAvailability returns whether the clone of the snapshot in each zone is available, by zone name.
+func snapshotCloneAvailability(clones []vpcv1.SnapshotClone) map[string]bool {
+	availability := make(map[string]bool, len(clones))
+	for _, clone := range clones {
+		if clone.Zone != nil && clone.Zone.Name != nil && clone.Available != nil {
+			availability[*clone.Zone.Name] = *clone.Available
+		}
+	}
+	return availability
+}
+
 func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotCloneOptions := &vpcv1.GetSnapshotCloneOptions{
@@ -367,8 +367,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get("tags"), meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
@@ -376,8 +375,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	}
 
 	if _, ok := d.GetOk("access_tags"); ok {
-		oldList, newList := d.GetChange("access_tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get("access_tags"), meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource snapshot consistency group (%s) access tags: %s", d.Id(), err)
@@ -434,6 +434,10 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_snapshot.testacc_snapshot", "clones.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_is_snapshot.testacc_snapshot", "clones.0", acc.ISZoneName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "clone_availability.%", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", fmt.Sprintf("clone_availability.%s", acc.ISZoneName2), "true"),
 				),
 			},
 		},
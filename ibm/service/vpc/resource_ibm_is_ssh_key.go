@@ -54,6 +54,10 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSSHKeyTypeCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -78,7 +82,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"ed25519", "rsa"}, false),
-				Description:  "Key type",
+				Description:  "Key type, detected from the public key when not set",
 			},
 
 			isKeyFingerprint: {
@@ -220,6 +224,10 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	if keytype, ok := d.GetOk(isKeyType); ok {
 		kt := keytype.(string)
 		options.Type = &kt
+	} else if pk, err := parseKey(strings.TrimSpace(publickey)); err == nil && sshKeyType(pk) != "" {
+		// the API defaults to rsa, which rejects ed25519 keys
+		kt := sshKeyType(pk)
+		options.Type = &kt
 	}
 
 	key, response, err := sess.CreateKey(options)
@@ -231,8 +239,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
-		oldList, newList := d.GetChange(isKeyTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isKeyTags), meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) tags: %s", d.Id(), err)
@@ -240,8 +247,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	}
 
 	if _, ok := d.GetOk(isKeyAccessTags); ok {
-		oldList, newList := d.GetChange(isKeyAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isKeyAccessTags), meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) access tags: %s", d.Id(), err)
@@ -468,6 +474,50 @@ func suppressPublicKeyDiff(k, old, new string, d *schema.ResourceData) bool {
 	}
 }
 
+// resourceIBMISSSHKeyTypeCustomizeDiff plans the key type detected from the public key of a new key, and
+// rejects a configured type that does not match the public key.
+func resourceIBMISSSHKeyTypeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" || !diff.NewValueKnown(isKeyPublicKey) {
+		return nil
+	}
+	pk, err := parseKey(strings.TrimSpace(diff.Get(isKeyPublicKey).(string)))
+	if err != nil {
+		return nil
+	}
+	keyType := sshKeyType(pk)
+	if keyType == "" {
+		return fmt.Errorf("[ERROR] Unsupported SSH key type %s, only rsa and ed25519 keys are supported", pk.Type())
+	}
+	if configured, ok := diff.GetOk(isKeyType); ok && diff.NewValueKnown(isKeyType) {
+		if configured.(string) != keyType {
+			return fmt.Errorf("[ERROR] The public key is an %s key but type is set to %s", keyType, configured.(string))
+		}
+		return nil
+	}
+	return diff.SetNew(isKeyType, keyType)
+}
+
+// sshKeyType returns the VPC key type of the public key, or an empty string for other key types.
+func sshKeyType(pk ssh.PublicKey) string {
+	switch pk.Type() {
+	case ssh.KeyAlgoRSA:
+		return "rsa"
+	case ssh.KeyAlgoED25519:
+		return "ed25519"
+	}
+	return ""
+}
+
+// sshKeyFingerprint returns the fingerprint of the public key in the format of the VPC API, the base64
+// encoded SHA256 hash of the key prefixed with SHA256:.
+func sshKeyFingerprint(publicKey string) (string, error) {
+	pk, err := parseKey(strings.TrimSpace(publicKey))
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(pk), nil
+}
+
 // takes a string and returns public key object
 func parseKey(s string) (ssh.PublicKey, error) {
 	keyBytes := []byte(s)
@@ -360,8 +360,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVirtualEndpointGatewayTags), meta, *endpointGateway.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
@@ -369,8 +368,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	}
 
 	if _, ok := d.GetOk(isVirtualEndpointGatewayAccessTags); ok {
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVirtualEndpointGatewayAccessTags), meta, *endpointGateway.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) access tags: %s", d.Id(), err)
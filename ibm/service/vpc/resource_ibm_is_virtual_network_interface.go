@@ -46,6 +46,10 @@ func ResourceIBMIsVirtualNetworkInterface() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsVirtualNetworkInterfaceValidateTarget(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +71,13 @@ func ResourceIBMIsVirtualNetworkInterface() *schema.Resource {
 				Computed:    true,
 				Description: "If `true`:- The VPC infrastructure performs any needed NAT operations.- `floating_ips` must not have more than one floating IP.If `false`:- Packets are passed unchanged to/from the network interface,  allowing the workload to perform any needed NAT operations.- `allow_ip_spoofing` must be `false`.- If the virtual network interface is attached:  - The target `resource_type` must be `bare_metal_server_network_attachment`.  - The target `interface_type` must not be `hipersocket`.",
 			},
+			"protocol_state_filtering_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "protocol_state_filtering_mode"),
+				Description:  "The protocol state filtering mode used for this virtual network interface. If `auto`, protocol state packet filtering is enabled or disabled based on the virtual network interface's `target` resource type:- `bare_metal_server_network_attachment`: disabled- `instance_network_attachment`: enabled- `share_mount_target`: enabled.",
+			},
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -373,6 +384,13 @@ func ResourceIBMIsVirtualNetworkInterfaceValidator() *validate.ResourceValidator
 			MinValueLength:             1,
 			MaxValueLength:             63,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "protocol_state_filtering_mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "auto, disabled, enabled",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_network_interface", Schema: validateSchema}
@@ -456,18 +474,25 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 	}
 
 	d.SetId(*virtualNetworkInterface.ID)
+	// the protocol state filtering mode is not part of the SDK in use, it is patched once the interface exists
+	if protocolStateFilteringMode, ok := d.GetOk("protocol_state_filtering_mode"); ok {
+		patch := map[string]interface{}{"protocol_state_filtering_mode": protocolStateFilteringMode.(string)}
+		response, err := vpcRawRequest(context, sess, core.PATCH, "/virtual_network_interfaces/{id}", map[string]string{"id": d.Id()}, patch, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed setting protocol_state_filtering_mode %s\n%s", err, response))
+		}
+	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get("tags"), meta, *virtualNetworkInterface.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vni (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk("access_tags"); ok {
-		oldList, newList := d.GetChange("access_tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get("access_tags"), meta, *virtualNetworkInterface.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vni (%s) access tags: %s", d.Id(), err)
@@ -511,6 +536,16 @@ func resourceIBMIsVirtualNetworkInterfaceRead(context context.Context, d *schema
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_infrastructure_nat: %s", err))
 		}
 	}
+	protocolStateFilteringMode, response, err := getVirtualNetworkInterfaceProtocolStateFilteringMode(context, sess, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed reading protocol_state_filtering_mode %s\n%s", err, response))
+	}
+	if protocolStateFilteringMode != "" {
+		if err = d.Set("protocol_state_filtering_mode", protocolStateFilteringMode); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting protocol_state_filtering_mode: %s", err))
+		}
+	}
 	if !core.IsNil(virtualNetworkInterface.Ips) {
 		ips := []map[string]interface{}{}
 		for _, ipsItem := range virtualNetworkInterface.Ips {
@@ -665,6 +700,9 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 		patchVals.EnableInfrastructureNat = &newEnableInfrastructureNat
 		hasChange = true
 	}
+	if d.HasChange("protocol_state_filtering_mode") {
+		hasChange = true
+	}
 	if d.HasChange("name") {
 		newName := d.Get("name").(string)
 		patchVals.Name = &newName
@@ -877,6 +915,9 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 
 	if hasChange {
 		updateVirtualNetworkInterfaceOptions.VirtualNetworkInterfacePatch, _ = patchVals.AsPatch()
+		if d.HasChange("protocol_state_filtering_mode") {
+			updateVirtualNetworkInterfaceOptions.VirtualNetworkInterfacePatch["protocol_state_filtering_mode"] = d.Get("protocol_state_filtering_mode").(string)
+		}
 		_, response, err := sess.UpdateVirtualNetworkInterfaceWithContext(context, updateVirtualNetworkInterfaceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
@@ -908,6 +949,73 @@ func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *sche
 	return nil
 }
 
+// getVirtualNetworkInterfaceProtocolStateFilteringMode returns the protocol state filtering mode of the virtual network
+// interface, which is not part of the SDK in use.
+func getVirtualNetworkInterfaceProtocolStateFilteringMode(ctx context.Context, sess *vpcv1.VpcV1, id string) (string, *core.DetailedResponse, error) {
+	result := &struct {
+		ProtocolStateFilteringMode string `json:"protocol_state_filtering_mode"`
+	}{}
+	response, err := vpcRawRequest(ctx, sess, core.GET, "/virtual_network_interfaces/{id}", map[string]string{"id": id}, nil, result)
+	return result.ProtocolStateFilteringMode, response, err
+}
+
+// listVirtualNetworkInterfaceProtocolStateFilteringModes returns the protocol state filtering modes of the virtual
+// network interfaces by ID, optionally of one resource group only.
+func listVirtualNetworkInterfaceProtocolStateFilteringModes(ctx context.Context, sess *vpcv1.VpcV1, resourceGroupID string) (map[string]string, error) {
+	modes := map[string]string{}
+	start := ""
+	for {
+		query := map[string]string{}
+		if start != "" {
+			query["start"] = start
+		}
+		if resourceGroupID != "" {
+			query["resource_group.id"] = resourceGroupID
+		}
+		collection := &struct {
+			VirtualNetworkInterfaces []struct {
+				ID                         string `json:"id"`
+				ProtocolStateFilteringMode string `json:"protocol_state_filtering_mode"`
+			} `json:"virtual_network_interfaces"`
+			Next *struct {
+				Href *string `json:"href"`
+			} `json:"next"`
+		}{}
+		response, err := vpcRawRequestWithQuery(ctx, sess, core.GET, "/virtual_network_interfaces", nil, query, nil, collection)
+		if err != nil {
+			return nil, fmt.Errorf("ListVirtualNetworkInterfacesWithContext failed %s\n%s", err, response)
+		}
+		for _, virtualNetworkInterface := range collection.VirtualNetworkInterfaces {
+			modes[virtualNetworkInterface.ID] = virtualNetworkInterface.ProtocolStateFilteringMode
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return modes, nil
+}
+
+// resourceIBMIsVirtualNetworkInterfaceValidateTarget rejects at plan time the flow settings that the target of
+// the virtual network interface does not support, instead of failing the update once the patch is sent.
+func resourceIBMIsVirtualNetworkInterfaceValidateTarget(diff *schema.ResourceDiff) error {
+	enableInfrastructureNat, ok := diff.GetOkExists("enable_infrastructure_nat")
+	if !ok || enableInfrastructureNat.(bool) {
+		return nil
+	}
+	if diff.Get("allow_ip_spoofing").(bool) {
+		return fmt.Errorf("[ERROR] allow_ip_spoofing must be false when enable_infrastructure_nat is false")
+	}
+	// the target is only known once the virtual network interface is attached
+	if targets, ok := diff.GetOk("target"); ok && len(targets.([]interface{})) > 0 && targets.([]interface{})[0] != nil {
+		target := targets.([]interface{})[0].(map[string]interface{})
+		if resourceType, ok := target["resource_type"].(string); ok && resourceType != "" && resourceType != "bare_metal_server_network_attachment" {
+			return fmt.Errorf("[ERROR] enable_infrastructure_nat can only be false when the virtual network interface is attached to a bare metal server network attachment, its target is a %s", resourceType)
+		}
+	}
+	return nil
+}
+
 func resourceIBMIsVirtualNetworkInterfaceMapToVirtualNetworkInterfaceIPsReservedIPPrototype(modelMap map[string]interface{}) (vpcv1.VirtualNetworkInterfaceIPPrototypeIntf, error) {
 	model := &vpcv1.VirtualNetworkInterfaceIPPrototype{}
 	if modelMap["reserved_ip"] != nil && modelMap["reserved_ip"].(string) != "" {
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -115,6 +116,51 @@ func TestAccIBMIsVirtualNetworkInterfaceResourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccIBMIsVirtualNetworkInterfaceProtocolStateFilteringMode(t *testing.T) {
+	var conf vpcv1.VirtualNetworkInterface
+	vpcname := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngw-subnet-%d", acctest.RandIntRange(10, 100))
+	vniname := fmt.Sprintf("tfvpngw-createname-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVirtualNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfigProtocolStateFilteringMode(vpcname, subnetname, vniname, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceExists("ibm_is_virtual_network_interface.testacc_vni", conf),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.testacc_vni", "protocol_state_filtering_mode", "enabled"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfigProtocolStateFilteringMode(vpcname, subnetname, vniname, "disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceExists("ibm_is_virtual_network_interface.testacc_vni", conf),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.testacc_vni", "protocol_state_filtering_mode", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVirtualNetworkInterfaceInfrastructureNatSpoofing(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngw-subnet-%d", acctest.RandIntRange(10, 100))
+	vniname := fmt.Sprintf("tfvpngw-createname-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVirtualNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMIsVirtualNetworkInterfaceConfigBasic(vpcname, subnetname, vniname, "tag1", "tag2", "tag3", false, true, false),
+				ExpectError: regexp.MustCompile("allow_ip_spoofing must be false when enable_infrastructure_nat is false"),
+			},
+		},
+	})
+}
+
 func TestAccIBMIsVirtualNetworkInterfaceAllArgs(t *testing.T) {
 	var conf vpcv1.VirtualNetworkInterface
 	vpcname := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
@@ -218,6 +264,27 @@ func testAccCheckIBMIsVirtualNetworkInterfaceConfigResourceGroup(vpcname, subnet
 	`, vpcname, subnetname, acc.ISZoneName, vniname, enablenat, allowipspoofing, acc.IsResourceGroupID)
 }
 
+func testAccCheckIBMIsVirtualNetworkInterfaceConfigProtocolStateFilteringMode(vpcname, subnetname, vniname, mode string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		total_ipv4_address_count = 16
+	}
+	
+	resource "ibm_is_virtual_network_interface" "testacc_vni"{
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		protocol_state_filtering_mode = "%s"
+	}
+	`, vpcname, subnetname, acc.ISZoneName, vniname, mode)
+}
+
 func testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, vniname string, enablenat, allowipspoofing bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -40,6 +40,7 @@ const (
 	isVolumeProvisioningDone      = "done"
 	isVolumeResourceGroup         = "resource_group"
 	isVolumeSourceSnapshot        = "source_snapshot"
+	isVolumeFastRestore           = "fast_restore"
 	isVolumeDeleteAllSnapshots    = "delete_all_snapshots"
 	isVolumeBandwidth             = "bandwidth"
 	isVolumeAccessTags            = "access_tags"
@@ -134,6 +135,12 @@ func ResourceIBMISVolume() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_volume", isVolumeSourceSnapshot),
 				Description:  "The unique identifier for this snapshot",
 			},
+			isVolumeFastRestore: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{isVolumeSourceSnapshot},
+				Description:  "Whether the volume must be restored from the clone of the source snapshot in the zone of the volume. Only applies at creation, which fails when the snapshot has no clone in the zone",
+			},
 			isVolumeResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -444,6 +451,12 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		if (response != nil && response.StatusCode == 404) || snapshot == nil {
 			return fmt.Errorf("[ERROR] No snapshot found with id %s", sourceSnapshot)
 		}
+		if d.Get(isVolumeFastRestore).(bool) {
+			err = volumeFastRestoreClone(sess, snapshot, zone, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+		}
 		minimumCapacity := *snapshot.MinimumCapacity
 		if capacity, ok := d.GetOk(isVolumeCapacity); ok {
 			if int64(capacity.(int)) > minimumCapacity {
@@ -515,8 +528,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 	}
 
 	if _, ok := d.GetOk(isVolumeAccessTags); ok {
-		oldList, newList := d.GetChange(isVolumeAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVolumeAccessTags), meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc volume (%s) access tags: %s", d.Id(), err)
@@ -525,6 +537,22 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 	return nil
 }
 
+// volumeFastRestoreClone waits for the clone of snapshot in zone to be available, so that the volume created
+// from the snapshot is restored from the clone instead of from the regional copy of the snapshot.
+func volumeFastRestoreClone(sess *vpcv1.VpcV1, snapshot *vpcv1.Snapshot, zone string, timeout time.Duration) error {
+	for _, clone := range snapshot.Clones {
+		if clone.Zone == nil || clone.Zone.Name == nil || *clone.Zone.Name != zone {
+			continue
+		}
+		if clone.Available != nil && *clone.Available {
+			return nil
+		}
+		_, err := isWaitForCloneAvailable(sess, *snapshot.ID, zone, timeout)
+		return err
+	}
+	return fmt.Errorf("[ERROR] Snapshot (%s) has no clone in zone %s for the fast restore of the volume, add the zone to the clones of the snapshot", *snapshot.ID, zone)
+}
+
 func resourceIBMISVolumeRead(d *schema.ResourceData, meta interface{}) error {
 
 	id := d.Id()
@@ -774,22 +774,26 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	if sgAclRules, ok := d.GetOk(isVPCNoSgAclRules); ok {
 		sgAclRules := sgAclRules.(bool)
 		if sgAclRules {
-			deleteDefaultNetworkACLRules(sess, *vpc.ID)
-			deleteDefaultSecurityGroupRules(sess, *vpc.ID)
+			err = deleteDefaultNetworkACLRules(sess, *vpc.ID)
+			if err != nil {
+				return err
+			}
+			err = deleteDefaultSecurityGroupRules(sess, *vpc.ID)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPCTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVPCTags), meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isVPCAccessTags); ok {
-		oldList, newList := d.GetChange(isVPCAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVPCAccessTags), meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
@@ -818,26 +822,26 @@ func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 	result, detail, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
 	if err != nil || result == nil {
 		log.Printf("Error reading details of VPC Default Network ACL:%s", detail)
-		return err
+		return fmt.Errorf("[ERROR] Error getting the default network ACL of VPC (%s): %s\n%s", vpcID, err, detail)
 	}
 
 	if result.Rules != nil {
 		for _, sourceRule := range result.Rules {
-			sourceRuleVal := sourceRule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-			if sourceRuleVal.ID != nil {
+			ruleID := networkACLRuleItemID(sourceRule)
+			if ruleID != nil {
 				getNetworkAclRuleOptions := &vpcv1.GetNetworkACLRuleOptions{
 					NetworkACLID: result.ID,
-					ID:           sourceRuleVal.ID,
+					ID:           ruleID,
 				}
 				_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", *ruleID, err, response)
 				}
 
 				deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
 					NetworkACLID: result.ID,
-					ID:           sourceRuleVal.ID,
+					ID:           ruleID,
 				}
 				response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 				if err != nil {
@@ -854,26 +858,26 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 	result, detail, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
 	if err != nil || result == nil {
 		log.Printf("Error reading details of VPC Default Security Group:%s", detail)
-		return err
+		return fmt.Errorf("[ERROR] Error getting the default security group of VPC (%s): %s\n%s", vpcID, err, detail)
 	}
 
 	if result.Rules != nil {
 		for _, sourceRule := range result.Rules {
-			sourceRuleVal := sourceRule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-			if sourceRuleVal.ID != nil {
+			ruleID := securityGroupRuleID(sourceRule)
+			if ruleID != nil {
 				getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 					SecurityGroupID: result.ID,
-					ID:              sourceRuleVal.ID,
+					ID:              ruleID,
 				}
 				_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Security Group Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return fmt.Errorf("[ERROR] Error Getting Security Group Rule  (%s): %s\n%s", *ruleID, err, response)
 				}
 
 				deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
 					SecurityGroupID: result.ID,
-					ID:              sourceRuleVal.ID,
+					ID:              ruleID,
 				}
 				response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 				if err != nil {
@@ -885,6 +889,32 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 	return nil
 }
 
+// networkACLRuleItemID returns the identifier of a network ACL rule of any protocol.
+func networkACLRuleItemID(ruleIntf vpcv1.NetworkACLRuleItemIntf) *string {
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		return rule.ID
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		return rule.ID
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		return rule.ID
+	}
+	return nil
+}
+
+// securityGroupRuleID returns the identifier of a security group rule of any protocol.
+func securityGroupRuleID(ruleIntf vpcv1.SecurityGroupRuleIntf) *string {
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		return rule.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		return rule.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		return rule.ID
+	}
+	return nil
+}
+
 func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
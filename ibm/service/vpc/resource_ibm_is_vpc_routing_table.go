@@ -29,6 +29,7 @@ const (
 	rtResourceType               = "resource_type"
 	rtLifecycleState             = "lifecycle_state"
 	rtSubnets                    = "subnets"
+	rtSubnetIDs                  = "subnet_ids"
 	rtDestination                = "destination"
 	rtAction                     = "action"
 	rtNextHop                    = "next_hop"
@@ -139,6 +140,13 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates whether this is the default routing table for this VPC",
 			},
+			rtSubnetIDs: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the subnets attached to this routing table. Subnets removed from the set are attached back to the default routing table of the VPC. Leave it unset when the subnets are attached with ibm_is_subnet_routing_table_attachment.",
+			},
 			rtSubnets: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -247,6 +255,13 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
 
+	if subnetIDs, ok := d.GetOk(rtSubnetIDs); ok {
+		err = attachVPCRoutingTableSubnets(sess, vpcID, *routeTable.ID, subnetIDs.(*schema.Set).List(), nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMISVPCRoutingTableRead(d, meta)
 }
 
@@ -306,6 +321,14 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set(rtSubnets, subnets)
+	// the attachments are only tracked when they are managed from the routing table
+	if _, ok := d.GetOk(rtSubnetIDs); ok {
+		subnetIDs := make([]string, 0, len(routeTable.Subnets))
+		for _, s := range routeTable.Subnets {
+			subnetIDs = append(subnetIDs, *s.ID)
+		}
+		d.Set(rtSubnetIDs, subnetIDs)
+	}
 
 	return nil
 }
@@ -408,6 +431,15 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
 		return err
 	}
+	if d.HasChange(rtSubnetIDs) {
+		oldSubnets, newSubnets := d.GetChange(rtSubnetIDs)
+		attach := newSubnets.(*schema.Set).Difference(oldSubnets.(*schema.Set)).List()
+		detach := oldSubnets.(*schema.Set).Difference(newSubnets.(*schema.Set)).List()
+		err = attachVPCRoutingTableSubnets(sess, idSet[0], idSet[1], attach, detach)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISVPCRoutingTableRead(d, meta)
 }
 
@@ -419,6 +451,14 @@ func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}
 
 	idSet := strings.Split(d.Id(), "/")
 
+	// a routing table can only be deleted once no subnet is attached to it anymore
+	if subnetIDs := d.Get(rtSubnetIDs).(*schema.Set).List(); len(subnetIDs) > 0 {
+		err = attachVPCRoutingTableSubnets(sess, idSet[0], idSet[1], nil, subnetIDs)
+		if err != nil {
+			return err
+		}
+	}
+
 	deleteTableOptions := sess.NewDeleteVPCRoutingTableOptions(idSet[0], idSet[1])
 	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
 	if err != nil && response.StatusCode != 404 {
@@ -451,3 +491,57 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 	}
 	return true, nil
 }
+
+// attachVPCRoutingTableSubnets attaches the subnets to the routing table, and attaches the detached
+// subnets that are still attached to it back to the default routing table of the VPC.
+func attachVPCRoutingTableSubnets(sess *vpcv1.VpcV1, vpcID, routingTableID string, attach, detach []interface{}) error {
+	for _, s := range attach {
+		subnetID := s.(string)
+		replaceSubnetRoutingTableOptions := &vpcv1.ReplaceSubnetRoutingTableOptions{
+			ID: &subnetID,
+			RoutingTableIdentity: &vpcv1.RoutingTableIdentityByID{
+				ID: &routingTableID,
+			},
+		}
+		_, response, err := sess.ReplaceSubnetRoutingTable(replaceSubnetRoutingTableOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error attaching subnet %s to routing table %s: %s\n%s", subnetID, routingTableID, err, response)
+		}
+	}
+	if len(detach) == 0 {
+		return nil
+	}
+
+	vpc, response, err := sess.GetVPC(&vpcv1.GetVPCOptions{ID: &vpcID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting VPC %s: %s\n%s", vpcID, err, response)
+	}
+	if vpc.DefaultRoutingTable == nil {
+		return fmt.Errorf("[ERROR] Error detaching subnets from routing table %s: VPC %s has no default routing table", routingTableID, vpcID)
+	}
+	for _, s := range detach {
+		subnetID := s.(string)
+		subnetRoutingTable, response, err := sess.GetSubnetRoutingTable(&vpcv1.GetSubnetRoutingTableOptions{ID: &subnetID})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error getting the routing table of subnet %s: %s\n%s", subnetID, err, response)
+		}
+		// the subnet has been attached to another routing table in the meantime
+		if *subnetRoutingTable.ID != routingTableID {
+			continue
+		}
+		replaceSubnetRoutingTableOptions := &vpcv1.ReplaceSubnetRoutingTableOptions{
+			ID: &subnetID,
+			RoutingTableIdentity: &vpcv1.RoutingTableIdentityByID{
+				ID: vpc.DefaultRoutingTable.ID,
+			},
+		}
+		_, response, err = sess.ReplaceSubnetRoutingTable(replaceSubnetRoutingTableOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error attaching subnet %s back to the default routing table %s: %s\n%s", subnetID, *vpc.DefaultRoutingTable.ID, err, response)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,481 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rtRoutesRoute = "route"
+
+	// rtRoutesConcurrency is the number of route requests sent in parallel to the routing table.
+	rtRoutesConcurrency = 8
+)
+
+func ResourceIBMISVPCRoutingTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCRoutingTableRoutesCreate,
+		Read:     resourceIBMISVPCRoutingTableRoutesRead,
+		Update:   resourceIBMISVPCRoutingTableRoutesUpdate,
+		Delete:   resourceIBMISVPCRoutingTableRoutesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			rtVpcID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			rtID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The routing table identifier.",
+			},
+			rtRoutesRoute: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISVPCRoutingTableRoutesHash,
+				Description: "The complete set of user routes of the routing table. Routes are identified by their destination and zone, and routes of the routing table that are not in this set are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rDestination: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "If action is deliver, the next hop that packets will be delivered to. For other action values, its address will be 0.0.0.0.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							Description:  "The action to perform with a packet matching the route. Changing the action re-creates the route.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+						},
+						"advertise": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+						rName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "The user-defined name for this route.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							Description:  "The route's priority. Smaller values have higher priority.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", "priority"),
+						},
+						rID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table route identifier.",
+						},
+						rtOrigin: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The origin of this route.",
+						},
+						rtLifecycleState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Routing table route Lifecycle State",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMISVPCRoutingTableRoutesHash keys the routes by destination and zone, so that a change of
+// the other arguments updates the route in place.
+func resourceIBMISVPCRoutingTableRoutesHash(v interface{}) int {
+	return schema.HashString(routingTableRouteKey(v.(map[string]interface{})))
+}
+
+func routingTableRouteKey(route map[string]interface{}) string {
+	return fmt.Sprintf("%s/%s", route[rDestination].(string), route[rZone].(string))
+}
+
+func resourceIBMISVPCRoutingTableRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+	vpcID := d.Get(rtVpcID).(string)
+	tableID := d.Get(rtID).(string)
+
+	if err := resourceIBMISVPCRoutingTableRoutesReconcile(d, meta, vpcID, tableID); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, tableID))
+	return resourceIBMISVPCRoutingTableRoutesRead(d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, tableID, err := routingTableRoutesParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	routes, response, err := listUserRoutingTableRoutes(sess, vpcID, tableID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// keep the configured key of the managed routes so that out of band routes stand out in the log
+	managed := map[string]bool{}
+	for _, route := range d.Get(rtRoutesRoute).(*schema.Set).List() {
+		managed[routingTableRouteKey(route.(map[string]interface{}))] = true
+	}
+	routeList := make([]interface{}, 0, len(routes))
+	for _, route := range routes {
+		routeMap := routingTableRouteToMap(route)
+		if key := routingTableRouteKey(routeMap); !d.IsNewResource() && !managed[key] {
+			log.Printf("[WARN] Route %s (%s) of routing table %s is not managed by ibm_is_vpc_routing_table_routes and will be deleted on the next apply", *route.ID, key, tableID)
+		}
+		routeList = append(routeList, routeMap)
+	}
+
+	d.Set(rtVpcID, vpcID)
+	d.Set(rtID, tableID)
+	if err = d.Set(rtRoutesRoute, schema.NewSet(resourceIBMISVPCRoutingTableRoutesHash, routeList)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting route %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+	vpcID, tableID, err := routingTableRoutesParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	if err = resourceIBMISVPCRoutingTableRoutesReconcile(d, meta, vpcID, tableID); err != nil {
+		return err
+	}
+	return resourceIBMISVPCRoutingTableRoutesRead(d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, tableID, err := routingTableRoutesParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tasks := []func() error{}
+	for _, route := range d.Get(rtRoutesRoute).(*schema.Set).List() {
+		routeID := route.(map[string]interface{})[rID].(string)
+		if routeID == "" {
+			continue
+		}
+		tasks = append(tasks, func() error {
+			return deleteRoutingTableRoute(sess, vpcID, tableID, routeID)
+		})
+	}
+	if err = runRoutingTableRouteTasks(tasks); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISVPCRoutingTableRoutesReconcile compares the configured routes with the user routes of
+// the routing table and deletes, updates and creates routes until they match. The routes to delete go
+// first so that a destination and zone are free before they are reused.
+func resourceIBMISVPCRoutingTableRoutesReconcile(d *schema.ResourceData, meta interface{}, vpcID, tableID string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := listUserRoutingTableRoutes(sess, vpcID, tableID)
+	if err != nil {
+		return err
+	}
+	existing := map[string]vpcv1.Route{}
+	for _, route := range current {
+		existing[routingTableRouteKey(routingTableRouteToMap(route))] = route
+	}
+
+	deletes, updates, creates := []func() error{}, []func() error{}, []func() error{}
+	desired := map[string]bool{}
+	for _, r := range d.Get(rtRoutesRoute).(*schema.Set).List() {
+		route := r.(map[string]interface{})
+		key := routingTableRouteKey(route)
+		desired[key] = true
+
+		routeID := ""
+		if existingRoute, ok := existing[key]; ok {
+			routeID = *existingRoute.ID
+			if existingRoute.Action != nil && *existingRoute.Action != route[rAction].(string) {
+				// the action of a route cannot be updated
+				replacedRouteID := routeID
+				deletes = append(deletes, func() error {
+					return deleteRoutingTableRoute(sess, vpcID, tableID, replacedRouteID)
+				})
+				routeID = ""
+			} else if routePatch := routingTableRoutePatch(existingRoute, route); routePatch != nil {
+				updates = append(updates, func() error {
+					return updateRoutingTableRoute(sess, vpcID, tableID, routeID, routePatch)
+				})
+			}
+		}
+		if routeID == "" {
+			creates = append(creates, func() error {
+				return createRoutingTableRoute(sess, vpcID, tableID, route)
+			})
+		}
+	}
+	for key, route := range existing {
+		if !desired[key] {
+			routeID := *route.ID
+			log.Printf("[INFO] Deleting route %s (%s) of routing table %s", routeID, key, tableID)
+			deletes = append(deletes, func() error {
+				return deleteRoutingTableRoute(sess, vpcID, tableID, routeID)
+			})
+		}
+	}
+
+	if err = runRoutingTableRouteTasks(deletes); err != nil {
+		return err
+	}
+	if err = runRoutingTableRouteTasks(updates); err != nil {
+		return err
+	}
+	return runRoutingTableRouteTasks(creates)
+}
+
+// runRoutingTableRouteTasks runs the tasks with at most rtRoutesConcurrency of them in flight and
+// returns the errors of all the failed tasks.
+func runRoutingTableRouteTasks(tasks []func() error) error {
+	errs := make([]error, len(tasks))
+	sem := make(chan struct{}, rtRoutesConcurrency)
+	var wg sync.WaitGroup
+	wg.Add(len(tasks))
+	for i, task := range tasks {
+		sem <- struct{}{}
+		go func(i int, task func() error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = task()
+		}(i, task)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// listUserRoutingTableRoutes lists the routes of the routing table that can be managed by the user.
+// The routes with a creator, such as the learned and service routes, are skipped.
+func listUserRoutingTableRoutes(sess *vpcv1.VpcV1, vpcID, tableID string) ([]vpcv1.Route, *core.DetailedResponse, error) {
+	start := ""
+	routes := []vpcv1.Route{}
+	for {
+		listVpcRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		if start != "" {
+			listVpcRoutingTableRoutesOptions.Start = &start
+		}
+		result, response, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, response, fmt.Errorf("[ERROR] Error listing VPC Routing table routes: %s\n%s", err, response)
+		}
+		for _, route := range result.Routes {
+			if route.Creator == nil {
+				routes = append(routes, route)
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return routes, nil, nil
+}
+
+func routingTableRouteToMap(route vpcv1.Route) map[string]interface{} {
+	routeMap := map[string]interface{}{
+		rID:          *route.ID,
+		rDestination: *route.Destination,
+		rZone:        "",
+		rNextHop:     routingTableRouteNextHop(route),
+	}
+	if route.Zone != nil && route.Zone.Name != nil {
+		routeMap[rZone] = *route.Zone.Name
+	}
+	if route.Action != nil {
+		routeMap[rAction] = *route.Action
+	}
+	if route.Advertise != nil {
+		routeMap["advertise"] = *route.Advertise
+	}
+	if route.Name != nil {
+		routeMap[rName] = *route.Name
+	}
+	if route.Priority != nil {
+		routeMap["priority"] = int(*route.Priority)
+	}
+	if route.Origin != nil {
+		routeMap[rtOrigin] = *route.Origin
+	}
+	if route.LifecycleState != nil {
+		routeMap[rtLifecycleState] = *route.LifecycleState
+	}
+	return routeMap
+}
+
+func routingTableRouteNextHop(route vpcv1.Route) string {
+	if route.NextHop != nil {
+		nexthop := route.NextHop.(*vpcv1.RouteNextHop)
+		if nexthop.ID != nil {
+			return *nexthop.ID
+		}
+		if nexthop.Address != nil {
+			return *nexthop.Address
+		}
+	}
+	return ""
+}
+
+// routingTableRoutePatch returns the patch that turns the existing route into the configured one, or
+// nil when they already match.
+func routingTableRoutePatch(existing vpcv1.Route, route map[string]interface{}) map[string]interface{} {
+	hasChange := false
+	routePatchModel := new(vpcv1.RoutePatch)
+	if nextHop := route[rNextHop].(string); nextHop != routingTableRouteNextHop(existing) {
+		if net.ParseIP(nextHop) == nil {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				ID: core.StringPtr(nextHop),
+			}
+		} else {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				Address: core.StringPtr(nextHop),
+			}
+		}
+		hasChange = true
+	}
+	if name := route[rName].(string); name != "" && (existing.Name == nil || *existing.Name != name) {
+		routePatchModel.Name = &name
+		hasChange = true
+	}
+	if advertise := route["advertise"].(bool); existing.Advertise == nil || *existing.Advertise != advertise {
+		routePatchModel.Advertise = &advertise
+		hasChange = true
+	}
+	if priority := int64(route["priority"].(int)); existing.Priority == nil || *existing.Priority != priority {
+		routePatchModel.Priority = &priority
+		hasChange = true
+	}
+	if !hasChange {
+		return nil
+	}
+	routePatch, err := routePatchModel.AsPatch()
+	if err != nil {
+		log.Printf("[DEBUG] Error calling asPatch for VPC Routing Table Route Patch: %s", err)
+		return nil
+	}
+	return routePatch
+}
+
+func createRoutingTableRoute(sess *vpcv1.VpcV1, vpcID, tableID string, route map[string]interface{}) error {
+	destination := route[rDestination].(string)
+	zone := &vpcv1.ZoneIdentityByName{
+		Name: core.StringPtr(route[rZone].(string)),
+	}
+	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, destination, zone)
+
+	nextHop := route[rNextHop].(string)
+	if net.ParseIP(nextHop) == nil {
+		createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeVPNGatewayConnectionIdentity{
+			ID: core.StringPtr(nextHop),
+		})
+	} else {
+		createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeRouteNextHopIP{
+			Address: core.StringPtr(nextHop),
+		})
+	}
+	createVpcRoutingTableRouteOptions.SetAction(route[rAction].(string))
+	createVpcRoutingTableRouteOptions.SetAdvertise(route["advertise"].(bool))
+	createVpcRoutingTableRouteOptions.SetPriority(int64(route["priority"].(int)))
+	if name := route[rName].(string); name != "" {
+		createVpcRoutingTableRouteOptions.SetName(name)
+	}
+
+	_, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating VPC Routing table route %s in zone %s: %s\n%s", destination, *zone.Name, err, response)
+	}
+	return nil
+}
+
+func updateRoutingTableRoute(sess *vpcv1.VpcV1, vpcID, tableID, routeID string, routePatch map[string]interface{}) error {
+	updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, routeID, routePatch)
+	_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating VPC Routing table route %s: %s\n%s", routeID, err, response)
+	}
+	return nil
+}
+
+func deleteRoutingTableRoute(sess *vpcv1.VpcV1, vpcID, tableID, routeID string) error {
+	deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, routeID)
+	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting VPC Routing table route %s: %s\n%s", routeID, err, response)
+	}
+	return nil
+}
+
+func routingTableRoutesParseID(id string) (string, string, error) {
+	idSet := strings.Split(id, "/")
+	if len(idSet) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", id)
+	}
+	return idSet[0], idSet[1], nil
+}
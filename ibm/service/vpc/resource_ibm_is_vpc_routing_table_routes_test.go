@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCRoutingTableRoutes_basic(t *testing.T) {
+	vpcName := fmt.Sprintf("tfvpcuat-create-%d", acctest.RandIntRange(10, 100))
+	routeTableName := fmt.Sprintf("tfvpcrt-create-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRoutingTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcName, routeTableName, []string{"192.168.1.0/24", "192.168.2.0/24", "192.168.3.0/24"}, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc_routing_table_routes.test_routes", "route.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_is_vpc_routing_table_routes.test_routes", "route.*", map[string]string{
+						"destination": "192.168.2.0/24",
+						"zone":        acc.ISZoneName,
+						"priority":    "2",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcName, routeTableName, []string{"192.168.2.0/24", "192.168.4.0/24"}, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc_routing_table_routes.test_routes", "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_is_vpc_routing_table_routes.test_routes", "route.*", map[string]string{
+						"destination": "192.168.2.0/24",
+						"priority":    "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_is_vpc_routing_table_routes.test_routes", "route.*", map[string]string{
+						"destination": "192.168.4.0/24",
+						"priority":    "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_routing_table_routes.test_routes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesDestroy(s *terraform.State) error {
+	sess, err := vpcClient(acc.TestAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpc_routing_table_routes" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		listVpcRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(parts[0], parts[1])
+		routes, _, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err == nil {
+			for _, route := range routes.Routes {
+				if route.Creator == nil {
+					return fmt.Errorf("Routing table route still exists: %s", *route.ID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcName, rtName string, destinations []string, priority int) string {
+	routes := ""
+	for _, destination := range destinations {
+		routes += fmt.Sprintf(`
+  route {
+    zone        = "%s"
+    destination = "%s"
+    next_hop    = "%s"
+    priority    = %d
+  }`, acc.ISZoneName, destination, acc.ISRouteNextHop, priority)
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+  name = "%s"
+}
+resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
+  vpc  = ibm_is_vpc.testacc_vpc.id
+  name = "%s"
+}
+resource "ibm_is_vpc_routing_table_routes" "test_routes" {
+  vpc           = ibm_is_vpc.testacc_vpc.id
+  routing_table = ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table.routing_table
+%s
+}
+`, vpcName, rtName, routes)
+}
@@ -47,6 +47,40 @@ func TestAccIBMISVPCRoutingTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPCRoutingTable_subnetIDs(t *testing.T) {
+	var vpcRouteTables string
+	name1 := fmt.Sprintf("tfvpc-create-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfsubnet-rt-%d", acctest.RandIntRange(10, 100))
+	routeTableName := fmt.Sprintf("tfvpcrt-create-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRouteTableSubnetIDsConfig(routeTableName, name1, subnetName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRouteTableExists("ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", vpcRouteTables),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "subnets.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRouteTableSubnetIDsConfig(routeTableName, name1, subnetName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "subnet_ids.#", "0"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "subnets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVPCRoutingTable_acceptRoutesFrom(t *testing.T) {
 	var vpcRouteTables string
 	name1 := fmt.Sprintf("tfvpc-create-%d", acctest.RandIntRange(10, 100))
@@ -212,6 +246,28 @@ resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
 }`, name, rtName)
 }
 
+func testAccCheckIBMISVPCRouteTableSubnetIDsConfig(rtName, name, subnetName string, attached bool) string {
+	subnetIDs := "[]"
+	if attached {
+		subnetIDs = "[ibm_is_subnet.testacc_subnet.id]"
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+resource "ibm_is_subnet" "testacc_subnet" {
+	name = "%s"
+	vpc = ibm_is_vpc.testacc_vpc.id
+	zone = "%s"
+	total_ipv4_address_count = 16
+}
+resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
+	vpc = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+	subnet_ids = %s
+}`, name, subnetName, acc.ISZoneName, rtName, subnetIDs)
+}
+
 func testAccCheckIBMISVPCRouteTableAcceptRoutesFromConfig(rtName, name, acceptRoutesFromVPNServer string) string {
 	return fmt.Sprintf(`
 resource "ibm_is_vpc" "testacc_vpc" {
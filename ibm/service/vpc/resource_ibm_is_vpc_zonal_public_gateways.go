@@ -0,0 +1,398 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isZonalPublicGatewaysVPC            = "vpc"
+	isZonalPublicGatewaysZones          = "zones"
+	isZonalPublicGatewaysNamePrefix     = "name_prefix"
+	isZonalPublicGatewaysResourceGroup  = "resource_group"
+	isZonalPublicGatewaysSubnets        = "subnets"
+	isZonalPublicGatewaysPublicGateways = "public_gateways"
+)
+
+// ResourceIBMISVPCZonalPublicGateways creates one public gateway in each zone of a VPC and attaches the subnets of
+// each zone to the public gateway of that zone.
+func ResourceIBMISVPCZonalPublicGateways() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPCZonalPublicGatewaysCreate,
+		ReadContext:   resourceIBMISVPCZonalPublicGatewaysRead,
+		UpdateContext: resourceIBMISVPCZonalPublicGatewaysUpdate,
+		DeleteContext: resourceIBMISVPCZonalPublicGatewaysDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isZonalPublicGatewaysVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier",
+			},
+			isZonalPublicGatewaysZones: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The zones to create a public gateway in, all the available zones of the region of the VPC by default",
+			},
+			isZonalPublicGatewaysNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the names of the public gateways, the name of each public gateway is the prefix followed by its zone",
+			},
+			isZonalPublicGatewaysResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group of the public gateways",
+			},
+			isZonalPublicGatewaysSubnets: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The subnets to attach to the public gateway of their zone",
+			},
+			isZonalPublicGatewaysPublicGateways: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public gateways, one per zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public gateway identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the public gateway",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the public gateway",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the public gateway",
+						},
+						"floating_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the floating IP of the public gateway",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMISVPCZonalPublicGatewaysCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcID := d.Get(isZonalPublicGatewaysVPC).(string)
+	zones := flex.ExpandStringList(d.Get(isZonalPublicGatewaysZones).(*schema.Set).List())
+	if len(zones) == 0 {
+		zones, err = vpcAvailableZones(context, sess, vpcID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	sort.Strings(zones)
+
+	namePrefix := d.Get(isZonalPublicGatewaysNamePrefix).(string)
+	publicGateways := []map[string]interface{}{}
+	d.SetId(vpcID)
+	for _, zone := range zones {
+		zone := zone
+		options := &vpcv1.CreatePublicGatewayOptions{
+			VPC: &vpcv1.VPCIdentity{
+				ID: &vpcID,
+			},
+			Zone: &vpcv1.ZoneIdentity{
+				Name: &zone,
+			},
+		}
+		if namePrefix != "" {
+			name := fmt.Sprintf("%s-%s", namePrefix, zone)
+			options.Name = &name
+		}
+		if rg, ok := d.GetOk(isZonalPublicGatewaysResourceGroup); ok {
+			rgID := rg.(string)
+			options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &rgID,
+			}
+		}
+
+		publicgw, response, err := sess.CreatePublicGatewayWithContext(context, options)
+		if err != nil {
+			// keep the public gateways created so far in the state, they are deleted with the tainted resource
+			d.Set(isZonalPublicGatewaysPublicGateways, publicGateways)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while creating Public Gateway in zone %s: %s\n%s", zone, err, response))
+		}
+		log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
+		publicGateways = append(publicGateways, map[string]interface{}{
+			"id":   *publicgw.ID,
+			"zone": zone,
+		})
+		d.Set(isZonalPublicGatewaysPublicGateways, publicGateways)
+
+		_, err = isWaitForPublicGatewayAvailable(sess, *publicgw.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, subnet := range flex.ExpandStringList(d.Get(isZonalPublicGatewaysSubnets).(*schema.Set).List()) {
+		if err = attachSubnetToZonalPublicGateway(context, sess, subnet, publicGateways, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMISVPCZonalPublicGatewaysRead(context, d, meta)
+}
+
+func resourceIBMISVPCZonalPublicGatewaysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicGateways := []map[string]interface{}{}
+	zones := []string{}
+	for _, pg := range d.Get(isZonalPublicGatewaysPublicGateways).([]interface{}) {
+		id := pg.(map[string]interface{})["id"].(string)
+		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
+			ID: &id,
+		}
+		publicgw, response, err := sess.GetPublicGatewayWithContext(context, getPublicGatewayOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Public Gateway (%s): %s\n%s", id, err, response))
+		}
+		publicGateway := map[string]interface{}{
+			"id":   *publicgw.ID,
+			"name": *publicgw.Name,
+			"zone": *publicgw.Zone.Name,
+			"crn":  *publicgw.CRN,
+		}
+		if publicgw.FloatingIP != nil && publicgw.FloatingIP.Address != nil {
+			publicGateway["floating_ip_address"] = *publicgw.FloatingIP.Address
+		}
+		if publicgw.ResourceGroup != nil {
+			d.Set(isZonalPublicGatewaysResourceGroup, *publicgw.ResourceGroup.ID)
+		}
+		publicGateways = append(publicGateways, publicGateway)
+		zones = append(zones, *publicgw.Zone.Name)
+	}
+	if len(publicGateways) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	// a subnet is only kept in the state while it is attached to the public gateway of its zone
+	subnets := []string{}
+	for _, subnet := range flex.ExpandStringList(d.Get(isZonalPublicGatewaysSubnets).(*schema.Set).List()) {
+		subnet := subnet
+		getSubnetPublicGatewayOptions := &vpcv1.GetSubnetPublicGatewayOptions{
+			ID: &subnet,
+		}
+		publicgw, response, err := sess.GetSubnetPublicGatewayWithContext(context, getSubnetPublicGatewayOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting the Public Gateway of subnet (%s): %s\n%s", subnet, err, response))
+		}
+		if zonalPublicGatewayID(publicGateways, *publicgw.Zone.Name) == *publicgw.ID {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	d.Set(isZonalPublicGatewaysVPC, d.Id())
+	d.Set(isZonalPublicGatewaysZones, zones)
+	d.Set(isZonalPublicGatewaysSubnets, subnets)
+	if err = d.Set(isZonalPublicGatewaysPublicGateways, publicGateways); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting public_gateways: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMISVPCZonalPublicGatewaysUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isZonalPublicGatewaysSubnets) {
+		publicGateways := []map[string]interface{}{}
+		for _, pg := range d.Get(isZonalPublicGatewaysPublicGateways).([]interface{}) {
+			publicGateways = append(publicGateways, pg.(map[string]interface{}))
+		}
+
+		oldSubnets, newSubnets := d.GetChange(isZonalPublicGatewaysSubnets)
+		removed := oldSubnets.(*schema.Set).Difference(newSubnets.(*schema.Set))
+		added := newSubnets.(*schema.Set).Difference(oldSubnets.(*schema.Set))
+		for _, subnet := range flex.ExpandStringList(removed.List()) {
+			if err = detachSubnetFromZonalPublicGateway(context, sess, subnet, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, subnet := range flex.ExpandStringList(added.List()) {
+			if err = attachSubnetToZonalPublicGateway(context, sess, subnet, publicGateways, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMISVPCZonalPublicGatewaysRead(context, d, meta)
+}
+
+func resourceIBMISVPCZonalPublicGatewaysDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, subnet := range flex.ExpandStringList(d.Get(isZonalPublicGatewaysSubnets).(*schema.Set).List()) {
+		if err = detachSubnetFromZonalPublicGateway(context, sess, subnet, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, pg := range d.Get(isZonalPublicGatewaysPublicGateways).([]interface{}) {
+		id := pg.(map[string]interface{})["id"].(string)
+		deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
+			ID: &id,
+		}
+		response, err := sess.DeletePublicGatewayWithContext(context, deletePublicGatewayOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Public Gateway (%s): %s\n%s", id, err, response))
+		}
+		_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// vpcAvailableZones returns the available zones of the region of a VPC, the region is read from the CRN of the VPC.
+func vpcAvailableZones(context context.Context, sess *vpcv1.VpcV1, vpcID string) ([]string, error) {
+	getVPCOptions := &vpcv1.GetVPCOptions{
+		ID: &vpcID,
+	}
+	vpc, response, err := sess.GetVPCWithContext(context, getVPCOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	crnParts := strings.Split(*vpc.CRN, ":")
+	if len(crnParts) < 6 {
+		return nil, fmt.Errorf("[ERROR] Error reading the region of VPC (%s) from its CRN %s", vpcID, *vpc.CRN)
+	}
+	region := crnParts[5]
+
+	listRegionZonesOptions := &vpcv1.ListRegionZonesOptions{
+		RegionName: &region,
+	}
+	availableZones, response, err := sess.ListRegionZonesWithContext(context, listRegionZonesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the zones of region %s: %s\n%s", region, err, response)
+	}
+	zones := []string{}
+	for _, zone := range availableZones.Zones {
+		if zone.Status != nil && *zone.Status == "available" {
+			zones = append(zones, *zone.Name)
+		}
+	}
+	return zones, nil
+}
+
+func zonalPublicGatewayID(publicGateways []map[string]interface{}, zone string) string {
+	for _, pg := range publicGateways {
+		if pg["zone"] == zone {
+			return pg["id"].(string)
+		}
+	}
+	return ""
+}
+
+func attachSubnetToZonalPublicGateway(context context.Context, sess *vpcv1.VpcV1, subnet string, publicGateways []map[string]interface{}, timeout time.Duration) error {
+	getSubnetOptions := &vpcv1.GetSubnetOptions{
+		ID: &subnet,
+	}
+	sn, response, err := sess.GetSubnetWithContext(context, getSubnetOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Subnet (%s): %s\n%s", subnet, err, response)
+	}
+	publicGateway := zonalPublicGatewayID(publicGateways, *sn.Zone.Name)
+	if publicGateway == "" {
+		return fmt.Errorf("[ERROR] Subnet (%s) is in zone %s which has no public gateway, add the zone to %s", subnet, *sn.Zone.Name, isZonalPublicGatewaysZones)
+	}
+
+	setSubnetPublicGatewayOptions := &vpcv1.SetSubnetPublicGatewayOptions{
+		ID: &subnet,
+		PublicGatewayIdentity: &vpcv1.PublicGatewayIdentity{
+			ID: &publicGateway,
+		},
+	}
+	_, response, err = sess.SetSubnetPublicGatewayWithContext(context, setSubnetPublicGatewayOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnet, err, response)
+	}
+	_, err = isWaitForSubnetPublicGatewayAvailable(sess, subnet, timeout)
+	return err
+}
+
+func detachSubnetFromZonalPublicGateway(context context.Context, sess *vpcv1.VpcV1, subnet string, timeout time.Duration) error {
+	unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
+		ID: &subnet,
+	}
+	response, err := sess.UnsetSubnetPublicGatewayWithContext(context, unsetSubnetPublicGatewayOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while detaching the public gateway of subnet(%s) %s\n%s", subnet, err, response)
+	}
+	_, err = isWaitForSubnetPublicGatewayUnset(sess, subnet, timeout)
+	return err
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCZonalPublicGateways_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfpgw-vpc-%d", acctest.RandIntRange(10, 100))
+	prefix := fmt.Sprintf("tfpgw-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfpgw-subnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISPublicGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCZonalPublicGatewaysConfig(vpcname, prefix, subnetname, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_zonal_public_gateways.testacc_public_gateways", "public_gateways.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_zonal_public_gateways.testacc_public_gateways", "subnets.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_zonal_public_gateways.testacc_public_gateways", "public_gateways.0.name", fmt.Sprintf("%s-%s", prefix, acc.ISZoneName)),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpc_zonal_public_gateways.testacc_public_gateways", "public_gateways.0.floating_ip_address"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCZonalPublicGatewaysConfig(vpcname, prefix, subnetname, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_zonal_public_gateways.testacc_public_gateways", "subnets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCZonalPublicGatewaysConfig(vpcname, prefix, subnetname string, both bool) string {
+	subnets := "ibm_is_subnet.testacc_subnet1.id"
+	if both {
+		subnets += ", ibm_is_subnet.testacc_subnet2.id"
+	}
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_is_subnet" "testacc_subnet1" {
+			name                     = "%[3]s-1"
+			vpc                      = ibm_is_vpc.testacc_vpc.id
+			zone                     = "%[4]s"
+			total_ipv4_address_count = 16
+			lifecycle {
+				ignore_changes = [public_gateway]
+			}
+		}
+
+		resource "ibm_is_subnet" "testacc_subnet2" {
+			name                     = "%[3]s-2"
+			vpc                      = ibm_is_vpc.testacc_vpc.id
+			zone                     = "%[5]s"
+			total_ipv4_address_count = 16
+			lifecycle {
+				ignore_changes = [public_gateway]
+			}
+		}
+
+		resource "ibm_is_vpc_zonal_public_gateways" "testacc_public_gateways" {
+			vpc         = ibm_is_vpc.testacc_vpc.id
+			zones       = ["%[4]s", "%[5]s"]
+			name_prefix = "%[2]s"
+			subnets     = [%[6]s]
+		}`, vpcname, prefix, subnetname, acc.ISZoneName, acc.ISZoneName2, subnets)
+}
@@ -430,8 +430,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPNGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVPNGatewayTags), meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
@@ -439,8 +438,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	}
 
 	if _, ok := d.GetOk(isVPNGatewayAccessTags); ok {
-		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVPNGatewayAccessTags), meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource VPN Gateway (%s) access tags: %s", d.Id(), err)
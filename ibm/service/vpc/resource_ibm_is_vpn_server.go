@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -87,6 +88,12 @@ func ResourceIBMIsVPNServer() *schema.Resource {
 							Optional:    true,
 							Description: "The crn of certificate instance to use for the VPN client certificate authority (CA).",
 						},
+						"crl": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressVPNServerCRLDiff,
+							Description:      "The certificate revocation list contents, encoded in PEM format, checked against the certificates of VPN clients. Only applies to the `certificate` method.",
+						},
 					},
 				},
 			},
@@ -452,36 +459,9 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 	certificateInstanceIdentity.CRN = &crn_val
 	createVPNServerOptions.Certificate = certificateInstanceIdentity
 
-	var clientAuthentication []vpcv1.VPNServerAuthenticationPrototypeIntf
-	clientAuthArray := d.Get("client_authentication").([]interface{})
-	for _, clientauth := range clientAuthArray {
-		clientAuth := clientauth.(map[string]interface{})
-		method := clientAuth["method"].(string)
-		clientAuthPrototype := &vpcv1.VPNServerAuthenticationPrototype{}
-		clientAuthPrototype.Method = &method
-
-		if method == "certificate" {
-			if clientAuth["client_ca_crn"] != nil {
-				crn_val := clientAuth["client_ca_crn"].(string)
-				certificateInstanceIdentity := &vpcv1.CertificateInstanceIdentity{}
-				certificateInstanceIdentity.CRN = &crn_val
-				clientAuthPrototype.ClientCa = certificateInstanceIdentity
-
-			} else {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error method type `certificate` should be passed with `client_ca_crn`"))
-			}
-		} else {
-			if clientAuth["identity_provider"] != nil {
-				providerType := clientAuth["identity_provider"].(string)
-				clientAuthPrototype.IdentityProvider = &vpcv1.VPNServerAuthenticationByUsernameIDProvider{
-					ProviderType: &providerType,
-				}
-			} else {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error method type `username` should be passed with `identity_provider`"))
-			}
-
-		}
-		clientAuthentication = append(clientAuthentication, clientAuthPrototype)
+	clientAuthentication, err := resourceIBMIsVPNServerExpandClientAuthentication(d.Get("client_authentication").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	createVPNServerOptions.ClientAuthentication = clientAuthentication
 
@@ -565,8 +545,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 	}
 
 	if _, ok := d.GetOk(isVPNServerAccessTags); ok {
-		oldList, newList := d.GetChange(isVPNServerAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
+		err = flex.AttachGlobalTagsUsingCRN(d.Get(isVPNServerAccessTags), meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
@@ -644,6 +623,9 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 				if vpnServerAuthentication.ClientCa != nil && vpnServerAuthentication.ClientCa.CRN != nil {
 					vpnServerAuthenticationPrototype["client_ca_crn"] = *vpnServerAuthentication.ClientCa.CRN
 				}
+				if vpnServerAuthentication.Crl != nil {
+					vpnServerAuthenticationPrototype["crl"] = *vpnServerAuthentication.Crl
+				}
 				if vpnServerAuthentication.IdentityProvider != nil {
 					vpnServerAuthenticationByUsernameIDProvider := vpnServerAuthentication.IdentityProvider.(*vpcv1.VPNServerAuthenticationByUsernameIDProvider)
 					vpnServerAuthenticationPrototype["identity_provider"] = *vpnServerAuthenticationByUsernameIDProvider.ProviderType
@@ -775,6 +757,62 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceIBMIsVPNServerExpandClientAuthentication builds the client authentication methods of a VPN
+// server. The methods are sent as a whole on update, so methods can be added, removed or rotated,
+// including the certificate revocation list, without replacing the VPN server.
+func resourceIBMIsVPNServerExpandClientAuthentication(clientAuthArray []interface{}) ([]vpcv1.VPNServerAuthenticationPrototypeIntf, error) {
+	var clientAuthentication []vpcv1.VPNServerAuthenticationPrototypeIntf
+	methods := map[string]bool{}
+	for _, clientauth := range clientAuthArray {
+		clientAuth := clientauth.(map[string]interface{})
+		method := clientAuth["method"].(string)
+		if methods[method] {
+			return nil, fmt.Errorf("[ERROR] Error method type `%s` can only be passed once in `client_authentication`", method)
+		}
+		methods[method] = true
+		clientAuthPrototype := &vpcv1.VPNServerAuthenticationPrototype{}
+		clientAuthPrototype.Method = &method
+
+		crl, _ := clientAuth["crl"].(string)
+		if method == "certificate" {
+			if clientAuth["client_ca_crn"] != nil && clientAuth["client_ca_crn"] != "" {
+				crn_val := clientAuth["client_ca_crn"].(string)
+				certificateInstanceIdentity := &vpcv1.CertificateInstanceIdentity{}
+				certificateInstanceIdentity.CRN = &crn_val
+				clientAuthPrototype.ClientCa = certificateInstanceIdentity
+			} else {
+				return nil, fmt.Errorf("[ERROR] Error method type `certificate` should be passed with `client_ca_crn`")
+			}
+			if crl != "" {
+				clientAuthPrototype.Crl = &crl
+			}
+		} else {
+			if crl != "" {
+				return nil, fmt.Errorf("[ERROR] Error `crl` can only be passed with method type `certificate`")
+			}
+			if clientAuth["identity_provider"] != nil && clientAuth["identity_provider"] != "" {
+				providerType := clientAuth["identity_provider"].(string)
+				clientAuthPrototype.IdentityProvider = &vpcv1.VPNServerAuthenticationByUsernameIDProvider{
+					ProviderType: &providerType,
+				}
+			} else {
+				return nil, fmt.Errorf("[ERROR] Error method type `username` should be passed with `identity_provider`")
+			}
+		}
+		clientAuthentication = append(clientAuthentication, clientAuthPrototype)
+	}
+	return clientAuthentication, nil
+}
+
+// suppressVPNServerCRLDiff ignores differences in the line endings and surrounding whitespace of
+// a certificate revocation list, which the API does not preserve.
+func suppressVPNServerCRLDiff(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(crl string) string {
+		return strings.TrimSpace(strings.ReplaceAll(crl, "\r\n", "\n"))
+	}
+	return normalize(old) == normalize(new)
+}
+
 func resourceIBMIsVPNServerReservedIPReferenceToMap(reservedIPReference vpcv1.ReservedIPReference) map[string]interface{} {
 	reservedIPReferenceMap := map[string]interface{}{}
 
@@ -844,36 +882,9 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 	}
 
 	if d.HasChange("client_authentication") {
-		var clientAuthentication []vpcv1.VPNServerAuthenticationPrototypeIntf
-		clientAuthArray := d.Get("client_authentication").([]interface{})
-		for _, clientauth := range clientAuthArray {
-			clientAuth := clientauth.(map[string]interface{})
-			method := clientAuth["method"].(string)
-			clientAuthPrototype := &vpcv1.VPNServerAuthenticationPrototype{}
-			clientAuthPrototype.Method = &method
-
-			if method == "certificate" {
-				if clientAuth["client_ca_crn"] != nil && clientAuth["client_ca_crn"] != "" {
-					crn_val := clientAuth["client_ca_crn"].(string)
-					certificateInstanceIdentity := &vpcv1.CertificateInstanceIdentity{}
-					certificateInstanceIdentity.CRN = &crn_val
-					clientAuthPrototype.ClientCa = certificateInstanceIdentity
-
-				} else {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error method type `certificate` should be passed with `client_ca_crn`"))
-				}
-			} else {
-				if clientAuth["identity_provider"] != nil && clientAuth["identity_provider"] != "" {
-					providerType := clientAuth["identity_provider"].(string)
-					clientAuthPrototype.IdentityProvider = &vpcv1.VPNServerAuthenticationByUsernameIDProvider{
-						ProviderType: &providerType,
-					}
-				} else {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error method type `username` should be passed with `identity_provider`"))
-				}
-
-			}
-			clientAuthentication = append(clientAuthentication, clientAuthPrototype)
+		clientAuthentication, err := resourceIBMIsVPNServerExpandClientAuthentication(d.Get("client_authentication").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 		patchVals.ClientAuthentication = clientAuthentication
 		hasChange = true
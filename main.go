@@ -2,13 +2,20 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	// the provider binary is also the token helper of the kubeconfigs of ibm_container_cluster_config
+	if len(os.Args) > 1 && os.Args[1] == kubernetes.ExecCredentialCommand {
+		os.Exit(kubernetes.RunExecCredentialHelper(os.Args[2:]))
+	}
+
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: provider.Provider,